@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"strings"
 	"syscall"
 
@@ -27,22 +28,16 @@ var (
 		Short: `An open source, lightweight note-taking service. Easily capture and share your great thoughts.`,
 		Run: func(_ *cobra.Command, _ []string) {
 			instanceProfile := &profile.Profile{
-				Demo:             viper.GetBool("demo"),
-				Addr:             viper.GetString("addr"),
-				Port:             viper.GetInt("port"),
-				UNIXSock:         viper.GetString("unix-sock"),
-				Data:             viper.GetString("data"),
-				Driver:           viper.GetString("driver"),
-				DSN:              viper.GetString("dsn"),
-				InstanceURL:      viper.GetString("instance-url"),
-				OpenRouterAPIKey: os.Getenv("OPENROUTER_API_KEY"),
-				AIModel:          func() string {
-					if m := os.Getenv("AI_MODEL"); m != "" {
-						return m
-					}
-					return "stepfun/step-3.5-flash:free"
-				}(),
+				Demo:        viper.GetBool("demo"),
+				Addr:        viper.GetString("addr"),
+				Port:        viper.GetInt("port"),
+				UNIXSock:    viper.GetString("unix-sock"),
+				Data:        viper.GetString("data"),
+				Driver:      viper.GetString("driver"),
+				DSN:         viper.GetString("dsn"),
+				InstanceURL: viper.GetString("instance-url"),
 			}
+			loadAIProfile(instanceProfile)
 			instanceProfile.Version = version.GetCurrentVersion()
 			webhook.AllowPrivateIPs = viper.GetBool("allow-private-webhooks")
 
@@ -149,6 +144,49 @@ func init() {
 	viper.AutomaticEnv()
 }
 
+// loadAIProfile reads the LLM settings from the environment. OPENROUTER_API_KEY
+// on its own keeps working and selects the OpenRouter backend with its defaults.
+func loadAIProfile(p *profile.Profile) {
+	p.AIAPIKey = os.Getenv("AI_API_KEY")
+	if p.AIAPIKey == "" {
+		p.AIAPIKey = os.Getenv("OPENROUTER_API_KEY")
+	}
+	p.AIProvider = os.Getenv("AI_PROVIDER")
+	if p.AIProvider == "" && p.AIAPIKey != "" {
+		p.AIProvider = "openrouter"
+	}
+	p.AIBaseURL = os.Getenv("AI_BASE_URL")
+	p.AIModel = os.Getenv("AI_MODEL")
+	if p.AIModel == "" && p.AIProvider == "openrouter" {
+		p.AIModel = "stepfun/step-3.5-flash:free"
+	}
+	if contextWindow := os.Getenv("AI_CONTEXT_WINDOW"); contextWindow != "" {
+		n, err := strconv.Atoi(contextWindow)
+		if err != nil || n < 0 {
+			slog.Warn("ignoring invalid AI_CONTEXT_WINDOW", "value", contextWindow)
+		} else {
+			p.AIContextWindow = n
+		}
+	}
+
+	p.AIEmbeddingProvider = os.Getenv("AI_EMBEDDING_PROVIDER")
+	p.AIEmbeddingBaseURL = os.Getenv("AI_EMBEDDING_BASE_URL")
+	p.AIEmbeddingAPIKey = os.Getenv("AI_EMBEDDING_API_KEY")
+	if p.AIEmbeddingProvider == "" {
+		p.AIEmbeddingProvider = p.AIProvider
+		if p.AIEmbeddingBaseURL == "" {
+			p.AIEmbeddingBaseURL = p.AIBaseURL
+		}
+		if p.AIEmbeddingAPIKey == "" {
+			p.AIEmbeddingAPIKey = p.AIAPIKey
+		}
+	}
+	p.AIEmbeddingModel = os.Getenv("AI_EMBEDDING_MODEL")
+	if p.AIEmbeddingModel == "" && p.AIEmbeddingProvider == "openrouter" {
+		p.AIEmbeddingModel = "qwen/qwen3-embedding-8b"
+	}
+}
+
 func printGreetings(profile *profile.Profile) {
 	fmt.Printf("Memos %s started successfully!\n", profile.Version)
 
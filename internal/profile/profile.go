@@ -32,12 +32,31 @@ type Profile struct {
 	Version string
 	// InstanceURL is the url of your memos instance.
 	InstanceURL string
-	// OpenRouterAPIKey is the API key for OpenRouter (AI proxy).
-	// Loaded from env OPENROUTER_API_KEY.
-	OpenRouterAPIKey string
-	// AIModel is the OpenRouter model to use (e.g. "openai/gpt-4o-mini").
-	// Loaded from env AI_MODEL; defaults to "openai/gpt-4o-mini".
+	// AIProvider is the LLM backend: "openrouter", "openai" (any OpenAI-compatible API) or "ollama".
+	// Loaded from env AI_PROVIDER; defaults to "openrouter" when an API key is set. Empty disables AI.
+	AIProvider string
+	// AIBaseURL overrides the backend's default API base URL.
+	// Loaded from env AI_BASE_URL.
+	AIBaseURL string
+	// AIAPIKey is the API key for the LLM backend.
+	// Loaded from env AI_API_KEY, falling back to OPENROUTER_API_KEY.
+	AIAPIKey string
+	// AIModel is the chat model to use (e.g. "openai/gpt-4o-mini").
+	// Loaded from env AI_MODEL.
 	AIModel string
+	// AIContextWindow is the context window of AIModel in tokens, which sets when
+	// AI chat history is compacted. Loaded from env AI_CONTEXT_WINDOW; 0 looks
+	// it up by model name.
+	AIContextWindow int
+	// AIEmbeddingProvider, AIEmbeddingBaseURL and AIEmbeddingAPIKey configure the backend
+	// used for semantic memo search. Loaded from env AI_EMBEDDING_PROVIDER, AI_EMBEDDING_BASE_URL
+	// and AI_EMBEDDING_API_KEY; each defaults to its chat counterpart.
+	AIEmbeddingProvider string
+	AIEmbeddingBaseURL  string
+	AIEmbeddingAPIKey   string
+	// AIEmbeddingModel is the embedding model. Loaded from env AI_EMBEDDING_MODEL.
+	// Empty disables semantic memo search.
+	AIEmbeddingModel string
 }
 
 func checkDataDir(dataDir string) (string, error) {
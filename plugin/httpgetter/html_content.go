@@ -0,0 +1,661 @@
+package httpgetter
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+	"golang.org/x/net/html/charset"
+)
+
+// maxHTMLContentSize caps the bytes of a page read by GetHTMLContent.
+const maxHTMLContentSize = 5 << 20
+
+// safeClient fetches the pages, which users and agents pick, so it does not
+// connect to internal addresses a host resolves to after ValidateURL.
+var safeClient = NewSafeClient()
+
+// ErrNoContent is returned for pages without readable text.
+var ErrNoContent = errors.New("no readable content")
+
+// HTMLContent is the main content of a web page, without navigation, ads and
+// other clutter.
+type HTMLContent struct {
+	Title    string `json:"title"`
+	Byline   string `json:"byline"`
+	SiteName string `json:"siteName"`
+	// Markdown is the content rendered as markdown, keeping headings, lists,
+	// links and code blocks.
+	Markdown string `json:"markdown"`
+	// Text is the content as plain text.
+	Text string `json:"text"`
+}
+
+// GetHTMLContent fetches a web page and extracts its main content, in the
+// manner of the readability algorithm of browser reader views.
+func GetHTMLContent(ctx context.Context, urlStr string) (*HTMLContent, error) {
+	if err := ValidateURL(urlStr); err != nil {
+		return nil, err
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, urlStr, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Accept", "text/html,application/xhtml+xml,text/plain;q=0.9")
+	response, err := safeClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("unexpected status %d", response.StatusCode)
+	}
+
+	mediatype, err := getMediatype(response)
+	if err != nil {
+		return nil, err
+	}
+	body, err := charset.NewReader(io.LimitReader(response.Body, maxHTMLContentSize), response.Header.Get("content-type"))
+	if err != nil {
+		return nil, err
+	}
+	switch mediatype {
+	case "text/html", "application/xhtml+xml":
+		return ExtractHTMLContent(body, response.Request.URL)
+	case "text/plain", "text/markdown":
+		data, err := io.ReadAll(body)
+		if err != nil {
+			return nil, err
+		}
+		text := strings.TrimSpace(string(data))
+		if text == "" {
+			return nil, ErrNoContent
+		}
+		return &HTMLContent{Markdown: text, Text: text}, nil
+	default:
+		return nil, errors.Errorf("unsupported content type %s", mediatype)
+	}
+}
+
+var (
+	// unlikelyPattern and maybePattern match the class and id of elements that
+	// are rarely part of the content, unless they also look like content.
+	unlikelyPattern = regexp.MustCompile(`(?i)-ad-|ai2html|banner|breadcrumbs|combx|comment|community|cookie|cover-wrap|disqus|extra|footer|gdpr|header|legends|menu|newsletter|pager|pagination|popup|related|remark|replies|rss|share|shoutbox|sidebar|skyscraper|social|sponsor|subscribe|supplemental`)
+	maybePattern    = regexp.MustCompile(`(?i)and|article|body|column|content|main|shadow`)
+	// positivePattern and negativePattern weigh the class and id of candidates.
+	positivePattern = regexp.MustCompile(`(?i)article|body|content|entry|hentry|h-entry|main|page|post|text|blog|story`)
+	negativePattern = regexp.MustCompile(`(?i)-ad-|hidden|banner|combx|comment|com-|contact|foot|footnote|gdpr|masthead|media|meta|outbrain|promo|related|scroll|share|shoutbox|sidebar|skyscraper|sponsor|shopping|tags|tool|widget`)
+	bylinePattern   = regexp.MustCompile(`(?i)byline|author|dateline|writtenby|p-author`)
+
+	// clutterAtoms are elements that never hold content.
+	clutterAtoms = map[atom.Atom]bool{
+		atom.Script: true, atom.Style: true, atom.Noscript: true, atom.Template: true,
+		atom.Iframe: true, atom.Object: true, atom.Embed: true, atom.Canvas: true, atom.Svg: true,
+		atom.Nav: true, atom.Footer: true, atom.Aside: true, atom.Menu: true, atom.Dialog: true,
+		atom.Form: true, atom.Button: true, atom.Input: true, atom.Select: true, atom.Textarea: true,
+	}
+	// blockAtoms are elements rendered as blocks of their own.
+	blockAtoms = map[atom.Atom]bool{
+		atom.Address: true, atom.Article: true, atom.Blockquote: true, atom.Dd: true, atom.Details: true,
+		atom.Div: true, atom.Dl: true, atom.Dt: true, atom.Figcaption: true, atom.Figure: true,
+		atom.H1: true, atom.H2: true, atom.H3: true, atom.H4: true, atom.H5: true, atom.H6: true,
+		atom.Hr: true, atom.Li: true, atom.Main: true, atom.Ol: true, atom.P: true, atom.Pre: true,
+		atom.Section: true, atom.Summary: true, atom.Table: true, atom.Ul: true,
+	}
+)
+
+// ExtractHTMLContent extracts the main content of an HTML page. base resolves
+// relative links and may be nil.
+func ExtractHTMLContent(r io.Reader, base *url.URL) (*HTMLContent, error) {
+	doc, err := html.Parse(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse HTML")
+	}
+	content := &HTMLContent{}
+	extractContentMeta(doc, content)
+
+	body := findElement(doc, atom.Body)
+	if body == nil {
+		body = doc
+	}
+	removeClutter(body, content)
+
+	markdown := renderer{base: base}
+	plain := renderer{base: base, plain: true}
+	for _, n := range contentNodes(body) {
+		markdown.render(n)
+		plain.render(n)
+	}
+	content.Markdown = strings.TrimSpace(markdown.b.String())
+	content.Text = strings.TrimSpace(plain.b.String())
+	if content.Text == "" {
+		return nil, ErrNoContent
+	}
+	return content, nil
+}
+
+// extractContentMeta reads the title, byline and site name from the head.
+func extractContentMeta(doc *html.Node, content *HTMLContent) {
+	var title, ogTitle string
+	walk(doc, func(n *html.Node) bool {
+		switch n.DataAtom {
+		case atom.Body:
+			return false
+		case atom.Title:
+			title = collapseSpace(textContent(n))
+		case atom.Meta:
+			key := getAttr(n, "property")
+			if key == "" {
+				key = getAttr(n, "name")
+			}
+			value := collapseSpace(getAttr(n, "content"))
+			switch strings.ToLower(key) {
+			case "og:title":
+				ogTitle = value
+			case "og:site_name":
+				content.SiteName = value
+			case "author", "article:author", "dc.creator":
+				// article:author is often a profile URL.
+				if content.Byline == "" && !strings.HasPrefix(value, "http") {
+					content.Byline = value
+				}
+			}
+		}
+		return true
+	})
+	content.Title = title
+	if ogTitle != "" {
+		content.Title = ogTitle
+	}
+}
+
+// removeClutter drops elements that are not content, picking up the byline
+// on the way.
+func removeClutter(root *html.Node, content *HTMLContent) {
+	var remove []*html.Node
+	walk(root, func(n *html.Node) bool {
+		if n.Type == html.CommentNode {
+			remove = append(remove, n)
+			return false
+		}
+		if n.Type != html.ElementNode {
+			return true
+		}
+		match := getAttr(n, "class") + " " + getAttr(n, "id")
+		if getAttr(n, "rel") == "author" || strings.Contains(getAttr(n, "itemprop"), "author") || bylinePattern.MatchString(match) {
+			if byline := collapseSpace(textContent(n)); byline != "" && len(byline) < 100 {
+				if content.Byline == "" {
+					content.Byline = byline
+				}
+				remove = append(remove, n)
+				return false
+			}
+		}
+		if clutterAtoms[n.DataAtom] || isHidden(n) {
+			remove = append(remove, n)
+			return false
+		}
+		if n.DataAtom != atom.Body && n.DataAtom != atom.Article && n.DataAtom != atom.Main &&
+			unlikelyPattern.MatchString(match) && !maybePattern.MatchString(match) && !hasAncestor(n, atom.Table, atom.Pre) {
+			remove = append(remove, n)
+			return false
+		}
+		return true
+	})
+	for _, n := range remove {
+		n.Parent.RemoveChild(n)
+	}
+}
+
+// contentNodes scores the elements holding paragraphs of text and returns the
+// best one, along with siblings that look like part of the same content.
+func contentNodes(body *html.Node) []*html.Node {
+	scores := make(map[*html.Node]float64)
+	// candidates keeps the scored elements in order, so that ties go to the
+	// first.
+	var candidates []*html.Node
+	initScore := func(n *html.Node) {
+		if _, ok := scores[n]; ok {
+			return
+		}
+		candidates = append(candidates, n)
+		var score float64
+		switch n.DataAtom {
+		case atom.Div, atom.Article, atom.Main, atom.Section:
+			score = 5
+		case atom.Pre, atom.Td, atom.Blockquote:
+			score = 3
+		case atom.Address, atom.Ol, atom.Ul, atom.Dl, atom.Dd, atom.Dt, atom.Li:
+			score = -3
+		case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6, atom.Th:
+			score = -5
+		}
+		scores[n] = score + classWeight(n)
+	}
+	walk(body, func(n *html.Node) bool {
+		if n.Type != html.ElementNode || !isParagraph(n) {
+			return true
+		}
+		text := collapseSpace(textContent(n))
+		if len(text) < 25 {
+			return true
+		}
+		score := 1 + float64(strings.Count(text, ",")) + min(float64(len(text))/100, 3)
+		// Ancestors share the score, the further up the less.
+		for level, ancestor := 0, n.Parent; level < 3 && ancestor != nil && ancestor.Type == html.ElementNode; level, ancestor = level+1, ancestor.Parent {
+			initScore(ancestor)
+			scores[ancestor] += score / []float64{1, 2, 6}[level]
+		}
+		return true
+	})
+
+	var top *html.Node
+	topScore := 0.0
+	for _, n := range candidates {
+		score := scores[n] * (1 - linkDensity(n))
+		scores[n] = score
+		if top == nil || score > topScore {
+			top, topScore = n, score
+		}
+	}
+	if top == nil || top == body {
+		return []*html.Node{body}
+	}
+
+	var nodes []*html.Node
+	threshold := max(10, topScore*0.2)
+	for sibling := top.Parent.FirstChild; sibling != nil; sibling = sibling.NextSibling {
+		switch {
+		case sibling == top:
+			nodes = append(nodes, sibling)
+		case sibling.Type != html.ElementNode:
+		case scores[sibling] >= threshold:
+			nodes = append(nodes, sibling)
+		case sibling.DataAtom == atom.P:
+			text := collapseSpace(textContent(sibling))
+			if len(text) > 80 && linkDensity(sibling) < 0.25 {
+				nodes = append(nodes, sibling)
+			}
+		}
+	}
+	return nodes
+}
+
+// isParagraph reports whether n is a paragraph of text, counting divs used as
+// paragraphs.
+func isParagraph(n *html.Node) bool {
+	switch n.DataAtom {
+	case atom.P, atom.Pre, atom.Td, atom.Blockquote:
+		return true
+	case atom.Div:
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			if c.Type == html.ElementNode && blockAtoms[c.DataAtom] {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func classWeight(n *html.Node) float64 {
+	var weight float64
+	for _, value := range []string{getAttr(n, "class"), getAttr(n, "id")} {
+		if value == "" {
+			continue
+		}
+		if negativePattern.MatchString(value) {
+			weight -= 25
+		}
+		if positivePattern.MatchString(value) {
+			weight += 25
+		}
+	}
+	return weight
+}
+
+// linkDensity is the share of the text of n that is inside links.
+func linkDensity(n *html.Node) float64 {
+	length := len(collapseSpace(textContent(n)))
+	if length == 0 {
+		return 0
+	}
+	linkLength := 0
+	walk(n, func(c *html.Node) bool {
+		if c.DataAtom == atom.A {
+			linkLength += len(collapseSpace(textContent(c)))
+			return false
+		}
+		return true
+	})
+	return float64(linkLength) / float64(length)
+}
+
+func isHidden(n *html.Node) bool {
+	if hasAttr(n, "hidden") || getAttr(n, "aria-hidden") == "true" {
+		return true
+	}
+	style := strings.ReplaceAll(strings.ToLower(getAttr(n, "style")), " ", "")
+	return strings.Contains(style, "display:none") || strings.Contains(style, "visibility:hidden")
+}
+
+// renderer writes content as markdown, or as plain text.
+type renderer struct {
+	base  *url.URL
+	plain bool
+	b     strings.Builder
+	// space is set when whitespace was skipped before the next text.
+	space bool
+}
+
+func (r *renderer) render(n *html.Node) {
+	switch n.Type {
+	case html.TextNode:
+		r.text(n.Data)
+		return
+	case html.ElementNode:
+	default:
+		r.renderChildren(n)
+		return
+	}
+
+	switch n.DataAtom {
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+		if heading := r.inline(n); heading != "" {
+			r.block()
+			if !r.plain {
+				level, _ := strconv.Atoi(n.Data[1:])
+				r.b.WriteString(strings.Repeat("#", level) + " ")
+			}
+			r.b.WriteString(heading)
+			r.block()
+		}
+	case atom.Br:
+		r.b.WriteString("\n")
+		r.space = false
+	case atom.Hr:
+		if !r.plain {
+			r.block()
+			r.b.WriteString("---")
+		}
+		r.block()
+	case atom.Pre:
+		code := strings.Trim(textContent(n), "\n")
+		if strings.TrimSpace(code) == "" {
+			return
+		}
+		r.block()
+		if r.plain {
+			r.b.WriteString(code)
+		} else {
+			r.b.WriteString("```\n" + code + "\n```")
+		}
+		r.block()
+	case atom.Code:
+		if code := collapseSpace(textContent(n)); code != "" {
+			r.inlineText(r.wrap(code, "`"))
+		}
+	case atom.Strong, atom.B:
+		r.inlineText(r.wrap(r.inline(n), "**"))
+	case atom.Em, atom.I:
+		r.inlineText(r.wrap(r.inline(n), "*"))
+	case atom.A:
+		text := r.inline(n)
+		href := r.resolve(getAttr(n, "href"))
+		if !r.plain && text != "" && href != "" {
+			text = "[" + text + "](" + href + ")"
+		}
+		r.inlineText(text)
+	case atom.Img, atom.Picture, atom.Video, atom.Audio, atom.Source:
+	case atom.Ul, atom.Ol:
+		r.list(n)
+	case atom.Blockquote:
+		quote := r.sub(n)
+		if quote == "" {
+			return
+		}
+		r.block()
+		if r.plain {
+			r.b.WriteString(quote)
+		} else {
+			r.b.WriteString("> " + strings.ReplaceAll(quote, "\n", "\n> "))
+		}
+		r.block()
+	case atom.Table:
+		r.table(n)
+	default:
+		if blockAtoms[n.DataAtom] {
+			r.block()
+			r.renderChildren(n)
+			r.block()
+		} else {
+			r.renderChildren(n)
+		}
+	}
+}
+
+func (r *renderer) renderChildren(n *html.Node) {
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		r.render(c)
+	}
+}
+
+// text writes text with its whitespace collapsed.
+func (r *renderer) text(s string) {
+	if s == "" {
+		return
+	}
+	if isSpace(s[0]) {
+		r.space = true
+	}
+	if collapsed := collapseSpace(s); collapsed != "" {
+		r.inlineText(collapsed)
+	}
+	if isSpace(s[len(s)-1]) {
+		r.space = true
+	}
+}
+
+func (r *renderer) inlineText(s string) {
+	if s == "" {
+		return
+	}
+	if r.space && r.b.Len() > 0 && !strings.HasSuffix(r.b.String(), "\n") {
+		r.b.WriteString(" ")
+	}
+	r.b.WriteString(s)
+	r.space = false
+}
+
+// block starts a new block, unless one was just started.
+func (r *renderer) block() {
+	r.space = false
+	out := r.b.String()
+	switch {
+	case out == "" || strings.HasSuffix(out, "\n\n"):
+	case strings.HasSuffix(out, "\n"):
+		r.b.WriteString("\n")
+	default:
+		r.b.WriteString("\n\n")
+	}
+}
+
+// sub renders the children of n on their own.
+func (r *renderer) sub(n *html.Node) string {
+	sub := renderer{base: r.base, plain: r.plain}
+	sub.renderChildren(n)
+	return strings.TrimSpace(sub.b.String())
+}
+
+// inline renders the children of n as a single line.
+func (r *renderer) inline(n *html.Node) string {
+	return collapseSpace(r.sub(n))
+}
+
+func (r *renderer) wrap(s, marker string) string {
+	if r.plain || s == "" {
+		return s
+	}
+	return marker + s + marker
+}
+
+func (r *renderer) list(n *html.Node) {
+	var items []string
+	number := 1
+	if start, err := strconv.Atoi(getAttr(n, "start")); err == nil {
+		number = start
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if c.DataAtom != atom.Li {
+			continue
+		}
+		item := r.sub(c)
+		if item == "" {
+			continue
+		}
+		marker := "- "
+		if n.DataAtom == atom.Ol {
+			marker = strconv.Itoa(number) + ". "
+			number++
+		}
+		indent := "\n" + strings.Repeat(" ", len(marker))
+		items = append(items, marker+strings.ReplaceAll(item, "\n", indent))
+	}
+	if len(items) == 0 {
+		return
+	}
+	r.block()
+	r.b.WriteString(strings.Join(items, "\n"))
+	r.block()
+}
+
+func (r *renderer) table(n *html.Node) {
+	var rows []string
+	walk(n, func(c *html.Node) bool {
+		if c != n && c.DataAtom == atom.Table {
+			return false
+		}
+		if c.DataAtom != atom.Tr {
+			return true
+		}
+		var cells []string
+		for cell := c.FirstChild; cell != nil; cell = cell.NextSibling {
+			if cell.DataAtom == atom.Td || cell.DataAtom == atom.Th {
+				cells = append(cells, strings.ReplaceAll(r.inline(cell), "|", "\\|"))
+			}
+		}
+		if len(cells) == 0 {
+			return false
+		}
+		if r.plain {
+			rows = append(rows, strings.Join(cells, " | "))
+			return false
+		}
+		rows = append(rows, "| "+strings.Join(cells, " | ")+" |")
+		if len(rows) == 1 {
+			rows = append(rows, "|"+strings.Repeat(" --- |", len(cells)))
+		}
+		return false
+	})
+	if len(rows) == 0 {
+		return
+	}
+	r.block()
+	r.b.WriteString(strings.Join(rows, "\n"))
+	r.block()
+}
+
+// resolve returns href as an absolute http(s) URL, or "" if it is not one.
+func (r *renderer) resolve(href string) string {
+	u, err := url.Parse(strings.TrimSpace(href))
+	if err != nil || href == "" {
+		return ""
+	}
+	if r.base != nil {
+		u = r.base.ResolveReference(u)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return ""
+	}
+	return u.String()
+}
+
+// walk calls visit for n and its descendants, skipping the descendants of
+// nodes for which visit returns false.
+func walk(n *html.Node, visit func(*html.Node) bool) {
+	if !visit(n) {
+		return
+	}
+	for c := n.FirstChild; c != nil; {
+		// visit may remove c from the tree.
+		next := c.NextSibling
+		walk(c, visit)
+		c = next
+	}
+}
+
+func findElement(n *html.Node, a atom.Atom) *html.Node {
+	var found *html.Node
+	walk(n, func(c *html.Node) bool {
+		if found == nil && c.DataAtom == a {
+			found = c
+		}
+		return found == nil
+	})
+	return found
+}
+
+func hasAncestor(n *html.Node, atoms ...atom.Atom) bool {
+	for p := n.Parent; p != nil; p = p.Parent {
+		for _, a := range atoms {
+			if p.DataAtom == a {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func textContent(n *html.Node) string {
+	var sb strings.Builder
+	walk(n, func(c *html.Node) bool {
+		if c.Type == html.TextNode {
+			sb.WriteString(c.Data)
+		}
+		return true
+	})
+	return sb.String()
+}
+
+func getAttr(n *html.Node, key string) string {
+	for _, attr := range n.Attr {
+		if attr.Key == key {
+			return attr.Val
+		}
+	}
+	return ""
+}
+
+func hasAttr(n *html.Node, key string) bool {
+	for _, attr := range n.Attr {
+		if attr.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+func collapseSpace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+func isSpace(b byte) bool {
+	return b == ' ' || b == '\t' || b == '\n' || b == '\r' || b == '\f'
+}
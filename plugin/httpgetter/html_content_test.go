@@ -0,0 +1,84 @@
+package httpgetter
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const articlePage = `<!DOCTYPE html>
+<html>
+<head>
+  <title>Growing basil | Garden Weekly</title>
+  <meta property="og:title" content="Growing basil indoors">
+  <meta property="og:site_name" content="Garden Weekly">
+  <script>var tracking = "ignored";</script>
+</head>
+<body>
+  <nav><a href="/">Home</a> <a href="/news">News</a></nav>
+  <div class="sidebar"><p>Subscribe to our newsletter, it is great, really, trust us, please.</p></div>
+  <article>
+    <header><h1>Growing basil indoors</h1><span class="byline">By Ada Gardener</span></header>
+    <div class="post-content">
+      <p>Basil loves warmth and light, so a sunny windowsill is the best place for it, even in winter.</p>
+      <p>Water it <em>regularly</em>, but let the soil dry out a little between waterings, or the roots rot.</p>
+      <h2>What you need</h2>
+      <ul>
+        <li>A pot with <a href="/drainage">drainage holes</a></li>
+        <li>Fresh potting soil</li>
+      </ul>
+      <pre>water(basil, ml=200)</pre>
+      <table><tr><th>Month</th><th>Height</th></tr><tr><td>May</td><td>10 cm</td></tr></table>
+      <p style="display:none">Hidden promotional text that should never show up in the output.</p>
+    </div>
+  </article>
+  <div id="comments"><p>Great article, thanks, I have been looking for this, bookmarked!</p></div>
+  <footer>Copyright Garden Weekly</footer>
+</body>
+</html>`
+
+func TestExtractHTMLContent(t *testing.T) {
+	base, _ := url.Parse("https://garden.example.com/basil")
+	content, err := ExtractHTMLContent(strings.NewReader(articlePage), base)
+	require.NoError(t, err)
+	require.Equal(t, "Growing basil indoors", content.Title)
+	require.Equal(t, "By Ada Gardener", content.Byline)
+	require.Equal(t, "Garden Weekly", content.SiteName)
+
+	// The heading repeating the title is left out with the header.
+	require.Equal(t, `Basil loves warmth and light, so a sunny windowsill is the best place for it, even in winter.
+
+Water it *regularly*, but let the soil dry out a little between waterings, or the roots rot.
+
+## What you need
+
+- A pot with [drainage holes](https://garden.example.com/drainage)
+- Fresh potting soil
+
+`+"```\nwater(basil, ml=200)\n```"+`
+
+| Month | Height |
+| --- | --- |
+| May | 10 cm |`, content.Markdown)
+
+	require.Contains(t, content.Text, "Water it regularly, but")
+	require.Contains(t, content.Text, "- A pot with drainage holes\n")
+	for _, clutter := range []string{"tracking", "Home", "newsletter", "Hidden", "bookmarked", "Copyright"} {
+		require.NotContains(t, content.Text, clutter)
+	}
+}
+
+func TestExtractHTMLContentWithoutText(t *testing.T) {
+	_, err := ExtractHTMLContent(strings.NewReader(`<html><body><nav><a href="/">Home</a></nav><img src="a.png"></body></html>`), nil)
+	require.ErrorIs(t, err, ErrNoContent)
+}
+
+func TestGetHTMLContentForInternal(t *testing.T) {
+	if _, err := GetHTMLContent(context.Background(), "http://127.0.0.1:8080/admin"); !errors.Is(err, ErrInternalIP) {
+		t.Errorf("Expected error for internal IP, got %v", err)
+	}
+}
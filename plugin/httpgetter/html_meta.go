@@ -6,6 +6,8 @@ import (
 	"net"
 	"net/http"
 	"net/url"
+	"syscall"
+	"time"
 
 	"github.com/pkg/errors"
 	"golang.org/x/net/html"
@@ -15,15 +17,43 @@ import (
 var ErrInternalIP = errors.New("internal IP addresses are not allowed")
 
 var httpClient = &http.Client{
-	CheckRedirect: func(req *http.Request, via []*http.Request) error {
-		if err := validateURL(req.URL.String()); err != nil {
-			return errors.Wrap(err, "redirect to internal IP")
-		}
-		if len(via) >= 10 {
-			return errors.New("too many redirects")
-		}
-		return nil
-	},
+	CheckRedirect: checkRedirect,
+}
+
+func checkRedirect(req *http.Request, via []*http.Request) error {
+	if err := ValidateURL(req.URL.String()); err != nil {
+		return errors.Wrap(err, "redirect to internal IP")
+	}
+	if len(via) >= 10 {
+		return errors.New("too many redirects")
+	}
+	return nil
+}
+
+// NewSafeClient returns an HTTP client for URLs that users supply. Besides
+// redirects to internal addresses, it refuses to connect to them at all, as a
+// host that passed ValidateURL may resolve elsewhere by the time of a request.
+func NewSafeClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || isInternalIP(ip) {
+				return errors.Wrap(ErrInternalIP, host)
+			}
+			return nil
+		},
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = dialer.DialContext
+	return &http.Client{
+		Transport:     transport,
+		CheckRedirect: checkRedirect,
+	}
 }
 
 type HTMLMeta struct {
@@ -33,7 +63,7 @@ type HTMLMeta struct {
 }
 
 func GetHTMLMeta(urlStr string) (*HTMLMeta, error) {
-	if err := validateURL(urlStr); err != nil {
+	if err := ValidateURL(urlStr); err != nil {
 		return nil, err
 	}
 
@@ -116,7 +146,9 @@ func extractMetaProperty(token html.Token, prop string) (content string, ok bool
 	return content, ok
 }
 
-func validateURL(urlStr string) error {
+// ValidateURL checks that urlStr is an http(s) URL whose host does not resolve
+// to an internal address, such as a loopback, private or link-local one.
+func ValidateURL(urlStr string) error {
 	u, err := url.Parse(urlStr)
 	if err != nil {
 		return errors.New("invalid URL format")
@@ -133,7 +165,7 @@ func validateURL(urlStr string) error {
 
 	// check if the hostname is an IP
 	if ip := net.ParseIP(host); ip != nil {
-		if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() {
+		if isInternalIP(ip) {
 			return errors.Wrap(ErrInternalIP, ip.String())
 		}
 		return nil
@@ -146,7 +178,7 @@ func validateURL(urlStr string) error {
 	}
 
 	for _, ip := range ips {
-		if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() {
+		if isInternalIP(ip) {
 			return errors.Wrapf(ErrInternalIP, "host=%s, ip=%s", host, ip.String())
 		}
 	}
@@ -154,6 +186,16 @@ func validateURL(urlStr string) error {
 	return nil
 }
 
+// sharedAddressSpace is the carrier-grade NAT range of RFC 6598, which is not
+// reachable from the internet either.
+var sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+func isInternalIP(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() ||
+		ip.IsUnspecified() || ip.IsMulticast() || ip.IsLinkLocalMulticast() ||
+		sharedAddressSpace.Contains(ip)
+}
+
 func enrichSiteMeta(url *url.URL, meta *HTMLMeta) {
 	if url.Hostname() == "www.youtube.com" {
 		if url.Path == "/watch" {
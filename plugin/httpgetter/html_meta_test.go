@@ -2,6 +2,9 @@ package httpgetter
 
 import (
 	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -30,3 +33,43 @@ func TestGetHTMLMetaForInternal(t *testing.T) {
 		t.Errorf("Expected error for resolved internal IP, got %v", err)
 	}
 }
+
+func TestIsInternalIP(t *testing.T) {
+	tests := []struct {
+		ip   string
+		want bool
+	}{
+		{"127.0.0.1", true},
+		{"::1", true},
+		{"10.1.2.3", true},
+		{"172.16.0.1", true},
+		{"192.168.0.1", true},
+		{"fd00::1", true},
+		{"169.254.169.254", true},
+		{"fe80::1", true},
+		{"0.0.0.0", true},
+		{"::", true},
+		{"224.0.0.1", true},
+		{"ff02::1", true},
+		{"100.64.0.1", true},
+		{"100.127.255.255", true},
+		{"::ffff:127.0.0.1", true},
+		{"8.8.8.8", false},
+		{"100.128.0.1", false},
+		{"2606:4700::1111", false},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.want, isInternalIP(net.ParseIP(tt.ip)), tt.ip)
+	}
+}
+
+func TestSafeClientForInternal(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	// The host is checked when connecting, not only when validating the URL.
+	_, err := NewSafeClient().Get(server.URL)
+	require.ErrorIs(t, err, ErrInternalIP)
+}
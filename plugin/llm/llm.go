@@ -0,0 +1,213 @@
+// Package llm provides a small, backend-agnostic client for chat completion,
+// tool calling, streaming and embedding models.
+package llm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Supported backends.
+const (
+	// BackendOpenRouter talks to OpenRouter's OpenAI-compatible API.
+	BackendOpenRouter = "openrouter"
+	// BackendOpenAI talks to any OpenAI-compatible API (OpenAI, vLLM, LM Studio, llama.cpp server, ...).
+	BackendOpenAI = "openai"
+	// BackendOllama talks to Ollama's native /api/chat and /api/embed endpoints.
+	BackendOllama = "ollama"
+)
+
+// Default base URLs used when Config.BaseURL is empty.
+const (
+	DefaultOpenRouterBaseURL = "https://openrouter.ai/api/v1"
+	DefaultOpenAIBaseURL     = "https://api.openai.com/v1"
+	DefaultOllamaBaseURL     = "http://localhost:11434"
+)
+
+// Message is a single chat message.
+type Message struct {
+	Role       string     `json:"role"`
+	Content    string     `json:"content"`
+	ToolCalls  []ToolCall `json:"tool_calls,omitempty"`
+	ToolCallID string     `json:"tool_call_id,omitempty"`
+}
+
+// ToolCall is a function call requested by the model.
+type ToolCall struct {
+	ID       string       `json:"id"`
+	Type     string       `json:"type"`
+	Function FunctionCall `json:"function"`
+}
+
+// FunctionCall holds the function name and its JSON-encoded arguments.
+type FunctionCall struct {
+	Name      string `json:"name"`
+	Arguments string `json:"arguments"`
+}
+
+// Tool describes a function the model may call.
+type Tool struct {
+	Type     string       `json:"type"`
+	Function ToolFunction `json:"function"`
+}
+
+// ToolFunction is the schema of a callable function.
+type ToolFunction struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description,omitempty"`
+	Parameters  map[string]any `json:"parameters,omitempty"`
+}
+
+// ChatRequest is a chat completion request.
+type ChatRequest struct {
+	// Model overrides the provider's default model when set.
+	Model    string
+	Messages []Message
+	Tools    []Tool
+}
+
+// ChatResponse is the assistant reply to a ChatRequest.
+type ChatResponse struct {
+	Message Message
+	// Usage is the token usage reported by the backend; zero when it reports none.
+	Usage Usage
+}
+
+// Usage is the number of tokens a chat completion consumed.
+type Usage struct {
+	PromptTokens     int
+	CompletionTokens int
+}
+
+// TotalTokens returns the prompt and completion tokens combined.
+func (u Usage) TotalTokens() int {
+	return u.PromptTokens + u.CompletionTokens
+}
+
+// Provider is a chat and embedding backend.
+type Provider interface {
+	// Model returns the default chat model.
+	Model() string
+	// ContextWindow returns how many tokens of prompt and reply the default
+	// chat model can take.
+	ContextWindow() int
+	// Chat sends a chat completion request and returns the complete reply,
+	// including any tool calls the model made.
+	Chat(ctx context.Context, req *ChatRequest) (*ChatResponse, error)
+	// ChatStream sends a streaming chat completion request, calling onDelta for
+	// every content fragment as it arrives, and returns the assembled reply.
+	// Tool calls are collected across chunks and returned whole.
+	ChatStream(ctx context.Context, req *ChatRequest, onDelta func(delta string)) (*ChatResponse, error)
+	// Embed returns one embedding vector per input text.
+	Embed(ctx context.Context, texts []string) ([][]float32, error)
+}
+
+// Config describes how to reach a backend.
+type Config struct {
+	// Backend is one of BackendOpenRouter, BackendOpenAI or BackendOllama.
+	Backend string
+	// BaseURL overrides the backend's default API base URL.
+	BaseURL string
+	// APIKey is sent as a bearer token when set.
+	APIKey string
+	// Model is the default chat model.
+	Model string
+	// EmbeddingModel is the model used by Embed.
+	EmbeddingModel string
+	// ContextWindow overrides the context window of Model in tokens. When 0,
+	// it is looked up by model name.
+	ContextWindow int
+}
+
+// NewProvider creates a Provider for the given config.
+func NewProvider(config *Config) (Provider, error) {
+	baseURL := strings.TrimRight(config.BaseURL, "/")
+	switch config.Backend {
+	case BackendOpenRouter:
+		if config.APIKey == "" {
+			return nil, errors.New("llm: openrouter requires an API key")
+		}
+		if baseURL == "" {
+			baseURL = DefaultOpenRouterBaseURL
+		}
+		return newOpenAIProvider(baseURL, config), nil
+	case BackendOpenAI:
+		if baseURL == "" {
+			baseURL = DefaultOpenAIBaseURL
+		}
+		return newOpenAIProvider(baseURL, config), nil
+	case BackendOllama:
+		if baseURL == "" {
+			baseURL = DefaultOllamaBaseURL
+		}
+		return newOllamaProvider(baseURL, config), nil
+	default:
+		return nil, errors.Errorf("llm: unsupported backend %q", config.Backend)
+	}
+}
+
+// EmbeddingFunc adapts a Provider to a single-text embedding function, the
+// shape expected by chromem-go.
+func EmbeddingFunc(p Provider) func(ctx context.Context, text string) ([]float32, error) {
+	return func(ctx context.Context, text string) ([]float32, error) {
+		vectors, err := p.Embed(ctx, []string{text})
+		if err != nil {
+			return nil, err
+		}
+		if len(vectors) != 1 {
+			return nil, errors.Errorf("llm: expected 1 embedding, got %d", len(vectors))
+		}
+		return vectors[0], nil
+	}
+}
+
+// Complete is a convenience wrapper for a single-turn prompt without tools.
+func Complete(ctx context.Context, p Provider, prompt string) (string, error) {
+	resp, err := p.Chat(ctx, &ChatRequest{
+		Messages: []Message{{Role: "user", Content: prompt}},
+	})
+	if err != nil {
+		return "", err
+	}
+	return resp.Message.Content, nil
+}
+
+// postJSON sends a JSON POST request and returns the response, which is guaranteed to
+// have a 200 status. The caller must close the body.
+func postJSON(ctx context.Context, client *http.Client, url, apiKey string, body any) (*http.Response, error) {
+	bodyBytes, err := json.Marshal(body)
+	if err != nil {
+		return nil, errors.Wrap(err, "llm: failed to marshal request")
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(bodyBytes))
+	if err != nil {
+		return nil, errors.Wrap(err, "llm: failed to build request")
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if apiKey != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+apiKey)
+	}
+
+	resp, err := client.Do(httpReq)
+	if err != nil {
+		return nil, errors.Wrap(err, "llm: request failed")
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		return nil, statusError(resp)
+	}
+	return resp, nil
+}
+
+// statusError builds an error from a non-200 response, including a short
+// excerpt of the body since backends put the useful detail there.
+func statusError(resp *http.Response) error {
+	excerpt, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	return errors.Errorf("llm: %s returned %s: %s", resp.Request.URL.Path, resp.Status, strings.TrimSpace(string(excerpt)))
+}
@@ -0,0 +1,223 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProvider(t *testing.T) {
+	_, err := NewProvider(&Config{Backend: BackendOpenRouter})
+	require.Error(t, err)
+
+	_, err = NewProvider(&Config{Backend: "unknown"})
+	require.Error(t, err)
+
+	p, err := NewProvider(&Config{Backend: BackendOllama, Model: "llama3.1"})
+	require.NoError(t, err)
+	require.Equal(t, "llama3.1", p.Model())
+}
+
+func TestOpenAIProviderChat(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/chat/completions", r.URL.Path)
+		require.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		var req openAIChatRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		require.Equal(t, "test-model", req.Model)
+		require.Len(t, req.Tools, 1)
+		fmt.Fprint(w, `{"choices":[{"message":{"role":"assistant","content":"","tool_calls":[{"id":"call_1","type":"function","function":{"name":"search","arguments":"{\"query\":\"go\"}"}}]}}],"usage":{"prompt_tokens":12,"completion_tokens":5}}`)
+	}))
+	defer server.Close()
+
+	p, err := NewProvider(&Config{Backend: BackendOpenAI, BaseURL: server.URL + "/v1/", APIKey: "secret", Model: "test-model"})
+	require.NoError(t, err)
+	resp, err := p.Chat(context.Background(), &ChatRequest{
+		Messages: []Message{{Role: "user", Content: "hi"}},
+		Tools:    []Tool{{Type: "function", Function: ToolFunction{Name: "search"}}},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Message.ToolCalls, 1)
+	require.Equal(t, "search", resp.Message.ToolCalls[0].Function.Name)
+	require.Equal(t, `{"query":"go"}`, resp.Message.ToolCalls[0].Function.Arguments)
+	require.Equal(t, Usage{PromptTokens: 12, CompletionTokens: 5}, resp.Usage)
+}
+
+func TestOpenAIProviderChatStream(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req openAIChatRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		require.True(t, req.StreamOptions.IncludeUsage)
+		fmt.Fprint(w, "data: {\"choices\":[{\"delta\":{\"content\":\"Hel\"}}]}\n\n")
+		fmt.Fprint(w, "data: {\"choices\":[{\"delta\":{\"content\":\"lo\"}}]}\n\n")
+		fmt.Fprint(w, "data: {\"choices\":[],\"usage\":{\"prompt_tokens\":7,\"completion_tokens\":2}}\n\n")
+		fmt.Fprint(w, "data: [DONE]\n\n")
+	}))
+	defer server.Close()
+
+	p, err := NewProvider(&Config{Backend: BackendOpenAI, BaseURL: server.URL, Model: "m"})
+	require.NoError(t, err)
+	var deltas []string
+	resp, err := p.ChatStream(context.Background(), &ChatRequest{}, func(delta string) {
+		deltas = append(deltas, delta)
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"Hel", "lo"}, deltas)
+	require.Equal(t, "Hello", resp.Message.Content)
+	require.Equal(t, Usage{PromptTokens: 7, CompletionTokens: 2}, resp.Usage)
+}
+
+func TestOpenAIProviderChatStreamToolCalls(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, "data: {\"choices\":[{\"delta\":{\"content\":\"Looking\"}}]}\n\n")
+		fmt.Fprint(w, "data: {\"choices\":[{\"delta\":{\"tool_calls\":[{\"index\":0,\"id\":\"call_a\",\"type\":\"function\",\"function\":{\"name\":\"search\",\"arguments\":\"\"}}]}}]}\n\n")
+		fmt.Fprint(w, "data: {\"choices\":[{\"delta\":{\"tool_calls\":[{\"index\":0,\"function\":{\"arguments\":\"{\\\"query\\\":\"}}]}}]}\n\n")
+		fmt.Fprint(w, "data: {\"choices\":[{\"delta\":{\"tool_calls\":[{\"index\":1,\"id\":\"call_b\",\"function\":{\"name\":\"stats\",\"arguments\":\"{}\"}}]}}]}\n\n")
+		fmt.Fprint(w, "data: {\"choices\":[{\"delta\":{\"tool_calls\":[{\"index\":0,\"function\":{\"arguments\":\"\\\"go\\\"}\"}}]}}]}\n\n")
+		fmt.Fprint(w, "data: [DONE]\n\n")
+	}))
+	defer server.Close()
+
+	p, err := NewProvider(&Config{Backend: BackendOpenAI, BaseURL: server.URL, Model: "m"})
+	require.NoError(t, err)
+	resp, err := p.ChatStream(context.Background(), &ChatRequest{}, func(string) {})
+	require.NoError(t, err)
+	require.Equal(t, "Looking", resp.Message.Content)
+	require.Equal(t, []ToolCall{
+		{ID: "call_a", Type: "function", Function: FunctionCall{Name: "search", Arguments: `{"query":"go"}`}},
+		{ID: "call_b", Type: "function", Function: FunctionCall{Name: "stats", Arguments: `{}`}},
+	}, resp.Message.ToolCalls)
+}
+
+func TestMergeToolCallDeltaWithoutIndex(t *testing.T) {
+	var calls []ToolCall
+	for _, raw := range []string{
+		`{"id":"a","function":{"name":"one","arguments":"{\"x\":"}}`,
+		`{"function":{"arguments":"1}"}}`,
+		`{"id":"b","function":{"name":"two","arguments":"{}"}}`,
+	} {
+		var d openAIToolCallDelta
+		require.NoError(t, json.Unmarshal([]byte(raw), &d))
+		calls = mergeToolCallDelta(calls, d)
+	}
+	require.Len(t, calls, 2)
+	require.Equal(t, `{"x":1}`, calls[0].Function.Arguments)
+	require.Equal(t, "two", calls[1].Function.Name)
+}
+
+func TestOpenAIProviderError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"error":"bad key"}`)
+	}))
+	defer server.Close()
+
+	p, err := NewProvider(&Config{Backend: BackendOpenAI, BaseURL: server.URL, Model: "m"})
+	require.NoError(t, err)
+	_, err = p.Chat(context.Background(), &ChatRequest{})
+	require.ErrorContains(t, err, "bad key")
+}
+
+func TestOpenAIProviderEmbed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/embeddings", r.URL.Path)
+		fmt.Fprint(w, `{"data":[{"index":1,"embedding":[0,1]},{"index":0,"embedding":[1,0]}]}`)
+	}))
+	defer server.Close()
+
+	p, err := NewProvider(&Config{Backend: BackendOpenAI, BaseURL: server.URL, EmbeddingModel: "e"})
+	require.NoError(t, err)
+	vectors, err := p.Embed(context.Background(), []string{"a", "b"})
+	require.NoError(t, err)
+	require.Equal(t, [][]float32{{1, 0}, {0, 1}}, vectors)
+}
+
+func TestOllamaProviderChat(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/chat", r.URL.Path)
+		var req ollamaChatRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		require.False(t, req.Stream)
+		require.Len(t, req.Messages, 2)
+		// Tool call arguments are sent as JSON objects, not strings.
+		require.JSONEq(t, `{"uid":"abc"}`, string(req.Messages[1].ToolCalls[0].Function.Arguments))
+		fmt.Fprint(w, `{"message":{"role":"assistant","content":"","tool_calls":[{"function":{"name":"delete","arguments":{"uid":"abc"}}}]},"done":true}`)
+	}))
+	defer server.Close()
+
+	p, err := NewProvider(&Config{Backend: BackendOllama, BaseURL: server.URL, Model: "m"})
+	require.NoError(t, err)
+	resp, err := p.Chat(context.Background(), &ChatRequest{
+		Messages: []Message{
+			{Role: "user", Content: "delete it"},
+			{Role: "assistant", ToolCalls: []ToolCall{{ID: "x", Type: "function", Function: FunctionCall{Name: "delete", Arguments: `{"uid":"abc"}`}}}},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Message.ToolCalls, 1)
+	require.Equal(t, "call_0", resp.Message.ToolCalls[0].ID)
+	require.JSONEq(t, `{"uid":"abc"}`, resp.Message.ToolCalls[0].Function.Arguments)
+}
+
+func TestOllamaProviderChatStreamAndEmbed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/chat":
+			fmt.Fprintln(w, `{"message":{"role":"assistant","content":"Hi"},"done":false}`)
+			fmt.Fprintln(w, `{"message":{"role":"assistant","content":" there"},"done":false}`)
+			fmt.Fprintln(w, `{"message":{"role":"assistant","content":""},"done":true,"prompt_eval_count":9,"eval_count":3}`)
+		case "/api/embed":
+			fmt.Fprint(w, `{"embeddings":[[0.5,0.5]]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	p, err := NewProvider(&Config{Backend: BackendOllama, BaseURL: server.URL, Model: "m", EmbeddingModel: "e"})
+	require.NoError(t, err)
+	resp, err := p.ChatStream(context.Background(), &ChatRequest{}, func(string) {})
+	require.NoError(t, err)
+	require.Equal(t, "Hi there", resp.Message.Content)
+	require.Equal(t, 12, resp.Usage.TotalTokens())
+
+	vector, err := EmbeddingFunc(p)(context.Background(), "text")
+	require.NoError(t, err)
+	require.Equal(t, []float32{0.5, 0.5}, vector)
+}
+
+func TestContextWindow(t *testing.T) {
+	require.Equal(t, 128_000, ModelContextWindow("openai/gpt-4o-mini"))
+	require.Equal(t, 8192, ModelContextWindow("gpt-4"))
+	require.Equal(t, 200_000, ModelContextWindow("anthropic/claude-sonnet-4"))
+	require.Equal(t, DefaultContextWindow, ModelContextWindow("stepfun/step-3.5-flash:free"))
+
+	p, err := NewProvider(&Config{Backend: BackendOpenAI, Model: "gpt-4o", ContextWindow: 64_000})
+	require.NoError(t, err)
+	require.Equal(t, 64_000, p.ContextWindow())
+
+	// Ollama only uses a larger window when every request asks for it.
+	p, err = NewProvider(&Config{Backend: BackendOllama, Model: "llama3.1"})
+	require.NoError(t, err)
+	require.Equal(t, ollamaDefaultContextWindow, p.ContextWindow())
+	require.Nil(t, p.(*ollamaProvider).chatRequest(&ChatRequest{}, false).Options)
+	p, err = NewProvider(&Config{Backend: BackendOllama, Model: "llama3.1", ContextWindow: 16_384})
+	require.NoError(t, err)
+	require.Equal(t, 16_384, p.(*ollamaProvider).chatRequest(&ChatRequest{}, false).Options.NumCtx)
+}
+
+func TestCountTokens(t *testing.T) {
+	// The embedded encoding is used, where the approximation would count 3.
+	require.Equal(t, 2, CountTokens("hello world"))
+}
+
+func TestApproximateTokens(t *testing.T) {
+	require.Equal(t, 0, approximateTokens(""))
+	require.Equal(t, 3, approximateTokens("hello world"))
+	require.Equal(t, 4, approximateTokens("你好世界"))
+}
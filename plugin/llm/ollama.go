@@ -0,0 +1,232 @@
+package llm
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ollamaProvider talks to Ollama's native /api/chat and /api/embed endpoints.
+type ollamaProvider struct {
+	baseURL        string
+	apiKey         string
+	model          string
+	embeddingModel string
+	// contextWindow is sent as num_ctx when set.
+	contextWindow int
+	client        *http.Client
+}
+
+func newOllamaProvider(baseURL string, config *Config) *ollamaProvider {
+	return &ollamaProvider{
+		baseURL:        baseURL,
+		apiKey:         config.APIKey,
+		model:          config.Model,
+		embeddingModel: config.EmbeddingModel,
+		contextWindow:  config.ContextWindow,
+		client:         http.DefaultClient,
+	}
+}
+
+func (p *ollamaProvider) Model() string {
+	return p.model
+}
+
+// ContextWindow returns the configured window, which every request asks
+// Ollama for, or else Ollama's own default.
+func (p *ollamaProvider) ContextWindow() int {
+	if p.contextWindow > 0 {
+		return p.contextWindow
+	}
+	return ollamaDefaultContextWindow
+}
+
+// ollamaMessage differs from Message in that tool call arguments are JSON
+// objects rather than strings, and tool calls carry no ID.
+type ollamaMessage struct {
+	Role      string           `json:"role"`
+	Content   string           `json:"content"`
+	ToolCalls []ollamaToolCall `json:"tool_calls,omitempty"`
+}
+
+type ollamaToolCall struct {
+	Function struct {
+		Name      string          `json:"name"`
+		Arguments json.RawMessage `json:"arguments"`
+	} `json:"function"`
+}
+
+type ollamaChatRequest struct {
+	Model    string          `json:"model"`
+	Messages []ollamaMessage `json:"messages"`
+	Tools    []Tool          `json:"tools,omitempty"`
+	Stream   bool            `json:"stream"`
+	Options  *ollamaOptions  `json:"options,omitempty"`
+}
+
+type ollamaOptions struct {
+	NumCtx int `json:"num_ctx,omitempty"`
+}
+
+type ollamaChatResponse struct {
+	Message ollamaMessage `json:"message"`
+	Done    bool          `json:"done"`
+	Error   string        `json:"error"`
+	// PromptEvalCount and EvalCount are the prompt and completion token
+	// counts, sent with the final response.
+	PromptEvalCount int `json:"prompt_eval_count"`
+	EvalCount       int `json:"eval_count"`
+}
+
+func (p *ollamaProvider) Chat(ctx context.Context, req *ChatRequest) (*ChatResponse, error) {
+	resp, err := postJSON(ctx, p.client, p.baseURL+"/api/chat", p.apiKey, p.chatRequest(req, false))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var apiResp ollamaChatResponse
+	if err := json.NewDecoder(resp.Body).Decode(&apiResp); err != nil {
+		return nil, errors.Wrap(err, "llm: failed to decode chat response")
+	}
+	if apiResp.Error != "" {
+		return nil, errors.Errorf("llm: %s", apiResp.Error)
+	}
+	return &ChatResponse{
+		Message: fromOllamaMessage(apiResp.Message, 0),
+		Usage:   Usage{PromptTokens: apiResp.PromptEvalCount, CompletionTokens: apiResp.EvalCount},
+	}, nil
+}
+
+func (p *ollamaProvider) ChatStream(ctx context.Context, req *ChatRequest, onDelta func(delta string)) (*ChatResponse, error) {
+	resp, err := postJSON(ctx, p.client, p.baseURL+"/api/chat", p.apiKey, p.chatRequest(req, true))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Ollama streams newline-delimited JSON objects, one per fragment.
+	var content strings.Builder
+	var toolCalls []ToolCall
+	var usage Usage
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		var chunk ollamaChatResponse
+		if err := json.Unmarshal([]byte(line), &chunk); err != nil {
+			continue
+		}
+		if chunk.Error != "" {
+			return nil, errors.Errorf("llm: %s", chunk.Error)
+		}
+		if chunk.Message.Content != "" {
+			content.WriteString(chunk.Message.Content)
+			onDelta(chunk.Message.Content)
+		}
+		// Tool calls arrive whole rather than as fragments.
+		if len(chunk.Message.ToolCalls) > 0 {
+			toolCalls = append(toolCalls, fromOllamaMessage(chunk.Message, len(toolCalls)).ToolCalls...)
+		}
+		if chunk.Done {
+			usage = Usage{PromptTokens: chunk.PromptEvalCount, CompletionTokens: chunk.EvalCount}
+			break
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "llm: failed to read chat stream")
+	}
+	return &ChatResponse{Message: Message{Role: "assistant", Content: content.String(), ToolCalls: toolCalls}, Usage: usage}, nil
+}
+
+func (p *ollamaProvider) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	if p.embeddingModel == "" {
+		return nil, errors.New("llm: no embedding model configured")
+	}
+	resp, err := postJSON(ctx, p.client, p.baseURL+"/api/embed", p.apiKey, map[string]any{
+		"model": p.embeddingModel,
+		"input": texts,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var apiResp struct {
+		Embeddings [][]float32 `json:"embeddings"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&apiResp); err != nil {
+		return nil, errors.Wrap(err, "llm: failed to decode embedding response")
+	}
+	if len(apiResp.Embeddings) != len(texts) {
+		return nil, errors.Errorf("llm: expected %d embeddings, got %d", len(texts), len(apiResp.Embeddings))
+	}
+	return apiResp.Embeddings, nil
+}
+
+func (p *ollamaProvider) chatRequest(req *ChatRequest, stream bool) *ollamaChatRequest {
+	model := req.Model
+	if model == "" {
+		model = p.model
+	}
+	messages := make([]ollamaMessage, 0, len(req.Messages))
+	for _, m := range req.Messages {
+		messages = append(messages, toOllamaMessage(m))
+	}
+	chatReq := &ollamaChatRequest{
+		Model:    model,
+		Messages: messages,
+		Tools:    req.Tools,
+		Stream:   stream,
+	}
+	if p.contextWindow > 0 {
+		chatReq.Options = &ollamaOptions{NumCtx: p.contextWindow}
+	}
+	return chatReq
+}
+
+func toOllamaMessage(m Message) ollamaMessage {
+	om := ollamaMessage{Role: m.Role, Content: m.Content}
+	for _, tc := range m.ToolCalls {
+		var otc ollamaToolCall
+		otc.Function.Name = tc.Function.Name
+		otc.Function.Arguments = json.RawMessage("{}")
+		if json.Valid([]byte(tc.Function.Arguments)) && strings.TrimSpace(tc.Function.Arguments) != "" {
+			otc.Function.Arguments = json.RawMessage(tc.Function.Arguments)
+		}
+		om.ToolCalls = append(om.ToolCalls, otc)
+	}
+	return om
+}
+
+// fromOllamaMessage converts an Ollama reply to a Message, synthesising the
+// tool call IDs that Ollama omits. offset keeps IDs unique across chunks.
+func fromOllamaMessage(om ollamaMessage, offset int) Message {
+	m := Message{Role: om.Role, Content: om.Content}
+	if m.Role == "" {
+		m.Role = "assistant"
+	}
+	for i, otc := range om.ToolCalls {
+		args := string(otc.Function.Arguments)
+		if args == "" || args == "null" {
+			args = "{}"
+		}
+		m.ToolCalls = append(m.ToolCalls, ToolCall{
+			ID:   fmt.Sprintf("call_%d", offset+i),
+			Type: "function",
+			Function: FunctionCall{
+				Name:      otc.Function.Name,
+				Arguments: args,
+			},
+		})
+	}
+	return m
+}
@@ -0,0 +1,252 @@
+package llm
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// openAIProvider talks to an OpenAI-compatible /chat/completions and /embeddings API.
+type openAIProvider struct {
+	baseURL        string
+	apiKey         string
+	model          string
+	embeddingModel string
+	contextWindow  int
+	client         *http.Client
+}
+
+func newOpenAIProvider(baseURL string, config *Config) *openAIProvider {
+	return &openAIProvider{
+		baseURL:        baseURL,
+		apiKey:         config.APIKey,
+		model:          config.Model,
+		embeddingModel: config.EmbeddingModel,
+		contextWindow:  config.ContextWindow,
+		client:         http.DefaultClient,
+	}
+}
+
+func (p *openAIProvider) Model() string {
+	return p.model
+}
+
+func (p *openAIProvider) ContextWindow() int {
+	if p.contextWindow > 0 {
+		return p.contextWindow
+	}
+	return ModelContextWindow(p.model)
+}
+
+type openAIChatRequest struct {
+	Model         string               `json:"model"`
+	Messages      []Message            `json:"messages"`
+	Tools         []Tool               `json:"tools,omitempty"`
+	Stream        bool                 `json:"stream,omitempty"`
+	StreamOptions *openAIStreamOptions `json:"stream_options,omitempty"`
+}
+
+// openAIStreamOptions asks for the usage to be sent in a final chunk, since
+// streamed responses otherwise omit it.
+type openAIStreamOptions struct {
+	IncludeUsage bool `json:"include_usage"`
+}
+
+type openAIUsage struct {
+	PromptTokens     int `json:"prompt_tokens"`
+	CompletionTokens int `json:"completion_tokens"`
+}
+
+type openAIChatResponse struct {
+	Choices []struct {
+		Message Message `json:"message"`
+	} `json:"choices"`
+	Usage *openAIUsage `json:"usage"`
+}
+
+type openAIStreamChunk struct {
+	Choices []struct {
+		Delta struct {
+			Content   string                `json:"content"`
+			ToolCalls []openAIToolCallDelta `json:"tool_calls"`
+		} `json:"delta"`
+	} `json:"choices"`
+	Usage *openAIUsage `json:"usage"`
+}
+
+// openAIToolCallDelta is a fragment of a streamed tool call. The first
+// fragment for a call carries its ID and name; later ones append arguments.
+type openAIToolCallDelta struct {
+	Index    *int   `json:"index"`
+	ID       string `json:"id"`
+	Type     string `json:"type"`
+	Function struct {
+		Name      string `json:"name"`
+		Arguments string `json:"arguments"`
+	} `json:"function"`
+}
+
+func (p *openAIProvider) Chat(ctx context.Context, req *ChatRequest) (*ChatResponse, error) {
+	resp, err := postJSON(ctx, p.client, p.baseURL+"/chat/completions", p.apiKey, p.chatRequest(req, false))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var apiResp openAIChatResponse
+	if err := json.NewDecoder(resp.Body).Decode(&apiResp); err != nil {
+		return nil, errors.Wrap(err, "llm: failed to decode chat response")
+	}
+	if len(apiResp.Choices) == 0 {
+		return nil, errors.New("llm: empty response from model")
+	}
+	return &ChatResponse{Message: apiResp.Choices[0].Message, Usage: apiResp.Usage.toUsage()}, nil
+}
+
+func (p *openAIProvider) ChatStream(ctx context.Context, req *ChatRequest, onDelta func(delta string)) (*ChatResponse, error) {
+	resp, err := postJSON(ctx, p.client, p.baseURL+"/chat/completions", p.apiKey, p.chatRequest(req, true))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var content strings.Builder
+	var toolCalls []ToolCall
+	var usage Usage
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, "data: ") {
+			continue
+		}
+		payload := strings.TrimPrefix(line, "data: ")
+		if payload == "[DONE]" {
+			break
+		}
+		var chunk openAIStreamChunk
+		if err := json.Unmarshal([]byte(payload), &chunk); err != nil {
+			continue
+		}
+		if chunk.Usage != nil {
+			usage = chunk.Usage.toUsage()
+		}
+		for _, ch := range chunk.Choices {
+			if ch.Delta.Content != "" {
+				content.WriteString(ch.Delta.Content)
+				onDelta(ch.Delta.Content)
+			}
+			for _, d := range ch.Delta.ToolCalls {
+				toolCalls = mergeToolCallDelta(toolCalls, d)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "llm: failed to read chat stream")
+	}
+	for i := range toolCalls {
+		if toolCalls[i].ID == "" {
+			toolCalls[i].ID = fmt.Sprintf("call_%d", i)
+		}
+		if toolCalls[i].Type == "" {
+			toolCalls[i].Type = "function"
+		}
+	}
+	return &ChatResponse{Message: Message{Role: "assistant", Content: content.String(), ToolCalls: toolCalls}, Usage: usage}, nil
+}
+
+func (u *openAIUsage) toUsage() Usage {
+	if u == nil {
+		return Usage{}
+	}
+	return Usage{PromptTokens: u.PromptTokens, CompletionTokens: u.CompletionTokens}
+}
+
+// mergeToolCallDelta folds a streamed tool call fragment into calls.
+// Fragments are matched by index; some backends omit the index, in which case
+// a fragment carrying a new ID starts a new call and anything else continues
+// the last one.
+func mergeToolCallDelta(calls []ToolCall, d openAIToolCallDelta) []ToolCall {
+	i := len(calls) - 1
+	switch {
+	case d.Index != nil:
+		i = *d.Index
+	case d.ID != "" && (i < 0 || calls[i].ID != d.ID):
+		i = len(calls)
+	}
+	if i < 0 {
+		i = 0
+	}
+	for len(calls) <= i {
+		calls = append(calls, ToolCall{})
+	}
+	call := &calls[i]
+	if d.ID != "" {
+		call.ID = d.ID
+	}
+	if d.Type != "" {
+		call.Type = d.Type
+	}
+	if d.Function.Name != "" {
+		call.Function.Name = d.Function.Name
+	}
+	call.Function.Arguments += d.Function.Arguments
+	return calls
+}
+
+func (p *openAIProvider) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	if p.embeddingModel == "" {
+		return nil, errors.New("llm: no embedding model configured")
+	}
+	resp, err := postJSON(ctx, p.client, p.baseURL+"/embeddings", p.apiKey, map[string]any{
+		"model": p.embeddingModel,
+		"input": texts,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var apiResp struct {
+		Data []struct {
+			Index     int       `json:"index"`
+			Embedding []float32 `json:"embedding"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&apiResp); err != nil {
+		return nil, errors.Wrap(err, "llm: failed to decode embedding response")
+	}
+	if len(apiResp.Data) != len(texts) {
+		return nil, errors.Errorf("llm: expected %d embeddings, got %d", len(texts), len(apiResp.Data))
+	}
+	vectors := make([][]float32, len(texts))
+	for _, d := range apiResp.Data {
+		if d.Index < 0 || d.Index >= len(vectors) {
+			return nil, errors.Errorf("llm: embedding index %d out of range", d.Index)
+		}
+		vectors[d.Index] = d.Embedding
+	}
+	return vectors, nil
+}
+
+func (p *openAIProvider) chatRequest(req *ChatRequest, stream bool) *openAIChatRequest {
+	model := req.Model
+	if model == "" {
+		model = p.model
+	}
+	chatReq := &openAIChatRequest{
+		Model:    model,
+		Messages: req.Messages,
+		Tools:    req.Tools,
+		Stream:   stream,
+	}
+	if stream {
+		chatReq.StreamOptions = &openAIStreamOptions{IncludeUsage: true}
+	}
+	return chatReq
+}
@@ -0,0 +1,99 @@
+package llm
+
+import (
+	"log/slog"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/pkoukk/tiktoken-go"
+	tiktoken_loader "github.com/pkoukk/tiktoken-go-loader"
+)
+
+// DefaultContextWindow is the context window assumed for models whose window
+// is neither configured nor known.
+const DefaultContextWindow = 32_768
+
+// ollamaDefaultContextWindow is the context Ollama gives a model unless the
+// request raises num_ctx, whatever the model itself supports.
+const ollamaDefaultContextWindow = 4096
+
+// modelContextWindows maps model name prefixes to context windows in tokens.
+// The first matching prefix wins, so more specific prefixes come first.
+var modelContextWindows = []struct {
+	prefix string
+	tokens int
+}{
+	{"gpt-4.1", 1_047_576},
+	{"gpt-5", 400_000},
+	{"gpt-4o", 128_000},
+	{"gpt-4-turbo", 128_000},
+	{"gpt-4", 8192},
+	{"gpt-3.5", 16_385},
+	{"o1", 200_000},
+	{"o3", 200_000},
+	{"o4", 200_000},
+	{"claude", 200_000},
+	{"gemini", 1_048_576},
+	{"llama-3", 128_000},
+	{"llama3", 128_000},
+	{"deepseek", 64_000},
+	{"mistral", 32_768},
+	{"qwen", 32_768},
+}
+
+// ModelContextWindow returns the context window of model in tokens, or
+// DefaultContextWindow when the model is not known.
+func ModelContextWindow(model string) int {
+	model = strings.ToLower(model)
+	// Drop the vendor of routed models, as in "openai/gpt-4o".
+	if i := strings.LastIndex(model, "/"); i >= 0 {
+		model = model[i+1:]
+	}
+	for _, w := range modelContextWindows {
+		if strings.HasPrefix(model, w.prefix) {
+			return w.tokens
+		}
+	}
+	return DefaultContextWindow
+}
+
+var (
+	encodingOnce sync.Once
+	encoding     *tiktoken.Tiktoken
+)
+
+// CountTokens estimates how many tokens text takes up in a prompt. It uses
+// OpenAI's cl100k_base encoding, which is close enough for other model
+// families too. The encoding is embedded in the binary, so nothing is
+// downloaded; if it cannot be loaded, a character-based approximation is used
+// instead.
+func CountTokens(text string) int {
+	encodingOnce.Do(func() {
+		tiktoken.SetBpeLoader(tiktoken_loader.NewOfflineLoader())
+		enc, err := tiktoken.GetEncoding("cl100k_base")
+		if err != nil {
+			slog.Warn("failed to load tokenizer, approximating token counts", "err", err)
+			return
+		}
+		encoding = enc
+	})
+	if encoding != nil {
+		return len(encoding.EncodeOrdinary(text))
+	}
+	return approximateTokens(text)
+}
+
+// approximateTokens counts about four ASCII characters per token, and one per
+// other character since scripts such as CJK take up a token or more each.
+func approximateTokens(text string) int {
+	ascii, other := 0, 0
+	for _, r := range text {
+		if r < utf8.RuneSelf {
+			ascii++
+		} else {
+			other++
+		}
+	}
+	return (ascii+3)/4 + other
+}
@@ -0,0 +1,157 @@
+package markdown
+
+import (
+	"bytes"
+	"strings"
+	"unicode/utf8"
+
+	gast "github.com/yuin/goldmark/ast"
+)
+
+// Chunk is a passage of markdown content split at block boundaries.
+type Chunk struct {
+	// Start and End are byte offsets of the passage in the original content.
+	Start int
+	End   int
+	// Text is content[Start:End].
+	Text string
+}
+
+// Chunk splits content into passages of at most maxLength bytes.
+// Top-level blocks are kept whole where possible, a heading always starts a new
+// passage so sections stay together, and blocks longer than maxLength are split
+// at line and then word boundaries.
+func (s *service) Chunk(content []byte, maxLength int) ([]Chunk, error) {
+	root, err := s.parse(content)
+	if err != nil {
+		return nil, err
+	}
+
+	// Each top-level block spans from the start of its first line to the start
+	// of the next block, so markers, fences and blank lines are all covered.
+	var starts []int
+	var headings []bool
+	for n := root.FirstChild(); n != nil; n = n.NextSibling() {
+		start, ok := blockStart(n, content)
+		if !ok {
+			continue
+		}
+		if len(starts) > 0 && start <= starts[len(starts)-1] {
+			continue
+		}
+		starts = append(starts, start)
+		headings = append(headings, n.Kind() == gast.KindHeading)
+	}
+
+	var chunks []Chunk
+	curStart, curEnd := -1, -1
+	flush := func() {
+		if curStart >= 0 {
+			chunks = appendChunk(chunks, content, curStart, curEnd, maxLength)
+		}
+		curStart, curEnd = -1, -1
+	}
+	for i, start := range starts {
+		// The first passage also takes anything before the first located block.
+		if i == 0 {
+			start = 0
+		}
+		end := len(content)
+		if i+1 < len(starts) {
+			end = starts[i+1]
+		}
+		if curStart >= 0 && (headings[i] || end-curStart > maxLength) {
+			flush()
+		}
+		if curStart < 0 {
+			curStart = start
+		}
+		curEnd = end
+	}
+	flush()
+	return chunks, nil
+}
+
+// blockStart returns the offset of the beginning of the line on which a block starts.
+func blockStart(n gast.Node, content []byte) (int, bool) {
+	start := -1
+	_ = gast.Walk(n, func(c gast.Node, entering bool) (gast.WalkStatus, error) {
+		if !entering {
+			return gast.WalkContinue, nil
+		}
+		at := -1
+		if c.Type() == gast.TypeBlock {
+			if lines := c.Lines(); lines != nil && lines.Len() > 0 {
+				at = lines.At(0).Start
+			}
+		} else if text, ok := c.(*gast.Text); ok {
+			// Some blocks (e.g. tables) only carry positions on their text.
+			at = text.Segment.Start
+		}
+		if at >= 0 && (start < 0 || at < start) {
+			start = at
+		}
+		return gast.WalkContinue, nil
+	})
+	// Fenced code lines exclude the opening fence, which sits one line above.
+	if fence, ok := n.(*gast.FencedCodeBlock); ok {
+		if fence.Info != nil {
+			start = fence.Info.Segment.Start
+		} else if start >= 0 {
+			if ls := lineStart(content, start); ls > 0 {
+				start = lineStart(content, ls-1)
+			}
+		}
+	}
+	if start < 0 {
+		return 0, false
+	}
+	return lineStart(content, start), true
+}
+
+func lineStart(content []byte, offset int) int {
+	if offset > len(content) {
+		offset = len(content)
+	}
+	return bytes.LastIndexByte(content[:offset], '\n') + 1
+}
+
+// appendChunk trims [start, end) and appends it, splitting it further if it is
+// longer than maxLength.
+func appendChunk(chunks []Chunk, content []byte, start, end, maxLength int) []Chunk {
+	for start < end && isSpace(content[start]) {
+		start++
+	}
+	for end > start && isSpace(content[end-1]) {
+		end--
+	}
+	if start == end {
+		return chunks
+	}
+	if end-start <= maxLength {
+		return append(chunks, Chunk{Start: start, End: end, Text: string(content[start:end])})
+	}
+
+	// Cut at the last line break that fits, else the last space, else the
+	// last rune boundary.
+	window := content[start : start+maxLength]
+	cut := bytes.LastIndexByte(window, '\n')
+	if cut <= 0 {
+		cut = bytes.LastIndexFunc(window, func(r rune) bool { return r == ' ' || r == '\t' })
+	}
+	if cut <= 0 {
+		cut = maxLength
+		for cut > 0 && !utf8.RuneStart(content[start+cut]) {
+			cut--
+		}
+		if cut == 0 {
+			cut = maxLength
+		}
+	}
+	chunks = appendChunk(chunks, content, start, start+cut, maxLength)
+	return appendChunk(chunks, content, start+cut, end, maxLength)
+}
+
+func isSpace(b byte) bool {
+	return strings.IndexByte(" \t\r\n", b) >= 0
+}
@@ -51,6 +51,9 @@ type Service interface {
 
 	// RenameTag renames all occurrences of oldTag to newTag in content
 	RenameTag(content []byte, oldTag, newTag string) (string, error)
+
+	// Chunk splits content into passages of at most maxLength bytes at block boundaries
+	Chunk(content []byte, maxLength int) ([]Chunk, error)
 }
 
 // service implements the Service interface.
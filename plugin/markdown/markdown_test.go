@@ -523,3 +523,53 @@ func BenchmarkExtractProperties(b *testing.B) {
 		}
 	}
 }
+
+func TestChunk(t *testing.T) {
+	svc := NewService(WithTagExtension())
+
+	t.Run("empty content", func(t *testing.T) {
+		chunks, err := svc.Chunk([]byte(""), 100)
+		require.NoError(t, err)
+		assert.Empty(t, chunks)
+	})
+
+	t.Run("headings start new passages", func(t *testing.T) {
+		content := "# One\n\nFirst paragraph.\n\n- a\n- b\n\n## Two\n\n```go\nfmt.Println()\n```\n"
+		chunks, err := svc.Chunk([]byte(content), 1000)
+		require.NoError(t, err)
+		require.Len(t, chunks, 2)
+		assert.Equal(t, "# One\n\nFirst paragraph.\n\n- a\n- b", chunks[0].Text)
+		assert.Equal(t, "## Two\n\n```go\nfmt.Println()\n```", chunks[1].Text)
+		for _, c := range chunks {
+			assert.Equal(t, content[c.Start:c.End], c.Text)
+		}
+	})
+
+	t.Run("blocks are packed up to the limit", func(t *testing.T) {
+		content := "aaaa aaaa\n\nbbbb bbbb\n\ncccc cccc\n"
+		chunks, err := svc.Chunk([]byte(content), 22)
+		require.NoError(t, err)
+		require.Len(t, chunks, 2)
+		assert.Equal(t, "aaaa aaaa\n\nbbbb bbbb", chunks[0].Text)
+		assert.Equal(t, "cccc cccc", chunks[1].Text)
+	})
+
+	t.Run("oversized blocks are split at word boundaries", func(t *testing.T) {
+		content := "one two three four five six"
+		chunks, err := svc.Chunk([]byte(content), 10)
+		require.NoError(t, err)
+		require.Greater(t, len(chunks), 1)
+		for _, c := range chunks {
+			assert.LessOrEqual(t, len(c.Text), 10)
+			assert.Equal(t, content[c.Start:c.End], c.Text)
+		}
+	})
+
+	t.Run("fenced code without info keeps its fence", func(t *testing.T) {
+		content := "Intro\n\n## Code\n\n```\nx := 1\n```"
+		chunks, err := svc.Chunk([]byte(content), 1000)
+		require.NoError(t, err)
+		require.Len(t, chunks, 2)
+		assert.Equal(t, "## Code\n\n```\nx := 1\n```", chunks[1].Text)
+	})
+}
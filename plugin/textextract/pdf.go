@@ -0,0 +1,952 @@
+package textextract
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+
+	"golang.org/x/text/encoding/charmap"
+)
+
+// PDF text is read from the text showing operators of the pages' content
+// streams. The file is scanned for objects instead of being read through its
+// cross-reference table, which is often damaged, and character codes are
+// decoded with the fonts' ToUnicode maps. Scanned PDFs without a text layer
+// yield no text.
+
+const (
+	// maxPDFStreamSize caps the decoded size of a single stream.
+	maxPDFStreamSize = 64 << 20
+	// maxPDFDecodedSize caps the decoded size of all streams of a file.
+	maxPDFDecodedSize = 256 << 20
+	// maxPDFDepth bounds nesting, in values, page trees and form XObjects.
+	maxPDFDepth = 32
+	// maxPDFOperators caps the operators run over all content streams of a
+	// file, which forms drawn many times over could otherwise multiply.
+	maxPDFOperators = 1 << 20
+)
+
+// ErrEncrypted is returned for encrypted PDFs, whose text cannot be read
+// without decrypting them.
+var ErrEncrypted = errors.New("encrypted PDFs are not supported")
+
+type (
+	pdfName    string
+	pdfString  string
+	pdfKeyword string
+	pdfArray   []any
+	pdfDict    map[pdfName]any
+	pdfRef     struct{ num, gen int }
+)
+
+// pdfObject is an indirect object: a value and, for streams, their still
+// encoded data.
+type pdfObject struct {
+	value   any
+	raw     []byte
+	decoded []byte
+	done    bool
+}
+
+type pdfFile struct {
+	objects   map[int]*pdfObject
+	root      any
+	encrypted bool
+	fonts     map[int]*pdfFont
+	// decoded is the decoded size of the streams so far.
+	decoded int
+	// operators is the number of operators run so far.
+	operators int
+	// showing holds the form XObjects being shown, to break cycles.
+	showing map[int]bool
+}
+
+var pdfObjectPattern = regexp.MustCompile(`(\d+)\s+(\d+)\s+obj\b`)
+
+func extractPDF(data []byte) (text string, err error) {
+	// The file is untrusted; a bug in reading a malformed one must not take
+	// the server down with it.
+	defer func() {
+		if r := recover(); r != nil {
+			text, err = "", fmt.Errorf("malformed PDF: %v", r)
+		}
+	}()
+	return readPDF(data)
+}
+
+func readPDF(data []byte) (string, error) {
+	if !bytes.Contains(data[:min(len(data), 1024)], []byte("%PDF-")) {
+		return "", errors.New("not a PDF file")
+	}
+	f := parsePDF(data)
+	if f.encrypted {
+		return "", ErrEncrypted
+	}
+	w := &textWriter{}
+	for _, page := range f.pages() {
+		f.showText(f.contents(page["Contents"]), f.resources(page), w, 0)
+		w.paragraph()
+		if w.full() {
+			break
+		}
+	}
+	// Runs of text often carry their own spaces at either end of a line.
+	lines := strings.Split(w.String(), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(line)
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+func parsePDF(data []byte) *pdfFile {
+	f := &pdfFile{
+		objects: make(map[int]*pdfObject),
+		fonts:   make(map[int]*pdfFont),
+		showing: make(map[int]bool),
+	}
+	var trailers []pdfDict
+	for pos := 0; pos < len(data); {
+		loc := pdfObjectPattern.FindSubmatchIndex(data[pos:])
+		if loc == nil {
+			break
+		}
+		num, _ := strconv.Atoi(string(data[pos+loc[2] : pos+loc[3]]))
+		l := &pdfLexer{data: data, pos: pos + loc[1]}
+		value, _ := l.value(0)
+		obj := &pdfObject{value: value}
+		if dict, ok := value.(pdfDict); ok {
+			obj.raw = l.stream(dict)
+			// Cross-reference streams double as the trailer.
+			if dict["Type"] == pdfName("XRef") {
+				trailers = append(trailers, dict)
+			}
+		}
+		// Later revisions of an object replace earlier ones.
+		f.objects[num] = obj
+		pos = max(l.pos, pos+loc[1])
+	}
+	for i := 0; ; {
+		at := bytes.Index(data[i:], []byte("trailer"))
+		if at < 0 {
+			break
+		}
+		l := &pdfLexer{data: data, pos: i + at + len("trailer")}
+		if dict, ok := l.value(0); ok {
+			if dict, ok := dict.(pdfDict); ok {
+				trailers = append(trailers, dict)
+			}
+		}
+		i += at + len("trailer")
+	}
+	for _, trailer := range trailers {
+		if trailer["Encrypt"] != nil {
+			f.encrypted = true
+		}
+		if trailer["Root"] != nil {
+			f.root = trailer["Root"]
+		}
+	}
+
+	// Objects of PDF 1.5 and later may be packed in object streams.
+	nums := make([]int, 0, len(f.objects))
+	for num := range f.objects {
+		nums = append(nums, num)
+	}
+	sort.Ints(nums)
+	for _, num := range nums {
+		obj := f.objects[num]
+		dict, ok := obj.value.(pdfDict)
+		if !ok || dict["Type"] != pdfName("ObjStm") {
+			continue
+		}
+		f.unpackObjectStream(dict, f.decode(obj))
+	}
+	return f
+}
+
+func (f *pdfFile) unpackObjectStream(dict pdfDict, data []byte) {
+	n, first := int(pdfNumber(dict["N"])), int(pdfNumber(dict["First"]))
+	header := &pdfLexer{data: data}
+	for i := 0; i < n; i++ {
+		num, ok1 := header.token()
+		offset, ok2 := header.token()
+		if !ok1 || !ok2 {
+			return
+		}
+		objNum := int(pdfNumber(num))
+		if _, exists := f.objects[objNum]; exists {
+			continue
+		}
+		l := &pdfLexer{data: data, pos: first + int(pdfNumber(offset))}
+		if l.pos < 0 || l.pos >= len(data) {
+			continue
+		}
+		if value, ok := l.value(0); ok {
+			f.objects[objNum] = &pdfObject{value: value}
+		}
+	}
+}
+
+// decode returns the decoded data of a stream, or nil if it uses a filter
+// other than FlateDecode.
+func (f *pdfFile) decode(obj *pdfObject) []byte {
+	if obj == nil || obj.raw == nil {
+		return nil
+	}
+	if obj.done {
+		return obj.decoded
+	}
+	obj.done = true
+	dict, _ := obj.value.(pdfDict)
+	var filters []any
+	switch v := f.resolve(dict["Filter"]).(type) {
+	case pdfName:
+		filters = []any{v}
+	case pdfArray:
+		filters = v
+	}
+	data := obj.raw
+	for _, filter := range filters {
+		switch f.resolve(filter) {
+		case pdfName("FlateDecode"), pdfName("Fl"):
+			data = inflate(data, min(maxPDFStreamSize, maxPDFDecodedSize-f.decoded))
+		default:
+			return nil
+		}
+	}
+	f.decoded += len(data)
+	obj.decoded = data
+	return data
+}
+
+// inflate decompresses data, up to limit bytes.
+func inflate(data []byte, limit int) []byte {
+	if limit <= 0 {
+		return nil
+	}
+	var r io.Reader
+	if zr, err := zlib.NewReader(bytes.NewReader(data)); err == nil {
+		r = zr
+	} else {
+		r = flate.NewReader(bytes.NewReader(data))
+	}
+	// Keep what was inflated before any error, e.g. from a cut off stream.
+	out, _ := io.ReadAll(io.LimitReader(r, int64(limit)))
+	return out
+}
+
+// resolve follows references to the value they point to.
+func (f *pdfFile) resolve(v any) any {
+	for i := 0; i < maxPDFDepth; i++ {
+		ref, ok := v.(pdfRef)
+		if !ok {
+			return v
+		}
+		obj := f.objects[ref.num]
+		if obj == nil {
+			return nil
+		}
+		v = obj.value
+	}
+	return nil
+}
+
+func (f *pdfFile) dict(v any) pdfDict {
+	d, _ := f.resolve(v).(pdfDict)
+	return d
+}
+
+// stream returns the decoded data of a referenced stream.
+func (f *pdfFile) stream(v any) []byte {
+	ref, ok := v.(pdfRef)
+	if !ok {
+		return nil
+	}
+	return f.decode(f.objects[ref.num])
+}
+
+// pages returns the page dictionaries in reading order.
+func (f *pdfFile) pages() []pdfDict {
+	var pages []pdfDict
+	seen := make(map[int]bool)
+	var walk func(v any, depth int)
+	walk = func(v any, depth int) {
+		if ref, ok := v.(pdfRef); ok {
+			if seen[ref.num] {
+				return
+			}
+			seen[ref.num] = true
+		}
+		node := f.dict(v)
+		if node == nil || depth > maxPDFDepth {
+			return
+		}
+		if kids, ok := f.resolve(node["Kids"]).(pdfArray); ok {
+			for _, kid := range kids {
+				walk(kid, depth+1)
+			}
+			return
+		}
+		if node["Type"] == pdfName("Page") || node["Contents"] != nil {
+			pages = append(pages, node)
+		}
+	}
+	if root := f.dict(f.root); root != nil {
+		walk(root["Pages"], 0)
+	}
+	if len(pages) > 0 {
+		return pages
+	}
+
+	// Without a usable page tree, take the pages in object order.
+	nums := make([]int, 0, len(f.objects))
+	for num, obj := range f.objects {
+		if dict, ok := obj.value.(pdfDict); ok && dict["Type"] == pdfName("Page") {
+			nums = append(nums, num)
+		}
+	}
+	sort.Ints(nums)
+	for _, num := range nums {
+		pages = append(pages, f.objects[num].value.(pdfDict))
+	}
+	return pages
+}
+
+// resources returns the resources of a page, which may be inherited from the
+// page tree.
+func (f *pdfFile) resources(page pdfDict) pdfDict {
+	for i := 0; page != nil && i < maxPDFDepth; i++ {
+		if resources := f.dict(page["Resources"]); resources != nil {
+			return resources
+		}
+		page = f.dict(page["Parent"])
+	}
+	return nil
+}
+
+// contents returns the content stream of a page, which may be split over
+// several streams.
+func (f *pdfFile) contents(v any) []byte {
+	if arr, ok := f.resolve(v).(pdfArray); ok {
+		var parts [][]byte
+		for _, part := range arr {
+			parts = append(parts, f.stream(part))
+		}
+		return bytes.Join(parts, []byte("\n"))
+	}
+	return f.stream(v)
+}
+
+// showText writes the text a content stream shows.
+func (f *pdfFile) showText(content []byte, resources pdfDict, w *textWriter, depth int) {
+	if depth > maxPDFDepth {
+		return
+	}
+	fonts := make(map[pdfName]*pdfFont)
+	if fontDict := f.dict(resources["Font"]); fontDict != nil {
+		for name, v := range fontDict {
+			fonts[name] = f.font(v)
+		}
+	}
+	var font *pdfFont
+	var lastY float64
+	l := &pdfLexer{data: content}
+	var operands []any
+	for !w.full() {
+		tok, ok := l.value(0)
+		if !ok {
+			break
+		}
+		op, isOp := tok.(pdfKeyword)
+		if !isOp {
+			operands = append(operands, tok)
+			continue
+		}
+		if f.operators++; f.operators > maxPDFOperators {
+			return
+		}
+		var last any
+		if len(operands) > 0 {
+			last = operands[len(operands)-1]
+		}
+		switch op {
+		case "Tf":
+			if len(operands) >= 2 {
+				if name, ok := operands[len(operands)-2].(pdfName); ok {
+					font = fonts[name]
+				}
+			}
+		case "Tj":
+			if s, ok := last.(pdfString); ok {
+				w.text(font.decode(s))
+			}
+		case "'", `"`:
+			w.newline()
+			if s, ok := last.(pdfString); ok {
+				w.text(font.decode(s))
+			}
+		case "TJ":
+			arr, _ := last.(pdfArray)
+			for _, item := range arr {
+				switch item := item.(type) {
+				case pdfString:
+					w.text(font.decode(item))
+				case float64:
+					// Large negative adjustments, in thousandths of a
+					// unit of text space, separate words.
+					if item < -180 {
+						w.space()
+					}
+				}
+			}
+		case "Td", "TD":
+			if len(operands) >= 2 && pdfNumber(last) != 0 {
+				w.newline()
+			} else {
+				w.space()
+			}
+		case "Tm":
+			if len(operands) >= 6 {
+				if y := pdfNumber(last); y != lastY {
+					lastY = y
+					w.newline()
+				} else {
+					w.space()
+				}
+			}
+		case "T*":
+			w.newline()
+		case "Do":
+			name, _ := last.(pdfName)
+			xobjects := f.dict(resources["XObject"])
+			ref, ok := xobjects[name].(pdfRef)
+			// A form may not draw itself, directly or through other forms.
+			if !ok || f.showing[ref.num] {
+				break
+			}
+			form := f.dict(ref)
+			if form["Subtype"] != pdfName("Form") {
+				break
+			}
+			formResources := f.dict(form["Resources"])
+			if formResources == nil {
+				formResources = resources
+			}
+			f.showing[ref.num] = true
+			f.showText(f.stream(ref), formResources, w, depth+1)
+			delete(f.showing, ref.num)
+		case "ID":
+			l.skipInlineImage()
+		}
+		operands = operands[:0]
+	}
+}
+
+// pdfFont decodes the character codes of a font to text.
+type pdfFont struct {
+	// codeLength is the length of the character codes in bytes.
+	codeLength int
+	// toUnicode maps character codes to text. Without it, single byte codes
+	// are read as Windows-1252, which covers the standard encodings of
+	// Latin text, and longer codes are dropped.
+	toUnicode map[uint32]string
+}
+
+func (f *pdfFile) font(v any) *pdfFont {
+	ref, isRef := v.(pdfRef)
+	if isRef {
+		if font, ok := f.fonts[ref.num]; ok {
+			return font
+		}
+	}
+	font := &pdfFont{codeLength: 1}
+	dict := f.dict(v)
+	if dict["Subtype"] == pdfName("Type0") {
+		font.codeLength = 2
+	}
+	if data := f.stream(dict["ToUnicode"]); data != nil {
+		var codeLength int
+		font.toUnicode, codeLength = parseCMap(data)
+		if codeLength > 0 {
+			font.codeLength = codeLength
+		}
+	}
+	if isRef {
+		f.fonts[ref.num] = font
+	}
+	return font
+}
+
+func (font *pdfFont) decode(s pdfString) string {
+	codeLength := 1
+	if font != nil {
+		codeLength = font.codeLength
+	}
+	var sb strings.Builder
+	for i := 0; i+codeLength <= len(s); i += codeLength {
+		var code uint32
+		for j := 0; j < codeLength; j++ {
+			code = code<<8 | uint32(s[i+j])
+		}
+		if font != nil {
+			if text, ok := font.toUnicode[code]; ok {
+				sb.WriteString(text)
+				continue
+			}
+		}
+		if codeLength == 1 {
+			sb.WriteRune(charmap.Windows1252.DecodeByte(s[i]))
+		}
+	}
+	return sb.String()
+}
+
+// parseCMap reads the mappings of a ToUnicode CMap and the length of its
+// character codes, or 0 if it declares no code space.
+func parseCMap(data []byte) (map[uint32]string, int) {
+	mapping := make(map[uint32]string)
+	codeLength := 0
+	l := &pdfLexer{data: data}
+	var operands []any
+	for {
+		tok, ok := l.value(0)
+		if !ok {
+			break
+		}
+		op, isOp := tok.(pdfKeyword)
+		if !isOp {
+			operands = append(operands, tok)
+			continue
+		}
+		switch op {
+		case "endcodespacerange":
+			if s, ok := firstOf(operands).(pdfString); ok && codeLength == 0 {
+				codeLength = len(s)
+			}
+		case "endbfchar":
+			for i := 0; i+1 < len(operands); i += 2 {
+				src, ok1 := operands[i].(pdfString)
+				dst, ok2 := operands[i+1].(pdfString)
+				if ok1 && ok2 {
+					mapping[cmapCode(src)] = decodeUTF16(dst)
+				}
+			}
+		case "endbfrange":
+			for i := 0; i+2 < len(operands); i += 3 {
+				lo, ok1 := operands[i].(pdfString)
+				hi, ok2 := operands[i+1].(pdfString)
+				if !ok1 || !ok2 {
+					continue
+				}
+				first, last := cmapCode(lo), cmapCode(hi)
+				if last < first || last-first > 0xFFFF {
+					continue
+				}
+				switch dst := operands[i+2].(type) {
+				case pdfString:
+					// Consecutive codes map to consecutive last characters.
+					units := utf16.Encode([]rune(decodeUTF16(dst)))
+					if len(units) == 0 {
+						continue
+					}
+					for code := first; code <= last; code++ {
+						units[len(units)-1] += uint16(code - first)
+						mapping[code] = string(utf16.Decode(units))
+						units[len(units)-1] -= uint16(code - first)
+					}
+				case pdfArray:
+					for j, item := range dst {
+						if s, ok := item.(pdfString); ok && first+uint32(j) <= last {
+							mapping[first+uint32(j)] = decodeUTF16(s)
+						}
+					}
+				}
+			}
+		}
+		operands = operands[:0]
+	}
+	return mapping, codeLength
+}
+
+func firstOf(values []any) any {
+	if len(values) == 0 {
+		return nil
+	}
+	return values[0]
+}
+
+func cmapCode(s pdfString) uint32 {
+	var code uint32
+	for i := 0; i < len(s) && i < 4; i++ {
+		code = code<<8 | uint32(s[i])
+	}
+	return code
+}
+
+func decodeUTF16(s pdfString) string {
+	units := make([]uint16, 0, len(s)/2)
+	for i := 0; i+1 < len(s); i += 2 {
+		units = append(units, uint16(s[i])<<8|uint16(s[i+1]))
+	}
+	return string(utf16.Decode(units))
+}
+
+func pdfNumber(v any) float64 {
+	n, _ := v.(float64)
+	return n
+}
+
+// textWriter joins the shown text, adding the separators asked for between
+// runs of text.
+type textWriter struct {
+	sb strings.Builder
+	// pending is the separator to write before the next text.
+	pending string
+}
+
+func (w *textWriter) separate(sep string) {
+	if w.sb.Len() > 0 && len(sep) > len(w.pending) {
+		w.pending = sep
+	}
+}
+
+func (w *textWriter) space()     { w.separate(" ") }
+func (w *textWriter) newline()   { w.separate("\n") }
+func (w *textWriter) paragraph() { w.separate("\n\n") }
+
+func (w *textWriter) text(s string) {
+	s = strings.Map(func(r rune) rune {
+		if r == '\t' {
+			return ' '
+		}
+		if unicode.IsControl(r) || r == unicode.ReplacementChar {
+			return -1
+		}
+		return r
+	}, s)
+	if s == "" {
+		return
+	}
+	w.sb.WriteString(w.pending)
+	w.pending = ""
+	w.sb.WriteString(s)
+}
+
+func (w *textWriter) full() bool { return w.sb.Len() >= MaxTextLength }
+
+func (w *textWriter) String() string { return w.sb.String() }
+
+// pdfLexer reads the tokens and values of PDF syntax, which content streams
+// and CMaps share with the file itself.
+type pdfLexer struct {
+	data []byte
+	pos  int
+}
+
+func isPDFSpace(c byte) bool {
+	switch c {
+	case 0, '\t', '\n', '\f', '\r', ' ':
+		return true
+	}
+	return false
+}
+
+func isPDFDelimiter(c byte) bool {
+	return strings.IndexByte("()<>[]{}/%", c) >= 0
+}
+
+func (l *pdfLexer) skipSpace() {
+	for l.pos < len(l.data) {
+		c := l.data[l.pos]
+		if c == '%' {
+			for l.pos < len(l.data) && l.data[l.pos] != '\n' && l.data[l.pos] != '\r' {
+				l.pos++
+			}
+			continue
+		}
+		if !isPDFSpace(c) {
+			return
+		}
+		l.pos++
+	}
+}
+
+// token reads a number, name, string or keyword. Delimiters of arrays and
+// dictionaries are returned as keywords.
+func (l *pdfLexer) token() (any, bool) {
+	l.pos = min(l.pos, len(l.data))
+	l.skipSpace()
+	if l.pos >= len(l.data) {
+		return nil, false
+	}
+	switch c := l.data[l.pos]; c {
+	case '/':
+		l.pos++
+		return l.name(), true
+	case '(':
+		l.pos++
+		return l.literal(), true
+	case '<':
+		if l.pos+1 < len(l.data) && l.data[l.pos+1] == '<' {
+			l.pos += 2
+			return pdfKeyword("<<"), true
+		}
+		l.pos++
+		return l.hex(), true
+	case '>':
+		if l.pos+1 < len(l.data) && l.data[l.pos+1] == '>' {
+			l.pos += 2
+			return pdfKeyword(">>"), true
+		}
+		l.pos++
+		return pdfKeyword(">"), true
+	case '[', ']', '{', '}', ')':
+		l.pos++
+		return pdfKeyword(c), true
+	}
+	start := l.pos
+	for l.pos < len(l.data) && !isPDFSpace(l.data[l.pos]) && !isPDFDelimiter(l.data[l.pos]) {
+		l.pos++
+	}
+	word := string(l.data[start:l.pos])
+	if strings.IndexByte("+-.0123456789", word[0]) >= 0 {
+		if n, err := strconv.ParseFloat(word, 64); err == nil {
+			return n, true
+		}
+	}
+	return pdfKeyword(word), true
+}
+
+// value reads a value, combining arrays, dictionaries and references.
+func (l *pdfLexer) value(depth int) (any, bool) {
+	tok, ok := l.token()
+	if !ok {
+		return nil, false
+	}
+	switch t := tok.(type) {
+	case pdfKeyword:
+		if depth > maxPDFDepth {
+			return t, true
+		}
+		switch t {
+		case "[":
+			arr := pdfArray{}
+			for {
+				l.skipSpace()
+				if l.pos >= len(l.data) {
+					return arr, true
+				}
+				if l.data[l.pos] == ']' {
+					l.pos++
+					return arr, true
+				}
+				v, ok := l.value(depth + 1)
+				if !ok {
+					return arr, true
+				}
+				arr = append(arr, v)
+			}
+		case "<<":
+			dict := pdfDict{}
+			for {
+				l.skipSpace()
+				if l.pos >= len(l.data) {
+					return dict, true
+				}
+				if bytes.HasPrefix(l.data[l.pos:], []byte(">>")) {
+					l.pos += 2
+					return dict, true
+				}
+				key, ok := l.value(depth + 1)
+				if !ok || key == pdfKeyword("endobj") || key == pdfKeyword("stream") {
+					// An unterminated dictionary.
+					return dict, true
+				}
+				name, isName := key.(pdfName)
+				if !isName {
+					// Skip stray tokens, such as an unbalanced "]".
+					continue
+				}
+				v, ok := l.value(depth + 1)
+				if !ok {
+					return dict, true
+				}
+				dict[name] = v
+			}
+		case "null":
+			return nil, true
+		case "true":
+			return true, true
+		case "false":
+			return false, true
+		}
+	case float64:
+		// "num gen R" is a reference.
+		if t >= 0 && t == float64(int(t)) {
+			pos := l.pos
+			if gen, ok := l.token(); ok {
+				if g, isNum := gen.(float64); isNum {
+					if r, ok := l.token(); ok && r == pdfKeyword("R") {
+						return pdfRef{num: int(t), gen: int(g)}, true
+					}
+				}
+			}
+			l.pos = pos
+		}
+	}
+	return tok, true
+}
+
+func (l *pdfLexer) name() pdfName {
+	var buf []byte
+	for l.pos < len(l.data) && !isPDFSpace(l.data[l.pos]) && !isPDFDelimiter(l.data[l.pos]) {
+		c := l.data[l.pos]
+		l.pos++
+		if c == '#' && l.pos+1 < len(l.data) {
+			if b, err := strconv.ParseUint(string(l.data[l.pos:l.pos+2]), 16, 8); err == nil {
+				buf = append(buf, byte(b))
+				l.pos += 2
+				continue
+			}
+		}
+		buf = append(buf, c)
+	}
+	return pdfName(buf)
+}
+
+func (l *pdfLexer) literal() pdfString {
+	var buf []byte
+	depth := 1
+	for l.pos < len(l.data) {
+		c := l.data[l.pos]
+		l.pos++
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return pdfString(buf)
+			}
+		case '\\':
+			if l.pos >= len(l.data) {
+				continue
+			}
+			e := l.data[l.pos]
+			l.pos++
+			switch e {
+			case 'n':
+				c = '\n'
+			case 'r':
+				c = '\r'
+			case 't':
+				c = '\t'
+			case 'b':
+				c = '\b'
+			case 'f':
+				c = '\f'
+			case '\r':
+				// A line continuation.
+				if l.pos < len(l.data) && l.data[l.pos] == '\n' {
+					l.pos++
+				}
+				continue
+			case '\n':
+				continue
+			case '0', '1', '2', '3', '4', '5', '6', '7':
+				n := int(e - '0')
+				for i := 0; i < 2 && l.pos < len(l.data) && l.data[l.pos] >= '0' && l.data[l.pos] <= '7'; i++ {
+					n = n*8 + int(l.data[l.pos]-'0')
+					l.pos++
+				}
+				c = byte(n)
+			default:
+				c = e
+			}
+		}
+		buf = append(buf, c)
+	}
+	return pdfString(buf)
+}
+
+func (l *pdfLexer) hex() pdfString {
+	var digits []byte
+	for l.pos < len(l.data) && l.data[l.pos] != '>' {
+		if c := l.data[l.pos]; !isPDFSpace(c) {
+			digits = append(digits, c)
+		}
+		l.pos++
+	}
+	// Skip the closing ">", unless the data ended before it.
+	if l.pos < len(l.data) {
+		l.pos++
+	}
+	if len(digits)%2 == 1 {
+		digits = append(digits, '0')
+	}
+	buf := make([]byte, 0, len(digits)/2)
+	for i := 0; i < len(digits); i += 2 {
+		b, err := strconv.ParseUint(string(digits[i:i+2]), 16, 8)
+		if err != nil {
+			continue
+		}
+		buf = append(buf, byte(b))
+	}
+	return pdfString(buf)
+}
+
+// stream reads the raw data of a stream following its dictionary, or returns
+// nil if no stream follows.
+func (l *pdfLexer) stream(dict pdfDict) []byte {
+	l.pos = min(l.pos, len(l.data))
+	l.skipSpace()
+	if !bytes.HasPrefix(l.data[l.pos:], []byte("stream")) {
+		return nil
+	}
+	l.pos += len("stream")
+	if l.pos < len(l.data) && l.data[l.pos] == '\r' {
+		l.pos++
+	}
+	if l.pos < len(l.data) && l.data[l.pos] == '\n' {
+		l.pos++
+	}
+	start := l.pos
+	// Trust a direct length only if the stream ends there.
+	if n, ok := dict["Length"].(float64); ok && n >= 0 && start+int(n) <= len(l.data) {
+		end := start + int(n)
+		rest := bytes.TrimLeft(l.data[end:min(len(l.data), end+32)], "\x00\t\n\f\r ")
+		if bytes.HasPrefix(rest, []byte("endstream")) {
+			l.pos = end
+			return l.data[start:end]
+		}
+	}
+	at := bytes.Index(l.data[start:], []byte("endstream"))
+	if at < 0 {
+		l.pos = len(l.data)
+		return l.data[start:]
+	}
+	l.pos = start + at + len("endstream")
+	data := l.data[start : start+at]
+	data = bytes.TrimSuffix(data, []byte("\n"))
+	return bytes.TrimSuffix(data, []byte("\r"))
+}
+
+// skipInlineImage skips the data of an inline image, up to its EI operator.
+func (l *pdfLexer) skipInlineImage() {
+	for i := l.pos + 1; i+1 < len(l.data); i++ {
+		if l.data[i] == 'E' && l.data[i+1] == 'I' && isPDFSpace(l.data[i-1]) && (i+2 == len(l.data) || isPDFSpace(l.data[i+2])) {
+			l.pos = i + 2
+			return
+		}
+	}
+	l.pos = len(l.data)
+}
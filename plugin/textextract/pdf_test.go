@@ -0,0 +1,155 @@
+package textextract
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// buildPDF lays out objects numbered from 1, with the catalog as object 1.
+func buildPDF(trailer string, objects ...string) []byte {
+	var b bytes.Buffer
+	b.WriteString("%PDF-1.7\n%\xE2\xE3\xCF\xD3\n")
+	for i, obj := range objects {
+		fmt.Fprintf(&b, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+	fmt.Fprintf(&b, "trailer\n<< /Root 1 0 R %s >>\n%%%%EOF\n", trailer)
+	return b.Bytes()
+}
+
+func stream(dict, data string) string {
+	return fmt.Sprintf("<< %s /Length %d >>\nstream\n%s\nendstream", dict, len(data), data)
+}
+
+func flateStream(dict, data string) string {
+	var b bytes.Buffer
+	w := zlib.NewWriter(&b)
+	w.Write([]byte(data))
+	w.Close()
+	return stream(dict+" /Filter /FlateDecode", b.String())
+}
+
+func TestExtractPDF(t *testing.T) {
+	font := "<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>"
+	pdf := buildPDF("",
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		// The page tree lists the second page object first.
+		"<< /Type /Pages /Kids [4 0 R 3 0 R] /Count 2 /Resources << /Font << /F1 5 0 R >> >> >>",
+		"<< /Type /Page /Parent 2 0 R /Contents 6 0 R >>",
+		"<< /Type /Page /Parent 2 0 R /Contents [7 0 R 8 0 R] >>",
+		font,
+		stream("", "BT /F1 12 Tf 72 700 Td (Second page) Tj ET"),
+		stream("", "BT /F1 12 Tf 72 700 Td (Quarterly \\(Q3\\) report) Tj 0 -14 Td [(Re) 20 (venue gr) -10 (ew) -250 (12%)] TJ ET"),
+		flateStream("", "BT /F1 12 Tf 72 600 Td (Caf\\351 \\223quotes\\224) Tj T* (next line) Tj ET"),
+	)
+	text, err := Extract("application/pdf", "report.pdf", pdf)
+	require.NoError(t, err)
+	require.Equal(t, "Quarterly (Q3) report\nRevenue grew 12%\nCafé “quotes”\nnext line\n\nSecond page", text)
+}
+
+func TestExtractPDFToUnicode(t *testing.T) {
+	cmap := `/CIDInit /ProcSet findresource begin
+12 dict begin
+begincmap
+1 begincodespacerange
+<0000> <FFFF>
+endcodespacerange
+2 beginbfchar
+<0003> <0020>
+<0010> <00660069>
+endbfchar
+1 beginbfrange
+<0020> <0025> <0061>
+endbfrange
+endcmap
+end end`
+	// The page and font dictionaries live in an object stream.
+	packed := "<< /Type /Page /Parent 2 0 R /Resources << /Font << /F1 4 0 R >> >> /Contents 5 0 R >> << /Type /Font /Subtype /Type0 /BaseFont /ABCDEF+Sans /Encoding /Identity-H /ToUnicode 6 0 R >>"
+	header := fmt.Sprintf("3 0 4 %d ", len("<< /Type /Page /Parent 2 0 R /Resources << /Font << /F1 4 0 R >> >> /Contents 5 0 R >> "))
+	pdf := buildPDF("",
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"", "",
+		// "fi bad" drawn with glyph codes.
+		flateStream("", "BT /F1 11 Tf 1 0 0 1 72 700 Tm <00100003002100200023> Tj ET"),
+		flateStream("", cmap),
+		flateStream(fmt.Sprintf("/Type /ObjStm /N 2 /First %d", len(header)), header+packed),
+	)
+	// Drop the empty placeholders for objects 3 and 4.
+	pdf = bytes.Replace(pdf, []byte("3 0 obj\n\nendobj\n"), nil, 1)
+	pdf = bytes.Replace(pdf, []byte("4 0 obj\n\nendobj\n"), nil, 1)
+
+	text, err := Extract("application/pdf", "glyphs.pdf", pdf)
+	require.NoError(t, err)
+	require.Equal(t, "fi bad", text)
+}
+
+func TestExtractPDFWithoutText(t *testing.T) {
+	scanned := buildPDF("",
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /Resources << /XObject << /Im1 5 0 R >> >> /Contents 4 0 R >>",
+		stream("", "q 612 0 0 792 0 0 cm /Im1 Do Q BI /W 1 /H 1 /BPC 8 /CS /G ID \x00 EI"),
+		stream("/Type /XObject /Subtype /Image /Width 1 /Height 1 /Filter /DCTDecode", "\xFF\xD8\xFF"),
+	)
+	_, err := Extract("application/pdf", "scan.pdf", scanned)
+	require.ErrorIs(t, err, ErrNoText)
+
+	encrypted := buildPDF("/Encrypt 3 0 R",
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [] /Count 0 >>",
+		"<< /Filter /Standard /V 2 >>",
+	)
+	_, err = Extract("application/pdf", "secret.pdf", encrypted)
+	require.ErrorIs(t, err, ErrEncrypted)
+
+	_, err = Extract("application/pdf", "fake.pdf", []byte("hello"))
+	require.Error(t, err)
+}
+
+func TestExtractPDFForms(t *testing.T) {
+	// A form that draws itself is drawn once.
+	pdf := buildPDF("",
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /Resources << /XObject << /X0 5 0 R >> >> /Contents 4 0 R >>",
+		stream("", "/X0 Do"),
+		stream("/Type /XObject /Subtype /Form /Resources << /XObject << /X0 5 0 R >> >>", "BT (Inside) Tj ET /X0 Do /X0 Do"),
+	)
+	text, err := Extract("application/pdf", "loop.pdf", pdf)
+	require.NoError(t, err)
+	require.Equal(t, "Inside", text)
+
+	// Forms that each draw the next one twice stop at the operator cap.
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /Resources << /XObject << /X 4 0 R >> >> /Contents 4 0 R >>",
+	}
+	for i := 0; i < maxPDFDepth-2; i++ {
+		objects = append(objects, stream(fmt.Sprintf("/Type /XObject /Subtype /Form /Resources << /XObject << /X %d 0 R >> >>", len(objects)+2), "/X Do /X Do"))
+	}
+	objects = append(objects, stream("/Type /XObject /Subtype /Form", "BT (Leaf) Tj ET"))
+	text, err = Extract("application/pdf", "bomb.pdf", buildPDF("", objects...))
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(text, "Leaf"))
+}
+
+func FuzzExtract(f *testing.F) {
+	f.Add([]byte("%PDF-0 0 obj<<<"))
+	f.Add([]byte("%PDF-1 0 obj<</Length 9>>stream\n"))
+	f.Add(buildPDF("",
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /Contents 4 0 R >>",
+		stream("", "BT (Hello) Tj <48656C6C6F> Tj ET"),
+	))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// readPDF, unlike extractPDF, does not recover, so a panic fails.
+		_, _ = readPDF(data)
+	})
+}
@@ -0,0 +1,144 @@
+// Package textextract pulls the plain text out of attachments, such as text
+// files and PDFs with a text layer, so that they can be searched. Everything
+// runs locally; nothing is sent to external services.
+package textextract
+
+import (
+	"bytes"
+	"errors"
+	"mime"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding/unicode"
+)
+
+const (
+	// MaxFileSize is the largest file text is extracted from, in bytes.
+	MaxFileSize = 32 << 20
+	// MaxTextLength caps the extracted text, in bytes. Longer text is cut.
+	MaxTextLength = 1 << 20
+)
+
+var (
+	// ErrUnsupported is returned for files whose type has no extractor.
+	ErrUnsupported = errors.New("unsupported file type")
+	// ErrTooLarge is returned for files larger than MaxFileSize.
+	ErrTooLarge = errors.New("file too large")
+	// ErrNoText is returned when a file holds no text, such as a scanned PDF
+	// without a text layer.
+	ErrNoText = errors.New("no text found")
+)
+
+type format int
+
+const (
+	formatNone format = iota
+	formatText
+	formatPDF
+)
+
+// textTypes are the media types, beside text/plain, read as plain text.
+var textTypes = map[string]bool{
+	"text/plain":                true,
+	"text/markdown":             true,
+	"text/x-markdown":           true,
+	"text/csv":                  true,
+	"text/tab-separated-values": true,
+	"application/csv":           true,
+	"application/json":          true,
+	"application/ld+json":       true,
+	"application/x-ndjson":      true,
+}
+
+// textExtensions are the file extensions read as plain text when the media
+// type does not tell, e.g. for "application/octet-stream".
+var textExtensions = map[string]bool{
+	".txt":      true,
+	".text":     true,
+	".md":       true,
+	".markdown": true,
+	".csv":      true,
+	".tsv":      true,
+	".json":     true,
+	".ndjson":   true,
+	".log":      true,
+}
+
+func detect(mimeType, filename string) format {
+	mediaType, _, err := mime.ParseMediaType(mimeType)
+	if err != nil {
+		mediaType = strings.ToLower(strings.TrimSpace(mimeType))
+	}
+	switch {
+	case mediaType == "application/pdf":
+		return formatPDF
+	case textTypes[mediaType]:
+		return formatText
+	}
+	ext := strings.ToLower(filepath.Ext(filename))
+	switch {
+	case ext == ".pdf":
+		return formatPDF
+	case textExtensions[ext]:
+		return formatText
+	}
+	return formatNone
+}
+
+// Supported reports whether text can be extracted from files of the media
+// type, or failing that, with the filename's extension.
+func Supported(mimeType, filename string) bool {
+	return detect(mimeType, filename) != formatNone
+}
+
+// Extract returns the plain text of a file, cut to MaxTextLength.
+func Extract(mimeType, filename string, data []byte) (string, error) {
+	f := detect(mimeType, filename)
+	if f == formatNone {
+		return "", ErrUnsupported
+	}
+	if len(data) > MaxFileSize {
+		return "", ErrTooLarge
+	}
+	var text string
+	switch f {
+	case formatPDF:
+		var err error
+		if text, err = extractPDF(data); err != nil {
+			return "", err
+		}
+	default:
+		text = decodeText(data)
+	}
+	text = strings.TrimSpace(strings.ReplaceAll(text, "\r\n", "\n"))
+	if text == "" {
+		return "", ErrNoText
+	}
+	return truncate(text, MaxTextLength), nil
+}
+
+// decodeText decodes UTF-8 text, or UTF-16 text starting with a byte order
+// mark. Invalid bytes are replaced.
+func decodeText(data []byte) string {
+	if bytes.HasPrefix(data, []byte{0xFE, 0xFF}) || bytes.HasPrefix(data, []byte{0xFF, 0xFE}) {
+		decoded, err := unicode.UTF16(unicode.BigEndian, unicode.UseBOM).NewDecoder().Bytes(data)
+		if err == nil {
+			return string(decoded)
+		}
+	}
+	data = bytes.TrimPrefix(data, []byte("\xEF\xBB\xBF"))
+	return strings.ToValidUTF8(string(data), "�")
+}
+
+// truncate cuts text to at most n bytes at a rune boundary.
+func truncate(text string, n int) string {
+	if len(text) <= n {
+		return text
+	}
+	for n > 0 && !utf8.RuneStart(text[n]) {
+		n--
+	}
+	return text[:n]
+}
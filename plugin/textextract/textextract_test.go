@@ -0,0 +1,57 @@
+package textextract
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSupported(t *testing.T) {
+	tests := []struct {
+		mimeType string
+		filename string
+		want     bool
+	}{
+		{"text/plain", "notes.txt", true},
+		{"text/markdown; charset=utf-8", "README", true},
+		{"text/csv", "data.csv", true},
+		{"application/json", "data.json", true},
+		{"application/pdf", "report.pdf", true},
+		{"application/octet-stream", "report.PDF", true},
+		{"application/octet-stream", "notes.md", true},
+		{"image/png", "photo.png", false},
+		{"text/html", "page.html", false},
+		{"application/zip", "archive.zip", false},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.want, Supported(tt.mimeType, tt.filename), "%s %s", tt.mimeType, tt.filename)
+	}
+}
+
+func TestExtractText(t *testing.T) {
+	text, err := Extract("text/csv", "data.csv", []byte("\xEF\xBB\xBFname,city\r\nAda,London\r\n"))
+	require.NoError(t, err)
+	require.Equal(t, "name,city\nAda,London", text)
+
+	text, err = Extract("text/plain", "utf16.txt", []byte{0xFF, 0xFE, 'h', 0, 'i', 0})
+	require.NoError(t, err)
+	require.Equal(t, "hi", text)
+
+	text, err = Extract("text/plain", "broken.txt", []byte("ok \xff"))
+	require.NoError(t, err)
+	require.Equal(t, "ok �", text)
+
+	text, err = Extract("text/markdown", "long.md", []byte(strings.Repeat("é", MaxTextLength)))
+	require.NoError(t, err)
+	require.Len(t, text, MaxTextLength)
+
+	_, err = Extract("text/plain", "blank.txt", []byte(" \n\t"))
+	require.ErrorIs(t, err, ErrNoText)
+
+	_, err = Extract("image/png", "photo.png", []byte("\x89PNG"))
+	require.ErrorIs(t, err, ErrUnsupported)
+
+	_, err = Extract("text/plain", "huge.txt", make([]byte, MaxFileSize+1))
+	require.ErrorIs(t, err, ErrTooLarge)
+}
@@ -0,0 +1,312 @@
+package vectorstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"sync"
+
+	chromem "github.com/philippgille/chromem-go"
+)
+
+// manifestFileName is the file in an index directory that records how the
+// index was built. chromem skips files at the top of its directory.
+const manifestFileName = "manifest.json"
+
+// Collection metadata keys recording how the vectors were made. chromem
+// persists collection metadata but has no way to read it back, so the
+// manifest is the authoritative copy.
+const (
+	CollectionMetadataModel     = "embedding_model"
+	CollectionMetadataDimension = "embedding_dimension"
+)
+
+// chromemBackend keeps each index in a persistent chromem database under
+// dataDir. chromem holds every collection in memory.
+type chromemBackend struct {
+	dataDir string
+}
+
+// NewChromemBackend returns a backend storing indexes under
+// dataDir/vectorstore/.
+func NewChromemBackend(dataDir string) (Backend, error) {
+	b := &chromemBackend{dataDir: dataDir}
+	activeDir, _, oldDir := b.dirs()
+	// Finish a swap of indexes that was interrupted.
+	if _, err := os.Stat(oldDir); err == nil {
+		if _, err := os.Stat(activeDir); err == nil {
+			if err := os.RemoveAll(oldDir); err != nil {
+				return nil, fmt.Errorf("remove replaced vectorstore: %w", err)
+			}
+		} else if err := os.Rename(oldDir, activeDir); err != nil {
+			return nil, fmt.Errorf("restore vectorstore: %w", err)
+		}
+	}
+	return b, nil
+}
+
+// dirs returns the directories of the active index, of the index being built,
+// and of an index being replaced.
+func (b *chromemBackend) dirs() (active, next, old string) {
+	active = filepath.Join(b.dataDir, "vectorstore")
+	return active, active + ".next", active + ".old"
+}
+
+func (b *chromemBackend) dir(slot Slot) string {
+	activeDir, nextDir, _ := b.dirs()
+	if slot == SlotNext {
+		return nextDir
+	}
+	return activeDir
+}
+
+func (b *chromemBackend) Open(_ context.Context, slot Slot) (VectorIndex, error) {
+	return openChromemIndex(b.dir(slot))
+}
+
+func (b *chromemBackend) Remove(_ context.Context, slot Slot) error {
+	return os.RemoveAll(b.dir(slot))
+}
+
+func (b *chromemBackend) Promote(_ context.Context) (VectorIndex, error) {
+	activeDir, nextDir, oldDir := b.dirs()
+	if err := os.Rename(activeDir, oldDir); err != nil {
+		return nil, fmt.Errorf("move old vectorstore: %w", err)
+	}
+	if err := os.Rename(nextDir, activeDir); err != nil {
+		return nil, fmt.Errorf("move new vectorstore: %w", err)
+	}
+	if err := os.RemoveAll(oldDir); err != nil {
+		slog.Warn("failed to remove old vectorstore", "err", err)
+	}
+	// The collections of the new index know their files by the old path.
+	return openChromemIndex(activeDir)
+}
+
+// chromemIndex is an index in a chromem database.
+type chromemIndex struct {
+	db  *chromem.DB
+	dir string
+
+	mu       sync.RWMutex
+	manifest Manifest
+}
+
+func openChromemIndex(dir string) (*chromemIndex, error) {
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return nil, fmt.Errorf("create vectorstore dir: %w", err)
+	}
+	db, err := chromem.NewPersistentDB(dir, false)
+	if err != nil {
+		return nil, fmt.Errorf("open vectorstore: %w", err)
+	}
+	x := &chromemIndex{db: db, dir: dir}
+	data, err := os.ReadFile(filepath.Join(dir, manifestFileName))
+	if errors.Is(err, fs.ErrNotExist) {
+		// Indexes from before manifests were written.
+		return x, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read vectorstore manifest: %w", err)
+	}
+	if err := json.Unmarshal(data, &x.manifest); err != nil {
+		return nil, fmt.Errorf("parse vectorstore manifest: %w", err)
+	}
+	return x, nil
+}
+
+// noEmbedding is the embedding function of the collections. Documents and
+// queries are embedded by the Store, so chromem never calls it.
+func noEmbedding(context.Context, string) ([]float32, error) {
+	return nil, errors.New("vectors are embedded by the store")
+}
+
+func (x *chromemIndex) LoadManifest(_ context.Context) (Manifest, error) {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	return x.manifest, nil
+}
+
+func (x *chromemIndex) SaveManifest(_ context.Context, manifest Manifest) error {
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(x.dir, manifestFileName), data, 0600); err != nil {
+		return err
+	}
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	x.manifest = manifest
+	return nil
+}
+
+// collection returns the collection of userID, or nil if it has none.
+func (x *chromemIndex) collection(userID int32) *chromem.Collection {
+	return x.db.GetCollection(collectionName(userID), noEmbedding)
+}
+
+// getOrCreateCollection returns (or creates) the collection of userID,
+// recording the model and dimension in its metadata.
+func (x *chromemIndex) getOrCreateCollection(userID int32) (*chromem.Collection, error) {
+	if col := x.collection(userID); col != nil {
+		return col, nil
+	}
+	x.mu.RLock()
+	metadata := map[string]string{CollectionMetadataModel: x.manifest.Model}
+	if x.manifest.Dimension > 0 {
+		metadata[CollectionMetadataDimension] = strconv.Itoa(x.manifest.Dimension)
+	}
+	x.mu.RUnlock()
+	col, err := x.db.CreateCollection(collectionName(userID), metadata, noEmbedding)
+	if err != nil {
+		return nil, fmt.Errorf("create vector collection for user %d: %w", userID, err)
+	}
+	return col, nil
+}
+
+func (x *chromemIndex) Upsert(ctx context.Context, userID int32, docs []Document) error {
+	col, err := x.getOrCreateCollection(userID)
+	if err != nil {
+		return err
+	}
+	chromemDocs := make([]chromem.Document, 0, len(docs))
+	for _, d := range docs {
+		chromemDocs = append(chromemDocs, chromem.Document{
+			ID:        d.ID,
+			Content:   d.Content,
+			Metadata:  d.Metadata,
+			Embedding: d.Embedding,
+		})
+	}
+	return col.AddDocuments(ctx, chromemDocs, 1)
+}
+
+func (x *chromemIndex) GetMemo(ctx context.Context, userID int32, memoUID string) ([]Document, error) {
+	col := x.collection(userID)
+	if col == nil {
+		return nil, nil
+	}
+	var docs []Document
+	for i := 0; ; i++ {
+		doc, err := col.GetByID(ctx, chunkID(memoUID, i))
+		if err != nil {
+			return docs, nil
+		}
+		docs = append(docs, fromChromem(doc))
+	}
+}
+
+func (x *chromemIndex) Delete(ctx context.Context, userID int32, ids []string) error {
+	col := x.collection(userID)
+	if col == nil || len(ids) == 0 {
+		return nil
+	}
+	return col.Delete(ctx, nil, nil, ids...)
+}
+
+func (x *chromemIndex) DeleteMemo(ctx context.Context, userID int32, memoUID string) error {
+	col := x.collection(userID)
+	if col == nil {
+		return nil
+	}
+	return col.Delete(ctx, map[string]string{MetadataMemoUID: memoUID}, nil)
+}
+
+func (x *chromemIndex) Users(_ context.Context) ([]int32, error) {
+	var users []int32
+	for name := range x.db.ListCollections() {
+		var userID int32
+		if _, err := fmt.Sscanf(name, "user_%d_memos", &userID); err != nil {
+			continue
+		}
+		users = append(users, userID)
+	}
+	slices.Sort(users)
+	return users, nil
+}
+
+func (x *chromemIndex) List(_ context.Context, userID int32) ([]Document, error) {
+	if x.collection(userID) == nil {
+		return nil, nil
+	}
+	// chromem has no way to list documents, but exports them as gob.
+	var buf bytes.Buffer
+	if err := x.db.ExportToWriter(&buf, false, "", collectionName(userID)); err != nil {
+		return nil, err
+	}
+	var export struct {
+		Collections map[string]*struct {
+			Documents map[string]*chromem.Document
+		}
+	}
+	if err := gob.NewDecoder(&buf).Decode(&export); err != nil {
+		return nil, fmt.Errorf("decode vector collection: %w", err)
+	}
+	var docs []Document
+	for _, col := range export.Collections {
+		for _, doc := range col.Documents {
+			docs = append(docs, fromChromem(*doc))
+		}
+	}
+	return docs, nil
+}
+
+func (x *chromemIndex) DeleteUser(_ context.Context, userID int32) error {
+	return x.db.DeleteCollection(collectionName(userID))
+}
+
+func (x *chromemIndex) Count(_ context.Context, userID int32) (int, error) {
+	col := x.collection(userID)
+	if col == nil {
+		return 0, nil
+	}
+	return col.Count(), nil
+}
+
+func (x *chromemIndex) Query(ctx context.Context, userID int32, vector []float32, n int, where map[string]string) ([]Result, error) {
+	col := x.collection(userID)
+	if col == nil {
+		return nil, nil
+	}
+	// chromem refuses to return more documents than it holds.
+	n = min(n, col.Count())
+	if n <= 0 {
+		return nil, nil
+	}
+	results, err := col.QueryEmbedding(ctx, vector, n, where, nil)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]Result, 0, len(results))
+	for _, r := range results {
+		out = append(out, Result{
+			Document: Document{
+				ID:        r.ID,
+				Content:   r.Content,
+				Metadata:  r.Metadata,
+				Embedding: r.Embedding,
+			},
+			Similarity: r.Similarity,
+		})
+	}
+	return out, nil
+}
+
+func fromChromem(doc chromem.Document) Document {
+	return Document{
+		ID:        doc.ID,
+		Content:   doc.Content,
+		Metadata:  doc.Metadata,
+		Embedding: doc.Embedding,
+	}
+}
@@ -0,0 +1,352 @@
+package vectorstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	chromem "github.com/philippgille/chromem-go"
+)
+
+// Manifest records the embedding model an index was built with.
+type Manifest struct {
+	Model string `json:"model"`
+	// Dimension is the length of the vectors, or 0 while none are stored.
+	Dimension int `json:"dimension"`
+	// CreateTime is when the index was started, in unix seconds.
+	CreateTime int64 `json:"createTime"`
+}
+
+// Status describes the vector index.
+type Status struct {
+	// Model and Dimension are those of the index searches use.
+	Model     string
+	Dimension int
+	// Reindex is set while memos are re-indexed for a new model.
+	Reindex *ReindexStatus
+}
+
+// ReindexStatus is the progress of re-indexing memos for a new model.
+type ReindexStatus struct {
+	Model     string
+	Dimension int
+	StartTime time.Time
+	// Indexed of Total memos are in the new index so far; Failed could not be
+	// embedded and are retried on the next run. Total is 0 until the run has
+	// started.
+	Indexed int
+	Failed  int
+	Total   int
+}
+
+// Document is an embedded passage of a memo.
+type Document struct {
+	// ID is of the form "{memoUID}#{index}".
+	ID       string
+	Content  string
+	Metadata map[string]string
+	// Embedding is a unit vector.
+	Embedding []float32
+}
+
+// Result is a document found by a query.
+type Result struct {
+	Document
+	// Similarity is the cosine similarity of the document to the query.
+	Similarity float32
+}
+
+// VectorIndex stores the passage vectors of memos embedded with a single
+// model, in a collection per user. The Store embeds passages itself and
+// serializes writes, so an index only stores and compares vectors.
+type VectorIndex interface {
+	// LoadManifest returns how the index was built, or an empty manifest for
+	// a new index.
+	LoadManifest(ctx context.Context) (Manifest, error)
+	SaveManifest(ctx context.Context, manifest Manifest) error
+	// Upsert adds docs to the collection of userID, replacing documents with
+	// the same IDs.
+	Upsert(ctx context.Context, userID int32, docs []Document) error
+	// GetMemo returns the documents of a memo in passage order.
+	GetMemo(ctx context.Context, userID int32, memoUID string) ([]Document, error)
+	// Delete removes the documents with the given IDs.
+	Delete(ctx context.Context, userID int32, ids []string) error
+	// DeleteMemo removes the documents of a memo.
+	DeleteMemo(ctx context.Context, userID int32, memoUID string) error
+	// Users returns the IDs of the users that have documents.
+	Users(ctx context.Context) ([]int32, error)
+	// List returns every document of userID.
+	List(ctx context.Context, userID int32) ([]Document, error)
+	// DeleteUser removes every document of userID.
+	DeleteUser(ctx context.Context, userID int32) error
+	// Count returns the number of documents of userID.
+	Count(ctx context.Context, userID int32) (int, error)
+	// Query returns up to n documents of userID most similar to the unit
+	// vector, the most similar first. Only documents whose metadata has every
+	// key and value of where are considered.
+	Query(ctx context.Context, userID int32, vector []float32, n int, where map[string]string) ([]Result, error)
+}
+
+// Slot names one of the indexes of a Backend.
+type Slot string
+
+const (
+	// SlotActive holds the index searches use.
+	SlotActive Slot = "active"
+	// SlotNext holds the index being built for a new embedding model.
+	SlotNext Slot = "next"
+)
+
+// Backend keeps the vector indexes of a Store.
+type Backend interface {
+	// Open opens (or creates) the index in slot.
+	Open(ctx context.Context, slot Slot) (VectorIndex, error)
+	// Remove deletes the index in slot.
+	Remove(ctx context.Context, slot Slot) error
+	// Promote replaces the active index with the next one and returns it.
+	Promote(ctx context.Context) (VectorIndex, error)
+}
+
+// newPostgresBackend is NewPostgresBackend, replaced in tests.
+var newPostgresBackend = NewPostgresBackend
+
+// NewBackend returns the backend for the database driver of the instance.
+// Vectors of SQLite and PostgreSQL instances are kept in the same database as
+// the memos, and so are included in its backups and shared by replicas; other
+// drivers keep them in chromem files under dataDir. PostgreSQL instances
+// without the pgvector extension fall back to chromem too.
+func NewBackend(ctx context.Context, driver string, db *sql.DB, dataDir string) (Backend, error) {
+	switch driver {
+	case "sqlite":
+		return NewSQLiteBackend(ctx, db)
+	case "postgres":
+		backend, err := newPostgresBackend(ctx, db)
+		if err != nil {
+			slog.Warn("failed to init pgvector backend, falling back to chromem", "err", err)
+			return NewChromemBackend(dataDir)
+		}
+		return backend, nil
+	default:
+		return NewChromemBackend(dataDir)
+	}
+}
+
+// index is an open VectorIndex with its manifest and the embedding function
+// of its model.
+type index struct {
+	VectorIndex
+	manifest Manifest
+	embedFn  chromem.EmbeddingFunc
+}
+
+// openIndex opens the index in slot of backend.
+func openIndex(ctx context.Context, backend Backend, slot Slot) (*index, error) {
+	x, err := backend.Open(ctx, slot)
+	if err != nil {
+		return nil, fmt.Errorf("open vector index: %w", err)
+	}
+	manifest, err := x.LoadManifest(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("read vector index manifest: %w", err)
+	}
+	return &index{VectorIndex: x, manifest: manifest}, nil
+}
+
+func (x *index) saveManifest(ctx context.Context) error {
+	if err := x.SaveManifest(ctx, x.manifest); err != nil {
+		return fmt.Errorf("write vector index manifest: %w", err)
+	}
+	return nil
+}
+
+// recordDimension records the dimension of the index once the first vector
+// is added.
+func (x *index) recordDimension(ctx context.Context, dimension int) error {
+	if x.manifest.Dimension > 0 {
+		return nil
+	}
+	x.manifest.Dimension = dimension
+	return x.saveManifest(ctx)
+}
+
+// matches reports whether vector can be compared with the vectors of the
+// index.
+func (x *index) matches(ctx context.Context, vector []float32) (bool, error) {
+	if x.manifest.Dimension > 0 {
+		return x.manifest.Dimension == len(vector), nil
+	}
+	// Indexes from before dimensions were recorded are checked against a
+	// stored vector instead.
+	users, err := x.Users(ctx)
+	if err != nil {
+		return false, err
+	}
+	for _, userID := range users {
+		docs, err := x.List(ctx, userID)
+		if err != nil {
+			return false, err
+		}
+		if len(docs) > 0 {
+			return len(docs[0].Embedding) == len(vector), nil
+		}
+	}
+	return true, nil
+}
+
+// startReindex begins building a new index for the configured model, resuming
+// one left by an earlier run unless fresh is set.
+func (s *Store) startReindex(ctx context.Context, fresh bool) error {
+	var next *index
+	if !fresh {
+		x, err := openIndex(ctx, s.backend, SlotNext)
+		if err != nil {
+			return err
+		}
+		if x.manifest.Model == s.model {
+			next = x
+		}
+	}
+	if next == nil {
+		if err := s.backend.Remove(ctx, SlotNext); err != nil {
+			return fmt.Errorf("remove abandoned vector index: %w", err)
+		}
+		x, err := openIndex(ctx, s.backend, SlotNext)
+		if err != nil {
+			return err
+		}
+		x.manifest = Manifest{Model: s.model, CreateTime: time.Now().Unix()}
+		if err := x.saveManifest(ctx); err != nil {
+			return err
+		}
+		next = x
+	}
+	next.embedFn = normalized(s.embedder(s.model))
+	s.next = next
+	s.progress = ReindexStatus{}
+	return nil
+}
+
+// CheckEmbedding embeds a probe text with the configured model and starts
+// re-indexing if its vectors cannot be compared with those already indexed,
+// as when a model was swapped without changing its name.
+func (s *Store) CheckEmbedding(ctx context.Context) error {
+	vector, err := normalized(s.embedder(s.model))(ctx, "dimension probe")
+	if err != nil {
+		return fmt.Errorf("embed probe: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// The index memos are written to is always the one of the configured model.
+	x := s.writeIndex()
+	matches, err := x.matches(ctx, vector)
+	if err != nil {
+		return err
+	}
+	if matches {
+		return x.recordDimension(ctx, len(vector))
+	}
+	slog.Info("embedding dimension changed, memos will be re-indexed", "model", s.model, "dimension", len(vector))
+	return s.startReindex(ctx, true)
+}
+
+// Reindex embeds memos into the new index while the embedding model is being
+// changed, then makes it the index searches use. memos are all memos; ones
+// already in the new index are skipped, so an interrupted run resumes where it
+// stopped. The new index only replaces the old one once every memo is in it.
+// It is a no-op if no re-index is in progress.
+func (s *Store) Reindex(ctx context.Context, memos []*Memo) error {
+	s.mu.Lock()
+	next := s.next
+	if next == nil {
+		s.mu.Unlock()
+		return nil
+	}
+	s.progress = ReindexStatus{Total: len(memos)}
+	s.mu.Unlock()
+
+	for _, m := range memos {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		passages, err := s.passages(m)
+		if err != nil {
+			slog.Warn("failed to chunk memo", "memo", m.UID, "err", err)
+			s.mu.Lock()
+			s.progress.Failed++
+			s.mu.Unlock()
+			continue
+		}
+
+		// Memos are embedded without holding the lock, so searches keep using
+		// the old index meanwhile.
+		var docs []Document
+		s.mu.RLock()
+		indexed := next.hasMemo(ctx, m.CreatorID, m.UID)
+		s.mu.RUnlock()
+		if !indexed {
+			docs, err = next.embedMemo(ctx, m, passages)
+		}
+
+		// Memos saved since the run started were indexed with fresher content.
+		s.mu.Lock()
+		if s.next != next {
+			s.mu.Unlock()
+			return errors.New("re-index was restarted")
+		}
+		if err == nil && !indexed && !next.hasMemo(ctx, m.CreatorID, m.UID) {
+			err = next.storeMemo(ctx, m, docs)
+		}
+		if err != nil {
+			slog.Warn("failed to re-index memo", "memo", m.UID, "err", err)
+			s.progress.Failed++
+		} else {
+			s.progress.Indexed++
+		}
+		s.mu.Unlock()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.progress.Failed > 0 {
+		return fmt.Errorf("%d memos could not be re-indexed", s.progress.Failed)
+	}
+	return s.promote(ctx)
+}
+
+// promote replaces the active index with the new one.
+func (s *Store) promote(ctx context.Context) error {
+	x, err := s.backend.Promote(ctx)
+	if err != nil {
+		return fmt.Errorf("replace vector index: %w", err)
+	}
+	active := &index{VectorIndex: x, manifest: s.next.manifest, embedFn: s.next.embedFn}
+	slog.Info("memos re-indexed", "model", active.manifest.Model, "dimension", active.manifest.Dimension)
+	s.active = active
+	s.next = nil
+	return nil
+}
+
+// Status reports the embedding model of the index and the progress of a
+// re-index.
+func (s *Store) Status() *Status {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	status := &Status{
+		Model:     s.active.manifest.Model,
+		Dimension: s.active.manifest.Dimension,
+	}
+	if s.next != nil {
+		progress := s.progress
+		progress.Model = s.next.manifest.Model
+		progress.Dimension = s.next.manifest.Dimension
+		progress.StartTime = time.Unix(s.next.manifest.CreateTime, 0)
+		status.Reindex = &progress
+	}
+	return status
+}
@@ -0,0 +1,156 @@
+package vectorstore
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	chromem "github.com/philippgille/chromem-go"
+	"github.com/stretchr/testify/require"
+)
+
+// shortEmbed stands in for a model with smaller vectors than fakeEmbed.
+func shortEmbed(ctx context.Context, text string) ([]float32, error) {
+	vector, err := fakeEmbed(ctx, text)
+	if err != nil {
+		return nil, err
+	}
+	return vector[:4], nil
+}
+
+func shortEmbedder(string) chromem.EmbeddingFunc {
+	return shortEmbed
+}
+
+func searchUIDs(t *testing.T, s *Store, query string) []string {
+	results, err := s.SearchSimilar(context.Background(), 1, query, 5, nil)
+	require.NoError(t, err)
+	var uids []string
+	for _, r := range results {
+		uids = append(uids, r.MemoUID)
+	}
+	return uids
+}
+
+func TestReindexOnModelChange(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, open func() Backend) {
+		ctx := context.Background()
+
+		s, err := New(ctx, open(), "old", fakeEmbedder)
+		require.NoError(t, err)
+		a, b := memo(1, "a", "alpha"), memo(1, "b", "beta")
+		require.NoError(t, s.UpsertMemo(ctx, a))
+		require.NoError(t, s.UpsertMemo(ctx, b))
+		require.Equal(t, &Status{Model: "old", Dimension: 8}, s.Status())
+
+		embedders := func(model string) chromem.EmbeddingFunc {
+			if model == "old" {
+				return fakeEmbed
+			}
+			return shortEmbed
+		}
+		s, err = New(ctx, open(), "new", embedders)
+		require.NoError(t, err)
+		status := s.Status()
+		require.Equal(t, "old", status.Model)
+		require.NotNil(t, status.Reindex)
+		require.Equal(t, "new", status.Reindex.Model)
+
+		// Searches keep using the old index, whose queries are embedded with the
+		// old model; new memos only go to the new index.
+		c := memo(1, "c", "gamma")
+		require.NoError(t, s.UpsertMemo(ctx, c))
+		require.Equal(t, []string{"a", "b"}, sortedUIDs(searchUIDs(t, s, "alpha")))
+		require.True(t, s.HasMemo(ctx, 1, "c"))
+		require.False(t, s.HasMemo(ctx, 1, "a"))
+
+		// An interrupted run resumes with the memos indexed so far.
+		require.NoError(t, s.UpsertMemo(ctx, a))
+		s, err = New(ctx, open(), "new", embedders)
+		require.NoError(t, err)
+		require.True(t, s.HasMemo(ctx, 1, "a"))
+
+		require.NoError(t, s.Reindex(ctx, []*Memo{a, b, c}))
+		status = s.Status()
+		require.Equal(t, &Status{Model: "new", Dimension: 4}, status)
+		require.Equal(t, []string{"a", "b", "c"}, sortedUIDs(searchUIDs(t, s, "alpha")))
+
+		// The new index is picked up on restart.
+		s, err = New(ctx, open(), "new", embedders)
+		require.NoError(t, err)
+		require.Nil(t, s.Status().Reindex)
+		require.Len(t, searchUIDs(t, s, "beta"), 3)
+	})
+}
+
+func TestSearchDuringReindex(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, open func() Backend) {
+		ctx := context.Background()
+
+		s, err := New(ctx, open(), "old", fakeEmbedder)
+		require.NoError(t, err)
+		a := memo(1, "a", "alpha")
+		require.NoError(t, s.UpsertMemo(ctx, a))
+
+		// The new model answers only once the search below is done.
+		embedding, release := make(chan struct{}), make(chan struct{})
+		var once sync.Once
+		s, err = New(ctx, open(), "new", func(model string) chromem.EmbeddingFunc {
+			if model == "old" {
+				return fakeEmbed
+			}
+			return func(ctx context.Context, text string) ([]float32, error) {
+				once.Do(func() { close(embedding) })
+				<-release
+				return shortEmbed(ctx, text)
+			}
+		})
+		require.NoError(t, err)
+		reindexed := make(chan error)
+		go func() { reindexed <- s.Reindex(ctx, []*Memo{a}) }()
+
+		<-embedding
+		searched := make(chan []string)
+		go func() { searched <- searchUIDs(t, s, "alpha") }()
+		select {
+		case uids := <-searched:
+			require.Equal(t, []string{"a"}, uids)
+		case <-time.After(5 * time.Second):
+			t.Fatal("search waited for the re-index to embed a memo")
+		}
+		close(release)
+		require.NoError(t, <-reindexed)
+	})
+}
+
+func TestCheckEmbedding(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, open func() Backend) {
+		ctx := context.Background()
+
+		s, err := New(ctx, open(), "model", fakeEmbedder)
+		require.NoError(t, err)
+		require.NoError(t, s.UpsertMemo(ctx, memo(1, "a", "alpha")))
+		require.NoError(t, s.CheckEmbedding(ctx))
+		require.Nil(t, s.Status().Reindex)
+
+		// The model behind the name now returns vectors of another dimension.
+		s, err = New(ctx, open(), "model", shortEmbedder)
+		require.NoError(t, err)
+		require.NoError(t, s.CheckEmbedding(ctx))
+		require.NotNil(t, s.Status().Reindex)
+
+		// The re-index survives a restart although the model name is unchanged.
+		s, err = New(ctx, open(), "model", shortEmbedder)
+		require.NoError(t, err)
+		require.NotNil(t, s.Status().Reindex)
+		require.NoError(t, s.Reindex(ctx, []*Memo{memo(1, "a", "alpha")}))
+		require.Equal(t, 4, s.Status().Dimension)
+	})
+}
+
+func sortedUIDs(uids []string) []string {
+	slices.Sort(uids)
+	return uids
+}
@@ -0,0 +1,296 @@
+package vectorstore
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/lib/pq"
+)
+
+// postgresBackend keeps indexes in tables of the PostgreSQL database of the
+// instance, using the pgvector extension to compare vectors in the database.
+// The vector column has no fixed dimension, as indexes of different models
+// share it, so searches are exact scans of a user's vectors rather than
+// approximate index lookups.
+type postgresBackend struct {
+	db *sql.DB
+}
+
+// NewPostgresBackend returns a backend storing indexes in db, creating the
+// pgvector extension and its tables if needed.
+func NewPostgresBackend(ctx context.Context, db *sql.DB) (Backend, error) {
+	if _, err := db.ExecContext(ctx, `CREATE EXTENSION IF NOT EXISTS vector`); err != nil {
+		return nil, fmt.Errorf("enable pgvector extension: %w", err)
+	}
+	stmts := []string{
+		`CREATE TABLE IF NOT EXISTS memo_embedding_index (
+			slot       TEXT    NOT NULL PRIMARY KEY,
+			model      TEXT    NOT NULL,
+			dimension  INTEGER NOT NULL DEFAULT 0,
+			created_ts BIGINT  NOT NULL
+		)`,
+		`CREATE TABLE IF NOT EXISTS memo_embedding (
+			slot      TEXT    NOT NULL,
+			user_id   INTEGER NOT NULL,
+			id        TEXT    NOT NULL,
+			memo_uid  TEXT    NOT NULL,
+			content   TEXT    NOT NULL,
+			metadata  JSONB   NOT NULL DEFAULT '{}',
+			embedding vector  NOT NULL,
+			PRIMARY KEY (slot, user_id, id)
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_memo_embedding_memo ON memo_embedding(slot, user_id, memo_uid)`,
+	}
+	for _, stmt := range stmts {
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			return nil, fmt.Errorf("create vector tables: %w", err)
+		}
+	}
+	return &postgresBackend{db: db}, nil
+}
+
+func (b *postgresBackend) Open(_ context.Context, slot Slot) (VectorIndex, error) {
+	return &postgresIndex{db: b.db, slot: slot}, nil
+}
+
+func (b *postgresBackend) Remove(ctx context.Context, slot Slot) error {
+	tx, err := b.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if _, err := tx.ExecContext(ctx, `DELETE FROM memo_embedding WHERE slot = $1`, slot); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM memo_embedding_index WHERE slot = $1`, slot); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (b *postgresBackend) Promote(ctx context.Context) (VectorIndex, error) {
+	tx, err := b.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	for _, table := range []string{"memo_embedding", "memo_embedding_index"} {
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf(`DELETE FROM %s WHERE slot = $1`, table), SlotActive); err != nil {
+			return nil, err
+		}
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf(`UPDATE %s SET slot = $1 WHERE slot = $2`, table), SlotActive, SlotNext); err != nil {
+			return nil, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return &postgresIndex{db: b.db, slot: SlotActive}, nil
+}
+
+// postgresIndex is the index in a slot of the PostgreSQL tables.
+type postgresIndex struct {
+	db   *sql.DB
+	slot Slot
+}
+
+func (x *postgresIndex) LoadManifest(ctx context.Context) (Manifest, error) {
+	var manifest Manifest
+	err := x.db.QueryRowContext(ctx, `SELECT model, dimension, created_ts FROM memo_embedding_index WHERE slot = $1`, x.slot).
+		Scan(&manifest.Model, &manifest.Dimension, &manifest.CreateTime)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Manifest{}, nil
+	}
+	return manifest, err
+}
+
+func (x *postgresIndex) SaveManifest(ctx context.Context, manifest Manifest) error {
+	_, err := x.db.ExecContext(ctx, `
+		INSERT INTO memo_embedding_index (slot, model, dimension, created_ts) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (slot) DO UPDATE SET model = EXCLUDED.model, dimension = EXCLUDED.dimension, created_ts = EXCLUDED.created_ts`,
+		x.slot, manifest.Model, manifest.Dimension, manifest.CreateTime)
+	return err
+}
+
+func (x *postgresIndex) Upsert(ctx context.Context, userID int32, docs []Document) error {
+	tx, err := x.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	for _, d := range docs {
+		metadata, err := json.Marshal(d.Metadata)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO memo_embedding (slot, user_id, id, memo_uid, content, metadata, embedding) VALUES ($1, $2, $3, $4, $5, $6::jsonb, $7::vector)
+			ON CONFLICT (slot, user_id, id) DO UPDATE SET memo_uid = EXCLUDED.memo_uid, content = EXCLUDED.content, metadata = EXCLUDED.metadata, embedding = EXCLUDED.embedding`,
+			x.slot, userID, d.ID, d.Metadata[MetadataMemoUID], d.Content, string(metadata), formatPGVector(d.Embedding)); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (x *postgresIndex) GetMemo(ctx context.Context, userID int32, memoUID string) ([]Document, error) {
+	docs, err := x.list(ctx, `AND memo_uid = $3`, userID, memoUID)
+	if err != nil {
+		return nil, err
+	}
+	sortByChunk(docs)
+	return docs, nil
+}
+
+func (x *postgresIndex) Delete(ctx context.Context, userID int32, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	_, err := x.db.ExecContext(ctx, `DELETE FROM memo_embedding WHERE slot = $1 AND user_id = $2 AND id = ANY($3)`, x.slot, userID, pq.Array(ids))
+	return err
+}
+
+func (x *postgresIndex) DeleteMemo(ctx context.Context, userID int32, memoUID string) error {
+	_, err := x.db.ExecContext(ctx, `DELETE FROM memo_embedding WHERE slot = $1 AND user_id = $2 AND memo_uid = $3`, x.slot, userID, memoUID)
+	return err
+}
+
+func (x *postgresIndex) Users(ctx context.Context) ([]int32, error) {
+	rows, err := x.db.QueryContext(ctx, `SELECT DISTINCT user_id FROM memo_embedding WHERE slot = $1 ORDER BY user_id`, x.slot)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var users []int32
+	for rows.Next() {
+		var userID int32
+		if err := rows.Scan(&userID); err != nil {
+			return nil, err
+		}
+		users = append(users, userID)
+	}
+	return users, rows.Err()
+}
+
+func (x *postgresIndex) List(ctx context.Context, userID int32) ([]Document, error) {
+	return x.list(ctx, "", userID)
+}
+
+func (x *postgresIndex) DeleteUser(ctx context.Context, userID int32) error {
+	_, err := x.db.ExecContext(ctx, `DELETE FROM memo_embedding WHERE slot = $1 AND user_id = $2`, x.slot, userID)
+	return err
+}
+
+func (x *postgresIndex) Count(ctx context.Context, userID int32) (int, error) {
+	var count int
+	err := x.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM memo_embedding WHERE slot = $1 AND user_id = $2`, x.slot, userID).Scan(&count)
+	return count, err
+}
+
+func (x *postgresIndex) Query(ctx context.Context, userID int32, vector []float32, n int, where map[string]string) ([]Result, error) {
+	if where == nil {
+		where = map[string]string{}
+	}
+	filter, err := json.Marshal(where)
+	if err != nil {
+		return nil, err
+	}
+	// <=> is the cosine distance.
+	rows, err := x.db.QueryContext(ctx, `
+		SELECT id, content, metadata, embedding::text, 1 - (embedding <=> $3::vector)
+		FROM memo_embedding
+		WHERE slot = $1 AND user_id = $2 AND metadata @> $4::jsonb
+		ORDER BY embedding <=> $3::vector
+		LIMIT $5`,
+		x.slot, userID, formatPGVector(vector), string(filter), n)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var results []Result
+	for rows.Next() {
+		var r Result
+		var similarity float64
+		if err := x.scan(rows, &r.Document, &similarity); err != nil {
+			return nil, err
+		}
+		r.Similarity = float32(similarity)
+		results = append(results, r)
+	}
+	return results, rows.Err()
+}
+
+// list returns the documents of userID matching the extra condition, whose
+// arguments start at $3.
+func (x *postgresIndex) list(ctx context.Context, condition string, userID int32, args ...any) ([]Document, error) {
+	rows, err := x.db.QueryContext(ctx, `SELECT id, content, metadata, embedding::text FROM memo_embedding WHERE slot = $1 AND user_id = $2 `+condition,
+		append([]any{x.slot, userID}, args...)...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var docs []Document
+	for rows.Next() {
+		var d Document
+		if err := x.scan(rows, &d); err != nil {
+			return nil, err
+		}
+		docs = append(docs, d)
+	}
+	return docs, rows.Err()
+}
+
+// scan reads a document selected as id, content, metadata and embedding,
+// followed by extra columns.
+func (*postgresIndex) scan(rows *sql.Rows, d *Document, extra ...any) error {
+	var metadata []byte
+	var embedding string
+	if err := rows.Scan(append([]any{&d.ID, &d.Content, &metadata, &embedding}, extra...)...); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(metadata, &d.Metadata); err != nil {
+		return fmt.Errorf("parse metadata of %s: %w", d.ID, err)
+	}
+	vector, err := parsePGVector(embedding)
+	if err != nil {
+		return fmt.Errorf("parse vector of %s: %w", d.ID, err)
+	}
+	d.Embedding = vector
+	return nil
+}
+
+// formatPGVector formats a vector in the text form of pgvector, "[1,2,3]".
+func formatPGVector(vector []float32) string {
+	var sb strings.Builder
+	sb.WriteByte('[')
+	for i, v := range vector {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteString(strconv.FormatFloat(float64(v), 'g', -1, 32))
+	}
+	sb.WriteByte(']')
+	return sb.String()
+}
+
+func parsePGVector(text string) ([]float32, error) {
+	text = strings.TrimSuffix(strings.TrimPrefix(text, "["), "]")
+	if text == "" {
+		return nil, nil
+	}
+	fields := strings.Split(text, ",")
+	vector := make([]float32, len(fields))
+	for i, field := range fields {
+		v, err := strconv.ParseFloat(field, 32)
+		if err != nil {
+			return nil, err
+		}
+		vector[i] = float32(v)
+	}
+	return vector, nil
+}
@@ -0,0 +1,34 @@
+package vectorstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPGVectorText(t *testing.T) {
+	vector := []float32{0.5, -0.25, 1e-7, 0}
+	text := formatPGVector(vector)
+	require.Equal(t, "[0.5,-0.25,1e-07,0]", text)
+	parsed, err := parsePGVector(text)
+	require.NoError(t, err)
+	require.Equal(t, vector, parsed)
+
+	parsed, err = parsePGVector("[]")
+	require.NoError(t, err)
+	require.Empty(t, parsed)
+}
+
+func TestNewBackendWithoutPGVector(t *testing.T) {
+	newPostgresBackend = func(context.Context, *sql.DB) (Backend, error) {
+		return nil, errors.New(`enable pgvector extension: extension "vector" is not available`)
+	}
+	t.Cleanup(func() { newPostgresBackend = NewPostgresBackend })
+
+	backend, err := NewBackend(context.Background(), "postgres", nil, t.TempDir())
+	require.NoError(t, err)
+	require.IsType(t, &chromemBackend{}, backend)
+}
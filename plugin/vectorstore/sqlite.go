@@ -0,0 +1,281 @@
+package vectorstore
+
+import (
+	"context"
+	"database/sql"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// sqliteBackend keeps indexes in tables of the SQLite database of the
+// instance. SQLite has no vector type, so vectors are stored as blobs and
+// queries compare every vector of a user, which is fast enough for the
+// number of memos a single user writes.
+type sqliteBackend struct {
+	db *sql.DB
+}
+
+// NewSQLiteBackend returns a backend storing indexes in db, creating its
+// tables if needed.
+func NewSQLiteBackend(ctx context.Context, db *sql.DB) (Backend, error) {
+	stmts := []string{
+		`CREATE TABLE IF NOT EXISTS memo_embedding_index (
+			slot       TEXT    NOT NULL PRIMARY KEY,
+			model      TEXT    NOT NULL,
+			dimension  INTEGER NOT NULL DEFAULT 0,
+			created_ts INTEGER NOT NULL
+		)`,
+		`CREATE TABLE IF NOT EXISTS memo_embedding (
+			slot      TEXT    NOT NULL,
+			user_id   INTEGER NOT NULL,
+			id        TEXT    NOT NULL,
+			memo_uid  TEXT    NOT NULL,
+			content   TEXT    NOT NULL,
+			metadata  TEXT    NOT NULL DEFAULT '{}',
+			embedding BLOB    NOT NULL,
+			PRIMARY KEY (slot, user_id, id)
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_memo_embedding_memo ON memo_embedding(slot, user_id, memo_uid)`,
+	}
+	for _, stmt := range stmts {
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			return nil, fmt.Errorf("create vector tables: %w", err)
+		}
+	}
+	return &sqliteBackend{db: db}, nil
+}
+
+func (b *sqliteBackend) Open(_ context.Context, slot Slot) (VectorIndex, error) {
+	return &sqliteIndex{db: b.db, slot: slot}, nil
+}
+
+func (b *sqliteBackend) Remove(ctx context.Context, slot Slot) error {
+	tx, err := b.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if _, err := tx.ExecContext(ctx, `DELETE FROM memo_embedding WHERE slot = ?`, slot); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM memo_embedding_index WHERE slot = ?`, slot); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (b *sqliteBackend) Promote(ctx context.Context) (VectorIndex, error) {
+	tx, err := b.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	for _, table := range []string{"memo_embedding", "memo_embedding_index"} {
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf(`DELETE FROM %s WHERE slot = ?`, table), SlotActive); err != nil {
+			return nil, err
+		}
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf(`UPDATE %s SET slot = ? WHERE slot = ?`, table), SlotActive, SlotNext); err != nil {
+			return nil, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return &sqliteIndex{db: b.db, slot: SlotActive}, nil
+}
+
+// sqliteIndex is the index in a slot of the SQLite tables.
+type sqliteIndex struct {
+	db   *sql.DB
+	slot Slot
+}
+
+func (x *sqliteIndex) LoadManifest(ctx context.Context) (Manifest, error) {
+	var manifest Manifest
+	err := x.db.QueryRowContext(ctx, `SELECT model, dimension, created_ts FROM memo_embedding_index WHERE slot = ?`, x.slot).
+		Scan(&manifest.Model, &manifest.Dimension, &manifest.CreateTime)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Manifest{}, nil
+	}
+	return manifest, err
+}
+
+func (x *sqliteIndex) SaveManifest(ctx context.Context, manifest Manifest) error {
+	_, err := x.db.ExecContext(ctx, `
+		INSERT INTO memo_embedding_index (slot, model, dimension, created_ts) VALUES (?, ?, ?, ?)
+		ON CONFLICT(slot) DO UPDATE SET model = excluded.model, dimension = excluded.dimension, created_ts = excluded.created_ts`,
+		x.slot, manifest.Model, manifest.Dimension, manifest.CreateTime)
+	return err
+}
+
+func (x *sqliteIndex) Upsert(ctx context.Context, userID int32, docs []Document) error {
+	tx, err := x.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	for _, d := range docs {
+		metadata, err := json.Marshal(d.Metadata)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO memo_embedding (slot, user_id, id, memo_uid, content, metadata, embedding) VALUES (?, ?, ?, ?, ?, ?, ?)
+			ON CONFLICT(slot, user_id, id) DO UPDATE SET memo_uid = excluded.memo_uid, content = excluded.content, metadata = excluded.metadata, embedding = excluded.embedding`,
+			x.slot, userID, d.ID, d.Metadata[MetadataMemoUID], d.Content, string(metadata), encodeVector(d.Embedding)); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (x *sqliteIndex) GetMemo(ctx context.Context, userID int32, memoUID string) ([]Document, error) {
+	docs, err := x.list(ctx, `AND memo_uid = ?`, userID, memoUID)
+	if err != nil {
+		return nil, err
+	}
+	sortByChunk(docs)
+	return docs, nil
+}
+
+func (x *sqliteIndex) Delete(ctx context.Context, userID int32, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	args := []any{x.slot, userID}
+	for _, id := range ids {
+		args = append(args, id)
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(ids)), ", ")
+	_, err := x.db.ExecContext(ctx, `DELETE FROM memo_embedding WHERE slot = ? AND user_id = ? AND id IN (`+placeholders+`)`, args...)
+	return err
+}
+
+func (x *sqliteIndex) DeleteMemo(ctx context.Context, userID int32, memoUID string) error {
+	_, err := x.db.ExecContext(ctx, `DELETE FROM memo_embedding WHERE slot = ? AND user_id = ? AND memo_uid = ?`, x.slot, userID, memoUID)
+	return err
+}
+
+func (x *sqliteIndex) Users(ctx context.Context) ([]int32, error) {
+	rows, err := x.db.QueryContext(ctx, `SELECT DISTINCT user_id FROM memo_embedding WHERE slot = ? ORDER BY user_id`, x.slot)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var users []int32
+	for rows.Next() {
+		var userID int32
+		if err := rows.Scan(&userID); err != nil {
+			return nil, err
+		}
+		users = append(users, userID)
+	}
+	return users, rows.Err()
+}
+
+func (x *sqliteIndex) List(ctx context.Context, userID int32) ([]Document, error) {
+	return x.list(ctx, "", userID)
+}
+
+func (x *sqliteIndex) DeleteUser(ctx context.Context, userID int32) error {
+	_, err := x.db.ExecContext(ctx, `DELETE FROM memo_embedding WHERE slot = ? AND user_id = ?`, x.slot, userID)
+	return err
+}
+
+func (x *sqliteIndex) Count(ctx context.Context, userID int32) (int, error) {
+	var count int
+	err := x.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM memo_embedding WHERE slot = ? AND user_id = ?`, x.slot, userID).Scan(&count)
+	return count, err
+}
+
+func (x *sqliteIndex) Query(ctx context.Context, userID int32, vector []float32, n int, where map[string]string) ([]Result, error) {
+	docs, err := x.list(ctx, "", userID)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]Result, 0, len(docs))
+	for _, d := range docs {
+		if !hasMetadata(d.Metadata, where) {
+			continue
+		}
+		similarity, err := dotProduct(vector, d.Embedding)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, Result{Document: d, Similarity: similarity})
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].Similarity > results[j].Similarity })
+	if len(results) > n {
+		results = results[:n]
+	}
+	return results, nil
+}
+
+// list returns the documents of userID matching the extra condition.
+func (x *sqliteIndex) list(ctx context.Context, condition string, userID int32, args ...any) ([]Document, error) {
+	rows, err := x.db.QueryContext(ctx, `SELECT id, content, metadata, embedding FROM memo_embedding WHERE slot = ? AND user_id = ? `+condition,
+		append([]any{x.slot, userID}, args...)...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var docs []Document
+	for rows.Next() {
+		var d Document
+		var metadata string
+		var embedding []byte
+		if err := rows.Scan(&d.ID, &d.Content, &metadata, &embedding); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal([]byte(metadata), &d.Metadata); err != nil {
+			return nil, fmt.Errorf("parse metadata of %s: %w", d.ID, err)
+		}
+		d.Embedding = decodeVector(embedding)
+		docs = append(docs, d)
+	}
+	return docs, rows.Err()
+}
+
+// encodeVector encodes a vector as little-endian float32s.
+func encodeVector(vector []float32) []byte {
+	data := make([]byte, 4*len(vector))
+	for i, v := range vector {
+		binary.LittleEndian.PutUint32(data[4*i:], math.Float32bits(v))
+	}
+	return data
+}
+
+func decodeVector(data []byte) []float32 {
+	vector := make([]float32, len(data)/4)
+	for i := range vector {
+		vector[i] = math.Float32frombits(binary.LittleEndian.Uint32(data[4*i:]))
+	}
+	return vector
+}
+
+// dotProduct returns the cosine similarity of two unit vectors.
+func dotProduct(a, b []float32) (float32, error) {
+	if len(a) != len(b) {
+		return 0, fmt.Errorf("vectors have different dimensions %d and %d", len(a), len(b))
+	}
+	var sum float32
+	for i := range a {
+		sum += a[i] * b[i]
+	}
+	return sum, nil
+}
+
+// hasMetadata reports whether metadata has every key and value of where.
+func hasMetadata(metadata, where map[string]string) bool {
+	for k, v := range where {
+		if metadata[k] != v {
+			return false
+		}
+	}
+	return true
+}
@@ -4,41 +4,135 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
-	"os"
-	"path/filepath"
+	"math"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
 	"sync"
+	"time"
 
 	chromem "github.com/philippgille/chromem-go"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/usememos/memos/plugin/markdown"
 )
 
-// SearchResult is a single semantic-search hit.
+// maxChunkLength is the maximum size in bytes of an embedded passage.
+const maxChunkLength = 1500
+
+// SearchResult is a single semantic-search hit: the best-matching passage of a memo.
 type SearchResult struct {
 	MemoUID string
+	// Content is the matching passage, found at [Start, End) in the memo content.
 	Content string
+	Start   int
+	End     int
 	Score   float32
+	// Attachment and Filename identify the attachment the passage comes from,
+	// if any; Start and End then index the attachment's text instead.
+	Attachment string
+	Filename   string
 }
 
-// Store wraps chromem-go with per-user collections and disk persistence.
+// Store keeps the vectors of memos in per-user collections of a VectorIndex.
+// Each memo, followed by the text of its attachments, is split into
+// markdown-aware passages which are embedded as separate documents with IDs of
+// the form "{memoUID}#{index}".
+//
+// Vectors of different embedding models cannot be compared, so the model an
+// index was built with is recorded alongside it. When the configured model
+// changes, a new index is built in the background while searches keep using
+// the old one; see Reindex.
 type Store struct {
-	mu       sync.RWMutex
-	db       *chromem.DB
-	dataDir  string
-	embedFn  chromem.EmbeddingFunc
+	mu sync.RWMutex
+	// active is the index searches use.
+	active *index
+	// next is the index being built for a new embedding model, if any. New
+	// and changed memos are only embedded into it.
+	next     *index
+	backend  Backend
+	model    string
+	embedder Embedder
+	markdown markdown.Service
+	progress ReindexStatus
 }
 
-// New creates (or opens) the persistent vector store at dataDir/vectorstore/.
-// embedFunc is the embedding function to use — pass chromem.NewEmbeddingFuncOpenAICompat
-// pointed at the OpenRouter embeddings endpoint.
-func New(dataDir string, embedFunc chromem.EmbeddingFunc) (*Store, error) {
-	dir := filepath.Join(dataDir, "vectorstore")
-	if err := os.MkdirAll(dir, 0750); err != nil {
-		return nil, fmt.Errorf("create vectorstore dir: %w", err)
+// Embedder returns the embedding function of a model.
+type Embedder func(model string) chromem.EmbeddingFunc
+
+// New opens the vector store kept by backend for the embedding model.
+// embedder returns the embedding function of a model, e.g.
+// chromem.NewEmbeddingFuncOpenAICompat pointed at the OpenRouter embeddings
+// endpoint; it is also asked for the model of an older index so that queries
+// against it can be embedded until it is replaced.
+func New(ctx context.Context, backend Backend, model string, embedder Embedder) (*Store, error) {
+	s := &Store{
+		backend:  backend,
+		model:    model,
+		embedder: embedder,
+		markdown: markdown.NewService(markdown.WithTagExtension()),
 	}
-	db, err := chromem.NewPersistentDB(dir, false)
+
+	active, err := openIndex(ctx, backend, SlotActive)
 	if err != nil {
-		return nil, fmt.Errorf("open vectorstore: %w", err)
+		return nil, err
+	}
+	// Indexes from before models were recorded are assumed to match the
+	// configured model; CheckEmbedding catches those that don't.
+	if active.manifest.Model == "" {
+		active.manifest = Manifest{Model: model, CreateTime: time.Now().Unix()}
+		if err := active.saveManifest(ctx); err != nil {
+			return nil, err
+		}
+	}
+	active.embedFn = normalized(embedder(active.manifest.Model))
+	s.active = active
+
+	pending, err := openIndex(ctx, backend, SlotNext)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case active.manifest.Model != model:
+		slog.Info("embedding model changed, memos will be re-indexed", "from", active.manifest.Model, "to", model)
+	case pending.manifest.Model == model:
+		// Resume a re-index started when the dimension of the model changed.
+	default:
+		if err := backend.Remove(ctx, SlotNext); err != nil {
+			return nil, fmt.Errorf("remove abandoned vector index: %w", err)
+		}
+		return s, nil
+	}
+	if err := s.startReindex(ctx, false); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// normalized wraps an embedding function so it returns unit vectors. chromem
+// scores by dot product and only normalizes embeddings passed in explicitly,
+// not ones it computes, and not every backend returns unit vectors.
+func normalized(embedFunc chromem.EmbeddingFunc) chromem.EmbeddingFunc {
+	return func(ctx context.Context, text string) ([]float32, error) {
+		vector, err := embedFunc(ctx, text)
+		if err != nil {
+			return nil, err
+		}
+		var sum float64
+		for _, v := range vector {
+			sum += float64(v) * float64(v)
+		}
+		if sum == 0 {
+			return vector, nil
+		}
+		norm := float32(math.Sqrt(sum))
+		out := make([]float32, len(vector))
+		for i, v := range vector {
+			out[i] = v / norm
+		}
+		return out, nil
 	}
-	return &Store{db: db, dataDir: dir, embedFn: embedFunc}, nil
 }
 
 // collectionName returns the per-user collection name.
@@ -46,82 +140,536 @@ func collectionName(userID int32) string {
 	return fmt.Sprintf("user_%d_memos", userID)
 }
 
-// getOrCreateCollection returns (or creates) the per-user collection.
-func (s *Store) getOrCreateCollection(userID int32) *chromem.Collection {
-	name := collectionName(userID)
-	col := s.db.GetCollection(name, s.embedFn)
-	if col == nil {
-		var err error
-		col, err = s.db.CreateCollection(name, nil, s.embedFn)
+// writeIndex returns the index memos are embedded into.
+func (s *Store) writeIndex() *index {
+	if s.next != nil {
+		return s.next
+	}
+	return s.active
+}
+
+// indexes returns the indexes whose vectors must be kept in line with the
+// memos.
+func (s *Store) indexes() []*index {
+	if s.next != nil {
+		return []*index{s.active, s.next}
+	}
+	return []*index{s.active}
+}
+
+// Metadata keys stored alongside each passage vector.
+const (
+	MetadataMemoUID     = "memo_uid"
+	MetadataChunkIndex  = "chunk_index"
+	MetadataChunkStart  = "chunk_start"
+	MetadataChunkEnd    = "chunk_end"
+	MetadataTags        = "tags"
+	MetadataVisibility  = "visibility"
+	MetadataRowStatus   = "row_status"
+	MetadataCreatorID   = "creator_id"
+	MetadataDisplayTime = "display_time"
+	// MetadataAttachment and MetadataFilename are only set on passages of
+	// attachments.
+	MetadataAttachment = "attachment"
+	MetadataFilename   = "filename"
+)
+
+// Row status values mirrored from the memo table.
+const (
+	RowStatusNormal   = "NORMAL"
+	RowStatusArchived = "ARCHIVED"
+)
+
+// Memo is a memo as seen by the index. Memos are stored in the collection of
+// their creator.
+type Memo struct {
+	UID        string
+	CreatorID  int32
+	Content    string
+	Tags       []string
+	Visibility string
+	RowStatus  string
+	// DisplayTime is the memo's display time in unix seconds.
+	DisplayTime int64
+	// Attachments are indexed along with the memo, so that searches matching
+	// their text lead to it.
+	Attachments []Attachment
+}
+
+// Attachment is the text extracted from an attachment of a memo.
+type Attachment struct {
+	UID      string
+	Filename string
+	Text     string
+}
+
+// Metadata returns the memo-level metadata stored on each of its passages.
+func (m *Memo) Metadata() map[string]string {
+	rowStatus := m.RowStatus
+	if rowStatus == "" {
+		rowStatus = RowStatusNormal
+	}
+	return map[string]string{
+		MetadataMemoUID:     m.UID,
+		MetadataTags:        strings.Join(m.Tags, " "),
+		MetadataVisibility:  m.Visibility,
+		MetadataRowStatus:   rowStatus,
+		MetadataCreatorID:   strconv.Itoa(int(m.CreatorID)),
+		MetadataDisplayTime: strconv.FormatInt(m.DisplayTime, 10),
+	}
+}
+
+// Filter narrows a semantic search. Zero values match everything.
+type Filter struct {
+	// Tags keeps memos with any of the tags or one of their sub-tags, so
+	// "work" also matches "work/project". A leading "#" is ignored.
+	Tags []string
+	// Visibilities keeps memos with any of the visibilities, e.g. "PUBLIC".
+	Visibilities []string
+	// DisplayTimeAfter and DisplayTimeBefore bound the display time in unix
+	// seconds, inclusive. Zero leaves that side open.
+	DisplayTimeAfter  int64
+	DisplayTimeBefore int64
+	// ExcludeMemoUIDs drops the memos with any of the UIDs.
+	ExcludeMemoUIDs []string
+}
+
+func (f *Filter) isEmpty() bool {
+	return f == nil || (len(f.Tags) == 0 && len(f.Visibilities) == 0 && f.DisplayTimeAfter == 0 && f.DisplayTimeBefore == 0 && len(f.ExcludeMemoUIDs) == 0)
+}
+
+// matches reports whether a passage's metadata satisfies the filter.
+func (f *Filter) matches(metadata map[string]string) bool {
+	if f.isEmpty() {
+		return true
+	}
+	if len(f.Tags) > 0 && !hasAnyTag(strings.Fields(metadata[MetadataTags]), f.Tags) {
+		return false
+	}
+	if len(f.Visibilities) > 0 && !slices.Contains(f.Visibilities, metadata[MetadataVisibility]) {
+		return false
+	}
+	if slices.Contains(f.ExcludeMemoUIDs, metadata[MetadataMemoUID]) {
+		return false
+	}
+	if f.DisplayTimeAfter != 0 || f.DisplayTimeBefore != 0 {
+		displayTime, err := strconv.ParseInt(metadata[MetadataDisplayTime], 10, 64)
 		if err != nil {
-			slog.Error("failed to create vector collection", "user", userID, "err", err)
+			return false
+		}
+		if f.DisplayTimeAfter != 0 && displayTime < f.DisplayTimeAfter {
+			return false
+		}
+		if f.DisplayTimeBefore != 0 && displayTime > f.DisplayTimeBefore {
+			return false
+		}
+	}
+	return true
+}
+
+func hasAnyTag(memoTags, filterTags []string) bool {
+	for _, want := range filterTags {
+		want = strings.TrimPrefix(want, "#")
+		if want == "" {
+			continue
+		}
+		for _, tag := range memoTags {
+			if tag == want || strings.HasPrefix(tag, want+"/") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// chunkID returns the document ID of a memo's i-th passage.
+func chunkID(memoUID string, i int) string {
+	return memoUID + "#" + strconv.Itoa(i)
+}
+
+// sortByChunk sorts the documents of a memo in passage order.
+func sortByChunk(docs []Document) {
+	chunkIndex := func(d Document) int {
+		i, _ := strconv.Atoi(d.ID[strings.LastIndex(d.ID, "#")+1:])
+		return i
+	}
+	sort.Slice(docs, func(i, j int) bool { return chunkIndex(docs[i]) < chunkIndex(docs[j]) })
+}
+
+// embedDocuments fills in the embeddings of docs.
+func embedDocuments(ctx context.Context, embedFn chromem.EmbeddingFunc, docs []Document) error {
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(4)
+	for i := range docs {
+		g.Go(func() error {
+			vector, err := embedFn(ctx, docs[i].Content)
+			if err != nil {
+				return fmt.Errorf("embed %s: %w", docs[i].ID, err)
+			}
+			docs[i].Embedding = vector
 			return nil
+		})
+	}
+	return g.Wait()
+}
+
+// passage is a chunk of a memo's content or of an attachment's text.
+type passage struct {
+	markdown.Chunk
+	// attachment is the attachment the chunk comes from, or nil.
+	attachment *Attachment
+}
+
+// passages splits a memo and then each of its attachments into passages.
+func (s *Store) passages(memo *Memo) ([]passage, error) {
+	chunks, err := s.markdown.Chunk([]byte(memo.Content), maxChunkLength)
+	if err != nil {
+		return nil, err
+	}
+	passages := make([]passage, 0, len(chunks))
+	for _, c := range chunks {
+		passages = append(passages, passage{Chunk: c})
+	}
+	for i := range memo.Attachments {
+		a := &memo.Attachments[i]
+		chunks, err := s.markdown.Chunk([]byte(a.Text), maxChunkLength)
+		if err != nil {
+			return nil, fmt.Errorf("attachment %s: %w", a.UID, err)
+		}
+		for _, c := range chunks {
+			passages = append(passages, passage{Chunk: c, attachment: a})
 		}
 	}
-	return col
+	return passages, nil
 }
 
-// UpsertMemo indexes (or re-indexes) a memo for a user.
-// tags is a space-separated string of hashtags like "#golang #project".
-func (s *Store) UpsertMemo(ctx context.Context, userID int32, memoUID, content, tags string) error {
+// UpsertMemo indexes (or re-indexes) a memo in its creator's collection.
+func (s *Store) UpsertMemo(ctx context.Context, memo *Memo) error {
+	passages, err := s.passages(memo)
+	if err != nil {
+		return fmt.Errorf("chunk memo %s: %w", memo.UID, err)
+	}
+
+	// Embedding calls the provider, so searches are not held up meanwhile.
+	for {
+		s.mu.RLock()
+		x := s.writeIndex()
+		s.mu.RUnlock()
+		docs, err := x.embedMemo(ctx, memo, passages)
+		if err != nil {
+			return err
+		}
+
+		s.mu.Lock()
+		if s.writeIndex() == x {
+			err = x.storeMemo(ctx, memo, docs)
+			s.mu.Unlock()
+			return err
+		}
+		// A re-index started or finished meanwhile; embed for the new index.
+		s.mu.Unlock()
+	}
+}
+
+// embedMemo returns the passages of a memo as documents embedded for x.
+func (x *index) embedMemo(ctx context.Context, memo *Memo, passages []passage) ([]Document, error) {
+	memoUID := memo.UID
+	docs := make([]Document, 0, len(passages))
+	for i, c := range passages {
+		metadata := memo.Metadata()
+		metadata[MetadataChunkIndex] = strconv.Itoa(i)
+		metadata[MetadataChunkStart] = strconv.Itoa(c.Start)
+		metadata[MetadataChunkEnd] = strconv.Itoa(c.End)
+		if c.attachment != nil {
+			metadata[MetadataAttachment] = c.attachment.UID
+			metadata[MetadataFilename] = c.attachment.Filename
+		}
+		docs = append(docs, Document{
+			ID:       chunkID(memoUID, i),
+			Content:  c.Text,
+			Metadata: metadata,
+		})
+	}
+	if err := embedDocuments(ctx, x.embedFn, docs); err != nil {
+		return nil, err
+	}
+	return docs, nil
+}
+
+// storeMemo saves the embedded documents of a memo in x, replacing its
+// previous ones.
+func (x *index) storeMemo(ctx context.Context, memo *Memo, docs []Document) error {
+	memoUID := memo.UID
+	existing, err := x.GetMemo(ctx, memo.CreatorID, memoUID)
+	if err != nil {
+		return err
+	}
+	if len(docs) > 0 {
+		if err := x.Upsert(ctx, memo.CreatorID, docs); err != nil {
+			return err
+		}
+		if err := x.recordDimension(ctx, len(docs[0].Embedding)); err != nil {
+			return err
+		}
+	}
+
+	// Drop passages left over from a longer previous version.
+	var leftover []string
+	for i := len(docs); i < len(existing); i++ {
+		leftover = append(leftover, chunkID(memoUID, i))
+	}
+	return x.Delete(ctx, memo.CreatorID, leftover)
+}
+
+// HasMemo reports whether a memo is already indexed for a user. While memos
+// are re-indexed, it reports on the new index.
+func (s *Store) HasMemo(ctx context.Context, userID int32, memoUID string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.writeIndex().hasMemo(ctx, userID, memoUID)
+}
+
+func (x *index) hasMemo(ctx context.Context, userID int32, memoUID string) bool {
+	docs, err := x.GetMemo(ctx, userID, memoUID)
+	return err == nil && len(docs) > 0
+}
+
+// DeleteMemo removes a memo's vectors. Deleting a memo that was never indexed is a no-op.
+func (s *Store) DeleteMemo(ctx context.Context, userID int32, memoUID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, x := range s.indexes() {
+		if err := x.DeleteMemo(ctx, userID, memoUID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpdateMemoMetadata merges metadata into every passage of an indexed memo
+// without re-embedding it. Updating a memo that was never indexed is a no-op.
+func (s *Store) UpdateMemoMetadata(ctx context.Context, userID int32, memoUID string, metadata map[string]string) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	col := s.getOrCreateCollection(userID)
-	if col == nil {
-		return fmt.Errorf("vectorstore: nil collection for user %d", userID)
+	for _, x := range s.indexes() {
+		if err := x.updateMemoMetadata(ctx, userID, memoUID, metadata); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (x *index) updateMemoMetadata(ctx context.Context, userID int32, memoUID string, metadata map[string]string) error {
+	docs, err := x.GetMemo(ctx, userID, memoUID)
+	if err != nil || len(docs) == 0 {
+		return err
+	}
+	for i := range docs {
+		if docs[i].Metadata == nil {
+			docs[i].Metadata = map[string]string{}
+		}
+		for k, v := range metadata {
+			docs[i].Metadata[k] = v
+		}
 	}
+	// The embeddings are carried over.
+	return x.Upsert(ctx, userID, docs)
+}
+
+// Reconcile brings the index in line with the database. memos maps each user
+// ID to that user's memos; Content is not used. Vectors for memos that no
+// longer exist are dropped (whole collections for users with no memos left),
+// as are vectors in the pre-chunking format, and metadata that has drifted is
+// corrected. It returns the number of vectors removed.
+func (s *Store) Reconcile(ctx context.Context, memos map[int32][]*Memo) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-	doc := chromem.Document{
-		ID:      memoUID,
-		Content: content,
-		Metadata: map[string]string{
-			"tags": tags,
-		},
+	removed := 0
+	for _, x := range s.indexes() {
+		n, err := x.reconcile(ctx, memos)
+		removed += n
+		if err != nil {
+			return removed, err
+		}
 	}
-	return col.AddDocument(ctx, doc)
+	return removed, nil
 }
 
-// SearchSimilar returns the top-k memos most semantically similar to the query.
-func (s *Store) SearchSimilar(ctx context.Context, userID int32, query string, k int) ([]SearchResult, error) {
+func (x *index) reconcile(ctx context.Context, memos map[int32][]*Memo) (int, error) {
+	users, err := x.Users(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("list vector collections: %w", err)
+	}
+	removed := 0
+	for _, userID := range users {
+		docs, err := x.List(ctx, userID)
+		if err != nil {
+			return removed, fmt.Errorf("list vectors of user %d: %w", userID, err)
+		}
+		if len(docs) == 0 {
+			continue
+		}
+
+		states := make(map[string]map[string]string, len(memos[userID]))
+		for _, m := range memos[userID] {
+			states[m.UID] = m.Metadata()
+		}
+
+		var stale []string
+		var drifted []Document
+		for _, d := range docs {
+			want, ok := states[d.Metadata[MetadataMemoUID]]
+			if !ok || !strings.Contains(d.ID, "#") {
+				stale = append(stale, d.ID)
+				continue
+			}
+			if hasMetadata(d.Metadata, want) {
+				continue
+			}
+			for k, v := range want {
+				d.Metadata[k] = v
+			}
+			drifted = append(drifted, d)
+		}
+		if len(stale) == len(docs) {
+			if err := x.DeleteUser(ctx, userID); err != nil {
+				return removed, fmt.Errorf("delete vectors of user %d: %w", userID, err)
+			}
+			removed += len(stale)
+			continue
+		}
+		if len(drifted) > 0 {
+			if err := x.Upsert(ctx, userID, drifted); err != nil {
+				return removed, fmt.Errorf("update metadata of user %d: %w", userID, err)
+			}
+		}
+		if len(stale) > 0 {
+			if err := x.Delete(ctx, userID, stale); err != nil {
+				return removed, fmt.Errorf("delete stale vectors of user %d: %w", userID, err)
+			}
+			removed += len(stale)
+		}
+	}
+	return removed, nil
+}
+
+// SearchSimilar returns the top-k memos most semantically similar to the query,
+// each with its best-matching passage. filter may be nil.
+func (s *Store) SearchSimilar(ctx context.Context, userID int32, query string, k int, filter *Filter) ([]SearchResult, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	col := s.getOrCreateCollection(userID)
-	if col == nil {
-		return nil, nil
+	count, err := s.active.Count(ctx, userID)
+	if err != nil {
+		return nil, err
 	}
-	
-	count := col.Count()
-	if count == 0 {
+	if k <= 0 || count == 0 {
 		return nil, nil
 	}
-	if k > count {
-		k = count
+	queryEmbedding, err := s.active.embedFn(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("embed query: %w", err)
 	}
+	return s.active.search(ctx, userID, count, queryEmbedding, k, filter)
+}
 
-	var results []chromem.Result
-	var err error
-	
-	// chromem-go sometimes throws "nResults must be <= number of documents" despite Count checks.
-	// Step down k if it fails.
-	for attemptK := k; attemptK > 0; attemptK-- {
-		results, err = col.Query(ctx, query, attemptK, nil, nil)
-		if err == nil {
-			break
+// SearchSimilarToMemo returns the top-k memos most semantically similar to an
+// indexed memo of the same user, each with its best-matching passage. The memo
+// is compared by the mean of its passage vectors, so nothing is embedded, and
+// it is left out of the results. It returns nothing if the memo is not
+// indexed. filter may be nil.
+func (s *Store) SearchSimilarToMemo(ctx context.Context, userID int32, memoUID string, k int, filter *Filter) ([]SearchResult, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if k <= 0 {
+		return nil, nil
+	}
+	chunks, err := s.active.GetMemo(ctx, userID, memoUID)
+	if err != nil || len(chunks) == 0 {
+		return nil, err
+	}
+	mean := make([]float32, len(chunks[0].Embedding))
+	for _, c := range chunks {
+		for i, v := range c.Embedding {
+			if i < len(mean) {
+				mean[i] += v
+			}
 		}
 	}
+	var sum float64
+	for _, v := range mean {
+		sum += float64(v) * float64(v)
+	}
+	if sum == 0 {
+		return nil, nil
+	}
+	norm := float32(math.Sqrt(sum))
+	for i := range mean {
+		mean[i] /= norm
+	}
+
+	count, err := s.active.Count(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
+	exclude := Filter{}
+	if filter != nil {
+		exclude = *filter
+	}
+	exclude.ExcludeMemoUIDs = append(slices.Clip(exclude.ExcludeMemoUIDs), memoUID)
+	return s.active.search(ctx, userID, count, mean, k, &exclude)
+}
 
-	out := make([]SearchResult, 0, len(results))
+// search returns the top-k memos of userID, who has count documents, nearest
+// to a unit query vector.
+func (x *index) search(ctx context.Context, userID int32, count int, queryEmbedding []float32, k int, filter *Filter) ([]SearchResult, error) {
+	// Several passages of one memo can rank highly, so fetch extra candidates
+	// to still end up with k distinct memos. Indexes only match metadata
+	// exactly, so the filter is applied afterwards on all candidates.
+	n := min(k*4, count)
+	if !filter.isEmpty() {
+		n = count
+	}
+	// Archived memos stay indexed but are not searchable.
+	where := map[string]string{MetadataRowStatus: RowStatusNormal}
+	results, err := x.Query(ctx, userID, queryEmbedding, n, where)
+	if err != nil {
+		return nil, err
+	}
+
+	best := make(map[string]SearchResult)
 	for _, r := range results {
-		out = append(out, SearchResult{
-			MemoUID: r.ID,
-			Content: r.Content,
-			Score:   r.Similarity,
-		})
+		if !filter.matches(r.Metadata) {
+			continue
+		}
+		uid := r.Metadata[MetadataMemoUID]
+		if prev, ok := best[uid]; ok && prev.Score >= r.Similarity {
+			continue
+		}
+		start, _ := strconv.Atoi(r.Metadata[MetadataChunkStart])
+		end, _ := strconv.Atoi(r.Metadata[MetadataChunkEnd])
+		best[uid] = SearchResult{
+			MemoUID:    uid,
+			Content:    r.Content,
+			Start:      start,
+			End:        end,
+			Score:      r.Similarity,
+			Attachment: r.Metadata[MetadataAttachment],
+			Filename:   r.Metadata[MetadataFilename],
+		}
+	}
+
+	out := make([]SearchResult, 0, len(best))
+	for _, r := range best {
+		out = append(out, r)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Score > out[j].Score })
+	if len(out) > k {
+		out = out[:k]
 	}
 	return out, nil
 }
@@ -0,0 +1,251 @@
+package vectorstore
+
+import (
+	"context"
+	"database/sql"
+	"hash/fnv"
+	"path/filepath"
+	"testing"
+
+	chromem "github.com/philippgille/chromem-go"
+	"github.com/stretchr/testify/require"
+	_ "modernc.org/sqlite"
+)
+
+// fakeEmbed derives a deterministic vector from the text so tests don't need a model.
+func fakeEmbed(_ context.Context, text string) ([]float32, error) {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(text))
+	sum := h.Sum64()
+	vector := make([]float32, 8)
+	for i := range vector {
+		vector[i] = float32((sum>>(i*8))&0xff) + 1
+	}
+	return vector, nil
+}
+
+func fakeEmbedder(string) chromem.EmbeddingFunc {
+	return fakeEmbed
+}
+
+// testBackends create the backends under test. Each returns a function that
+// opens the same storage again, as after a restart.
+var testBackends = map[string]func(t *testing.T) func() Backend{
+	"chromem": func(t *testing.T) func() Backend {
+		dir := t.TempDir()
+		return func() Backend {
+			b, err := NewChromemBackend(dir)
+			require.NoError(t, err)
+			return b
+		}
+	},
+	"sqlite": func(t *testing.T) func() Backend {
+		db, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "memos.db"))
+		require.NoError(t, err)
+		t.Cleanup(func() { db.Close() })
+		return func() Backend {
+			b, err := NewSQLiteBackend(context.Background(), db)
+			require.NoError(t, err)
+			return b
+		}
+	},
+}
+
+// forEachBackend runs test against each backend.
+func forEachBackend(t *testing.T, test func(t *testing.T, open func() Backend)) {
+	for name, backend := range testBackends {
+		t.Run(name, func(t *testing.T) {
+			test(t, backend(t))
+		})
+	}
+}
+
+func newTestStore(t *testing.T, open func() Backend) *Store {
+	s, err := New(context.Background(), open(), "fake", fakeEmbedder)
+	require.NoError(t, err)
+	return s
+}
+
+func count(t *testing.T, s *Store, userID int32) int {
+	n, err := s.active.Count(context.Background(), userID)
+	require.NoError(t, err)
+	return n
+}
+
+func memo(creatorID int32, uid, content string) *Memo {
+	return &Memo{UID: uid, CreatorID: creatorID, Content: content, Visibility: "PRIVATE"}
+}
+
+func TestDeleteAndUpdateMemo(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, open func() Backend) {
+		ctx := context.Background()
+		s := newTestStore(t, open)
+
+		require.NoError(t, s.UpsertMemo(ctx, memo(1, "a", "alpha")))
+		require.NoError(t, s.UpsertMemo(ctx, memo(1, "b", "beta")))
+		require.True(t, s.HasMemo(ctx, 1, "a"))
+
+		// Archived memos drop out of search but stay indexed.
+		require.NoError(t, s.UpdateMemoMetadata(ctx, 1, "a", map[string]string{MetadataRowStatus: RowStatusArchived}))
+		results, err := s.SearchSimilar(ctx, 1, "alpha", 5, nil)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		require.Equal(t, "b", results[0].MemoUID)
+
+		require.NoError(t, s.DeleteMemo(ctx, 1, "b"))
+		require.False(t, s.HasMemo(ctx, 1, "b"))
+		// Deleting from a user without a collection is a no-op.
+		require.NoError(t, s.DeleteMemo(ctx, 2, "b"))
+	})
+}
+
+func TestReconcile(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, open func() Backend) {
+		ctx := context.Background()
+		s := newTestStore(t, open)
+
+		require.NoError(t, s.UpsertMemo(ctx, memo(1, "keep", "keep me")))
+		require.NoError(t, s.UpsertMemo(ctx, memo(1, "gone", "delete me")))
+		require.NoError(t, s.UpsertMemo(ctx, memo(1, "archived", "archive me")))
+		require.NoError(t, s.UpsertMemo(ctx, memo(2, "orphan", "user deleted")))
+		// A vector from before memos were chunked has no memo_uid and is dropped.
+		vector, err := normalized(fakeEmbed)(ctx, "keep me")
+		require.NoError(t, err)
+		require.NoError(t, s.active.Upsert(ctx, 1, []Document{{ID: "keep", Content: "keep me", Embedding: vector}}))
+
+		archived := memo(1, "archived", "")
+		archived.RowStatus = RowStatusArchived
+		kept := memo(1, "keep", "")
+		kept.Tags = []string{"work"}
+		removed, err := s.Reconcile(ctx, map[int32][]*Memo{
+			1: {kept, archived, memo(1, "never-indexed", "")},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 3, removed)
+		require.True(t, s.HasMemo(ctx, 1, "keep"))
+		require.False(t, s.HasMemo(ctx, 1, "gone"))
+		require.False(t, s.HasMemo(ctx, 2, "orphan"))
+
+		results, err := s.SearchSimilar(ctx, 1, "archive me", 5, nil)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		require.Equal(t, "keep", results[0].MemoUID)
+
+		// Drifted tags were corrected too.
+		results, err = s.SearchSimilar(ctx, 1, "keep me", 5, &Filter{Tags: []string{"#work"}})
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+	})
+}
+
+func TestSearchReturnsBestPassage(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, open func() Backend) {
+		ctx := context.Background()
+		s := newTestStore(t, open)
+
+		content := "# Alpha\n\nfirst section\n\n# Beta\n\nsecond section"
+		require.NoError(t, s.UpsertMemo(ctx, memo(1, "a", content)))
+		require.NoError(t, s.UpsertMemo(ctx, memo(1, "b", "unrelated")))
+
+		// fakeEmbed gives identical text identical vectors, so this matches the second passage exactly.
+		results, err := s.SearchSimilar(ctx, 1, "# Beta\n\nsecond section", 5, nil)
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		require.Equal(t, "a", results[0].MemoUID)
+		require.Equal(t, "# Beta\n\nsecond section", results[0].Content)
+		require.Equal(t, results[0].Content, content[results[0].Start:results[0].End])
+
+		// Shrinking a memo removes its surplus passages.
+		require.NoError(t, s.UpsertMemo(ctx, memo(1, "a", "short")))
+		require.LessOrEqual(t, count(t, s, 1), 2)
+	})
+}
+
+func TestSearchAttachmentText(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, open func() Backend) {
+		ctx := context.Background()
+		s := newTestStore(t, open)
+
+		m := memo(1, "a", "see the attached report")
+		m.Attachments = []Attachment{{UID: "r1", Filename: "report.pdf", Text: "Revenue grew 12% in Q3"}}
+		require.NoError(t, s.UpsertMemo(ctx, m))
+		require.Equal(t, 2, count(t, s, 1))
+
+		results, err := s.SearchSimilar(ctx, 1, "Revenue grew 12% in Q3", 5, nil)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		require.Equal(t, "a", results[0].MemoUID)
+		require.Equal(t, "r1", results[0].Attachment)
+		require.Equal(t, "report.pdf", results[0].Filename)
+		require.Equal(t, "Revenue grew 12% in Q3", m.Attachments[0].Text[results[0].Start:results[0].End])
+
+		results, err = s.SearchSimilar(ctx, 1, "see the attached report", 5, nil)
+		require.NoError(t, err)
+		require.Empty(t, results[0].Attachment)
+
+		// Detaching the file drops its passages.
+		m.Attachments = nil
+		require.NoError(t, s.UpsertMemo(ctx, m))
+		require.Equal(t, 1, count(t, s, 1))
+	})
+}
+
+func TestSearchFilter(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, open func() Backend) {
+		ctx := context.Background()
+		s := newTestStore(t, open)
+
+		work := memo(1, "work", "quarterly planning")
+		work.Tags = []string{"work/planning"}
+		work.DisplayTime = 100
+		home := memo(1, "home", "garden planning")
+		home.Tags = []string{"home"}
+		home.Visibility = "PUBLIC"
+		home.DisplayTime = 200
+		require.NoError(t, s.UpsertMemo(ctx, work))
+		require.NoError(t, s.UpsertMemo(ctx, home))
+
+		search := func(filter *Filter) []string {
+			results, err := s.SearchSimilar(ctx, 1, "planning", 5, filter)
+			require.NoError(t, err)
+			var uids []string
+			for _, r := range results {
+				uids = append(uids, r.MemoUID)
+			}
+			return uids
+		}
+		require.Len(t, search(nil), 2)
+		require.Equal(t, []string{"work"}, search(&Filter{Tags: []string{"#work"}}))
+		require.Empty(t, search(&Filter{Tags: []string{"wor"}}))
+		require.Equal(t, []string{"home"}, search(&Filter{Visibilities: []string{"PUBLIC"}}))
+		require.Equal(t, []string{"home"}, search(&Filter{DisplayTimeAfter: 150}))
+		require.Equal(t, []string{"work"}, search(&Filter{DisplayTimeBefore: 150}))
+	})
+}
+
+func TestSearchSimilarToMemo(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, open func() Backend) {
+		ctx := context.Background()
+		s := newTestStore(t, open)
+
+		require.NoError(t, s.UpsertMemo(ctx, memo(1, "a", "alpha")))
+		require.NoError(t, s.UpsertMemo(ctx, memo(1, "b", "alpha")))
+		require.NoError(t, s.UpsertMemo(ctx, memo(1, "c", "gamma")))
+
+		// The memo itself is left out; its identical twin ranks first.
+		results, err := s.SearchSimilarToMemo(ctx, 1, "a", 5, nil)
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		require.Equal(t, "b", results[0].MemoUID)
+		require.InDelta(t, 1, results[0].Score, 1e-5)
+
+		results, err = s.SearchSimilarToMemo(ctx, 1, "a", 5, &Filter{ExcludeMemoUIDs: []string{"b"}})
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		require.Equal(t, "c", results[0].MemoUID)
+
+		results, err = s.SearchSimilarToMemo(ctx, 1, "missing", 5, nil)
+		require.NoError(t, err)
+		require.Empty(t, results)
+	})
+}
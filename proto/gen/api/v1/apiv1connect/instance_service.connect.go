@@ -42,6 +42,9 @@ const (
 	// InstanceServiceUpdateInstanceSettingProcedure is the fully-qualified name of the
 	// InstanceService's UpdateInstanceSetting RPC.
 	InstanceServiceUpdateInstanceSettingProcedure = "/memos.api.v1.InstanceService/UpdateInstanceSetting"
+	// InstanceServiceGetEmbeddingIndexProcedure is the fully-qualified name of the InstanceService's
+	// GetEmbeddingIndex RPC.
+	InstanceServiceGetEmbeddingIndexProcedure = "/memos.api.v1.InstanceService/GetEmbeddingIndex"
 )
 
 // InstanceServiceClient is a client for the memos.api.v1.InstanceService service.
@@ -52,6 +55,9 @@ type InstanceServiceClient interface {
 	GetInstanceSetting(context.Context, *connect.Request[v1.GetInstanceSettingRequest]) (*connect.Response[v1.InstanceSetting], error)
 	// Updates an instance setting.
 	UpdateInstanceSetting(context.Context, *connect.Request[v1.UpdateInstanceSettingRequest]) (*connect.Response[v1.InstanceSetting], error)
+	// Gets the state of the embedding index used for semantic search, including
+	// the progress of re-indexing memos after the embedding model changed.
+	GetEmbeddingIndex(context.Context, *connect.Request[v1.GetEmbeddingIndexRequest]) (*connect.Response[v1.EmbeddingIndex], error)
 }
 
 // NewInstanceServiceClient constructs a client for the memos.api.v1.InstanceService service. By
@@ -83,6 +89,12 @@ func NewInstanceServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(instanceServiceMethods.ByName("UpdateInstanceSetting")),
 			connect.WithClientOptions(opts...),
 		),
+		getEmbeddingIndex: connect.NewClient[v1.GetEmbeddingIndexRequest, v1.EmbeddingIndex](
+			httpClient,
+			baseURL+InstanceServiceGetEmbeddingIndexProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("GetEmbeddingIndex")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -91,6 +103,7 @@ type instanceServiceClient struct {
 	getInstanceProfile    *connect.Client[v1.GetInstanceProfileRequest, v1.InstanceProfile]
 	getInstanceSetting    *connect.Client[v1.GetInstanceSettingRequest, v1.InstanceSetting]
 	updateInstanceSetting *connect.Client[v1.UpdateInstanceSettingRequest, v1.InstanceSetting]
+	getEmbeddingIndex     *connect.Client[v1.GetEmbeddingIndexRequest, v1.EmbeddingIndex]
 }
 
 // GetInstanceProfile calls memos.api.v1.InstanceService.GetInstanceProfile.
@@ -108,6 +121,11 @@ func (c *instanceServiceClient) UpdateInstanceSetting(ctx context.Context, req *
 	return c.updateInstanceSetting.CallUnary(ctx, req)
 }
 
+// GetEmbeddingIndex calls memos.api.v1.InstanceService.GetEmbeddingIndex.
+func (c *instanceServiceClient) GetEmbeddingIndex(ctx context.Context, req *connect.Request[v1.GetEmbeddingIndexRequest]) (*connect.Response[v1.EmbeddingIndex], error) {
+	return c.getEmbeddingIndex.CallUnary(ctx, req)
+}
+
 // InstanceServiceHandler is an implementation of the memos.api.v1.InstanceService service.
 type InstanceServiceHandler interface {
 	// Gets the instance profile.
@@ -116,6 +134,9 @@ type InstanceServiceHandler interface {
 	GetInstanceSetting(context.Context, *connect.Request[v1.GetInstanceSettingRequest]) (*connect.Response[v1.InstanceSetting], error)
 	// Updates an instance setting.
 	UpdateInstanceSetting(context.Context, *connect.Request[v1.UpdateInstanceSettingRequest]) (*connect.Response[v1.InstanceSetting], error)
+	// Gets the state of the embedding index used for semantic search, including
+	// the progress of re-indexing memos after the embedding model changed.
+	GetEmbeddingIndex(context.Context, *connect.Request[v1.GetEmbeddingIndexRequest]) (*connect.Response[v1.EmbeddingIndex], error)
 }
 
 // NewInstanceServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -143,6 +164,12 @@ func NewInstanceServiceHandler(svc InstanceServiceHandler, opts ...connect.Handl
 		connect.WithSchema(instanceServiceMethods.ByName("UpdateInstanceSetting")),
 		connect.WithHandlerOptions(opts...),
 	)
+	instanceServiceGetEmbeddingIndexHandler := connect.NewUnaryHandler(
+		InstanceServiceGetEmbeddingIndexProcedure,
+		svc.GetEmbeddingIndex,
+		connect.WithSchema(instanceServiceMethods.ByName("GetEmbeddingIndex")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/memos.api.v1.InstanceService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case InstanceServiceGetInstanceProfileProcedure:
@@ -151,6 +178,8 @@ func NewInstanceServiceHandler(svc InstanceServiceHandler, opts ...connect.Handl
 			instanceServiceGetInstanceSettingHandler.ServeHTTP(w, r)
 		case InstanceServiceUpdateInstanceSettingProcedure:
 			instanceServiceUpdateInstanceSettingHandler.ServeHTTP(w, r)
+		case InstanceServiceGetEmbeddingIndexProcedure:
+			instanceServiceGetEmbeddingIndexHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -171,3 +200,7 @@ func (UnimplementedInstanceServiceHandler) GetInstanceSetting(context.Context, *
 func (UnimplementedInstanceServiceHandler) UpdateInstanceSetting(context.Context, *connect.Request[v1.UpdateInstanceSettingRequest]) (*connect.Response[v1.InstanceSetting], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.InstanceService.UpdateInstanceSetting is not implemented"))
 }
+
+func (UnimplementedInstanceServiceHandler) GetEmbeddingIndex(context.Context, *connect.Request[v1.GetEmbeddingIndexRequest]) (*connect.Response[v1.EmbeddingIndex], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.InstanceService.GetEmbeddingIndex is not implemented"))
+}
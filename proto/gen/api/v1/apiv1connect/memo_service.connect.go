@@ -38,10 +38,15 @@ const (
 	MemoServiceCreateMemoProcedure = "/memos.api.v1.MemoService/CreateMemo"
 	// MemoServiceListMemosProcedure is the fully-qualified name of the MemoService's ListMemos RPC.
 	MemoServiceListMemosProcedure = "/memos.api.v1.MemoService/ListMemos"
+	// MemoServiceSearchMemosProcedure is the fully-qualified name of the MemoService's SearchMemos RPC.
+	MemoServiceSearchMemosProcedure = "/memos.api.v1.MemoService/SearchMemos"
 	// MemoServiceGetMemoProcedure is the fully-qualified name of the MemoService's GetMemo RPC.
 	MemoServiceGetMemoProcedure = "/memos.api.v1.MemoService/GetMemo"
 	// MemoServiceUpdateMemoProcedure is the fully-qualified name of the MemoService's UpdateMemo RPC.
 	MemoServiceUpdateMemoProcedure = "/memos.api.v1.MemoService/UpdateMemo"
+	// MemoServiceAcceptMemoTagSuggestionsProcedure is the fully-qualified name of the MemoService's
+	// AcceptMemoTagSuggestions RPC.
+	MemoServiceAcceptMemoTagSuggestionsProcedure = "/memos.api.v1.MemoService/AcceptMemoTagSuggestions"
 	// MemoServiceDeleteMemoProcedure is the fully-qualified name of the MemoService's DeleteMemo RPC.
 	MemoServiceDeleteMemoProcedure = "/memos.api.v1.MemoService/DeleteMemo"
 	// MemoServiceSetMemoAttachmentsProcedure is the fully-qualified name of the MemoService's
@@ -56,6 +61,9 @@ const (
 	// MemoServiceListMemoRelationsProcedure is the fully-qualified name of the MemoService's
 	// ListMemoRelations RPC.
 	MemoServiceListMemoRelationsProcedure = "/memos.api.v1.MemoService/ListMemoRelations"
+	// MemoServiceListRelatedMemosProcedure is the fully-qualified name of the MemoService's
+	// ListRelatedMemos RPC.
+	MemoServiceListRelatedMemosProcedure = "/memos.api.v1.MemoService/ListRelatedMemos"
 	// MemoServiceCreateMemoCommentProcedure is the fully-qualified name of the MemoService's
 	// CreateMemoComment RPC.
 	MemoServiceCreateMemoCommentProcedure = "/memos.api.v1.MemoService/CreateMemoComment"
@@ -79,10 +87,14 @@ type MemoServiceClient interface {
 	CreateMemo(context.Context, *connect.Request[v1.CreateMemoRequest]) (*connect.Response[v1.Memo], error)
 	// ListMemos lists memos with pagination and filter.
 	ListMemos(context.Context, *connect.Request[v1.ListMemosRequest]) (*connect.Response[v1.ListMemosResponse], error)
+	// SearchMemos searches memos by keyword and meaning, ranked by relevance.
+	SearchMemos(context.Context, *connect.Request[v1.SearchMemosRequest]) (*connect.Response[v1.SearchMemosResponse], error)
 	// GetMemo gets a memo.
 	GetMemo(context.Context, *connect.Request[v1.GetMemoRequest]) (*connect.Response[v1.Memo], error)
 	// UpdateMemo updates a memo.
 	UpdateMemo(context.Context, *connect.Request[v1.UpdateMemoRequest]) (*connect.Response[v1.Memo], error)
+	// AcceptMemoTagSuggestions adds suggested tags to a memo and dismisses the rest.
+	AcceptMemoTagSuggestions(context.Context, *connect.Request[v1.AcceptMemoTagSuggestionsRequest]) (*connect.Response[v1.Memo], error)
 	// DeleteMemo deletes a memo.
 	DeleteMemo(context.Context, *connect.Request[v1.DeleteMemoRequest]) (*connect.Response[emptypb.Empty], error)
 	// SetMemoAttachments sets attachments for a memo.
@@ -93,6 +105,9 @@ type MemoServiceClient interface {
 	SetMemoRelations(context.Context, *connect.Request[v1.SetMemoRelationsRequest]) (*connect.Response[emptypb.Empty], error)
 	// ListMemoRelations lists relations for a memo.
 	ListMemoRelations(context.Context, *connect.Request[v1.ListMemoRelationsRequest]) (*connect.Response[v1.ListMemoRelationsResponse], error)
+	// ListRelatedMemos lists the memos most semantically similar to a memo that
+	// are not yet linked to it.
+	ListRelatedMemos(context.Context, *connect.Request[v1.ListRelatedMemosRequest]) (*connect.Response[v1.ListRelatedMemosResponse], error)
 	// CreateMemoComment creates a comment for a memo.
 	CreateMemoComment(context.Context, *connect.Request[v1.CreateMemoCommentRequest]) (*connect.Response[v1.Memo], error)
 	// ListMemoComments lists comments for a memo.
@@ -128,6 +143,12 @@ func NewMemoServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(memoServiceMethods.ByName("ListMemos")),
 			connect.WithClientOptions(opts...),
 		),
+		searchMemos: connect.NewClient[v1.SearchMemosRequest, v1.SearchMemosResponse](
+			httpClient,
+			baseURL+MemoServiceSearchMemosProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("SearchMemos")),
+			connect.WithClientOptions(opts...),
+		),
 		getMemo: connect.NewClient[v1.GetMemoRequest, v1.Memo](
 			httpClient,
 			baseURL+MemoServiceGetMemoProcedure,
@@ -140,6 +161,12 @@ func NewMemoServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(memoServiceMethods.ByName("UpdateMemo")),
 			connect.WithClientOptions(opts...),
 		),
+		acceptMemoTagSuggestions: connect.NewClient[v1.AcceptMemoTagSuggestionsRequest, v1.Memo](
+			httpClient,
+			baseURL+MemoServiceAcceptMemoTagSuggestionsProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("AcceptMemoTagSuggestions")),
+			connect.WithClientOptions(opts...),
+		),
 		deleteMemo: connect.NewClient[v1.DeleteMemoRequest, emptypb.Empty](
 			httpClient,
 			baseURL+MemoServiceDeleteMemoProcedure,
@@ -170,6 +197,12 @@ func NewMemoServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(memoServiceMethods.ByName("ListMemoRelations")),
 			connect.WithClientOptions(opts...),
 		),
+		listRelatedMemos: connect.NewClient[v1.ListRelatedMemosRequest, v1.ListRelatedMemosResponse](
+			httpClient,
+			baseURL+MemoServiceListRelatedMemosProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("ListRelatedMemos")),
+			connect.WithClientOptions(opts...),
+		),
 		createMemoComment: connect.NewClient[v1.CreateMemoCommentRequest, v1.Memo](
 			httpClient,
 			baseURL+MemoServiceCreateMemoCommentProcedure,
@@ -205,20 +238,23 @@ func NewMemoServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 
 // memoServiceClient implements MemoServiceClient.
 type memoServiceClient struct {
-	createMemo          *connect.Client[v1.CreateMemoRequest, v1.Memo]
-	listMemos           *connect.Client[v1.ListMemosRequest, v1.ListMemosResponse]
-	getMemo             *connect.Client[v1.GetMemoRequest, v1.Memo]
-	updateMemo          *connect.Client[v1.UpdateMemoRequest, v1.Memo]
-	deleteMemo          *connect.Client[v1.DeleteMemoRequest, emptypb.Empty]
-	setMemoAttachments  *connect.Client[v1.SetMemoAttachmentsRequest, emptypb.Empty]
-	listMemoAttachments *connect.Client[v1.ListMemoAttachmentsRequest, v1.ListMemoAttachmentsResponse]
-	setMemoRelations    *connect.Client[v1.SetMemoRelationsRequest, emptypb.Empty]
-	listMemoRelations   *connect.Client[v1.ListMemoRelationsRequest, v1.ListMemoRelationsResponse]
-	createMemoComment   *connect.Client[v1.CreateMemoCommentRequest, v1.Memo]
-	listMemoComments    *connect.Client[v1.ListMemoCommentsRequest, v1.ListMemoCommentsResponse]
-	listMemoReactions   *connect.Client[v1.ListMemoReactionsRequest, v1.ListMemoReactionsResponse]
-	upsertMemoReaction  *connect.Client[v1.UpsertMemoReactionRequest, v1.Reaction]
-	deleteMemoReaction  *connect.Client[v1.DeleteMemoReactionRequest, emptypb.Empty]
+	createMemo               *connect.Client[v1.CreateMemoRequest, v1.Memo]
+	listMemos                *connect.Client[v1.ListMemosRequest, v1.ListMemosResponse]
+	searchMemos              *connect.Client[v1.SearchMemosRequest, v1.SearchMemosResponse]
+	getMemo                  *connect.Client[v1.GetMemoRequest, v1.Memo]
+	updateMemo               *connect.Client[v1.UpdateMemoRequest, v1.Memo]
+	acceptMemoTagSuggestions *connect.Client[v1.AcceptMemoTagSuggestionsRequest, v1.Memo]
+	deleteMemo               *connect.Client[v1.DeleteMemoRequest, emptypb.Empty]
+	setMemoAttachments       *connect.Client[v1.SetMemoAttachmentsRequest, emptypb.Empty]
+	listMemoAttachments      *connect.Client[v1.ListMemoAttachmentsRequest, v1.ListMemoAttachmentsResponse]
+	setMemoRelations         *connect.Client[v1.SetMemoRelationsRequest, emptypb.Empty]
+	listMemoRelations        *connect.Client[v1.ListMemoRelationsRequest, v1.ListMemoRelationsResponse]
+	listRelatedMemos         *connect.Client[v1.ListRelatedMemosRequest, v1.ListRelatedMemosResponse]
+	createMemoComment        *connect.Client[v1.CreateMemoCommentRequest, v1.Memo]
+	listMemoComments         *connect.Client[v1.ListMemoCommentsRequest, v1.ListMemoCommentsResponse]
+	listMemoReactions        *connect.Client[v1.ListMemoReactionsRequest, v1.ListMemoReactionsResponse]
+	upsertMemoReaction       *connect.Client[v1.UpsertMemoReactionRequest, v1.Reaction]
+	deleteMemoReaction       *connect.Client[v1.DeleteMemoReactionRequest, emptypb.Empty]
 }
 
 // CreateMemo calls memos.api.v1.MemoService.CreateMemo.
@@ -231,6 +267,11 @@ func (c *memoServiceClient) ListMemos(ctx context.Context, req *connect.Request[
 	return c.listMemos.CallUnary(ctx, req)
 }
 
+// SearchMemos calls memos.api.v1.MemoService.SearchMemos.
+func (c *memoServiceClient) SearchMemos(ctx context.Context, req *connect.Request[v1.SearchMemosRequest]) (*connect.Response[v1.SearchMemosResponse], error) {
+	return c.searchMemos.CallUnary(ctx, req)
+}
+
 // GetMemo calls memos.api.v1.MemoService.GetMemo.
 func (c *memoServiceClient) GetMemo(ctx context.Context, req *connect.Request[v1.GetMemoRequest]) (*connect.Response[v1.Memo], error) {
 	return c.getMemo.CallUnary(ctx, req)
@@ -241,6 +282,11 @@ func (c *memoServiceClient) UpdateMemo(ctx context.Context, req *connect.Request
 	return c.updateMemo.CallUnary(ctx, req)
 }
 
+// AcceptMemoTagSuggestions calls memos.api.v1.MemoService.AcceptMemoTagSuggestions.
+func (c *memoServiceClient) AcceptMemoTagSuggestions(ctx context.Context, req *connect.Request[v1.AcceptMemoTagSuggestionsRequest]) (*connect.Response[v1.Memo], error) {
+	return c.acceptMemoTagSuggestions.CallUnary(ctx, req)
+}
+
 // DeleteMemo calls memos.api.v1.MemoService.DeleteMemo.
 func (c *memoServiceClient) DeleteMemo(ctx context.Context, req *connect.Request[v1.DeleteMemoRequest]) (*connect.Response[emptypb.Empty], error) {
 	return c.deleteMemo.CallUnary(ctx, req)
@@ -266,6 +312,11 @@ func (c *memoServiceClient) ListMemoRelations(ctx context.Context, req *connect.
 	return c.listMemoRelations.CallUnary(ctx, req)
 }
 
+// ListRelatedMemos calls memos.api.v1.MemoService.ListRelatedMemos.
+func (c *memoServiceClient) ListRelatedMemos(ctx context.Context, req *connect.Request[v1.ListRelatedMemosRequest]) (*connect.Response[v1.ListRelatedMemosResponse], error) {
+	return c.listRelatedMemos.CallUnary(ctx, req)
+}
+
 // CreateMemoComment calls memos.api.v1.MemoService.CreateMemoComment.
 func (c *memoServiceClient) CreateMemoComment(ctx context.Context, req *connect.Request[v1.CreateMemoCommentRequest]) (*connect.Response[v1.Memo], error) {
 	return c.createMemoComment.CallUnary(ctx, req)
@@ -297,10 +348,14 @@ type MemoServiceHandler interface {
 	CreateMemo(context.Context, *connect.Request[v1.CreateMemoRequest]) (*connect.Response[v1.Memo], error)
 	// ListMemos lists memos with pagination and filter.
 	ListMemos(context.Context, *connect.Request[v1.ListMemosRequest]) (*connect.Response[v1.ListMemosResponse], error)
+	// SearchMemos searches memos by keyword and meaning, ranked by relevance.
+	SearchMemos(context.Context, *connect.Request[v1.SearchMemosRequest]) (*connect.Response[v1.SearchMemosResponse], error)
 	// GetMemo gets a memo.
 	GetMemo(context.Context, *connect.Request[v1.GetMemoRequest]) (*connect.Response[v1.Memo], error)
 	// UpdateMemo updates a memo.
 	UpdateMemo(context.Context, *connect.Request[v1.UpdateMemoRequest]) (*connect.Response[v1.Memo], error)
+	// AcceptMemoTagSuggestions adds suggested tags to a memo and dismisses the rest.
+	AcceptMemoTagSuggestions(context.Context, *connect.Request[v1.AcceptMemoTagSuggestionsRequest]) (*connect.Response[v1.Memo], error)
 	// DeleteMemo deletes a memo.
 	DeleteMemo(context.Context, *connect.Request[v1.DeleteMemoRequest]) (*connect.Response[emptypb.Empty], error)
 	// SetMemoAttachments sets attachments for a memo.
@@ -311,6 +366,9 @@ type MemoServiceHandler interface {
 	SetMemoRelations(context.Context, *connect.Request[v1.SetMemoRelationsRequest]) (*connect.Response[emptypb.Empty], error)
 	// ListMemoRelations lists relations for a memo.
 	ListMemoRelations(context.Context, *connect.Request[v1.ListMemoRelationsRequest]) (*connect.Response[v1.ListMemoRelationsResponse], error)
+	// ListRelatedMemos lists the memos most semantically similar to a memo that
+	// are not yet linked to it.
+	ListRelatedMemos(context.Context, *connect.Request[v1.ListRelatedMemosRequest]) (*connect.Response[v1.ListRelatedMemosResponse], error)
 	// CreateMemoComment creates a comment for a memo.
 	CreateMemoComment(context.Context, *connect.Request[v1.CreateMemoCommentRequest]) (*connect.Response[v1.Memo], error)
 	// ListMemoComments lists comments for a memo.
@@ -342,6 +400,12 @@ func NewMemoServiceHandler(svc MemoServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(memoServiceMethods.ByName("ListMemos")),
 		connect.WithHandlerOptions(opts...),
 	)
+	memoServiceSearchMemosHandler := connect.NewUnaryHandler(
+		MemoServiceSearchMemosProcedure,
+		svc.SearchMemos,
+		connect.WithSchema(memoServiceMethods.ByName("SearchMemos")),
+		connect.WithHandlerOptions(opts...),
+	)
 	memoServiceGetMemoHandler := connect.NewUnaryHandler(
 		MemoServiceGetMemoProcedure,
 		svc.GetMemo,
@@ -354,6 +418,12 @@ func NewMemoServiceHandler(svc MemoServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(memoServiceMethods.ByName("UpdateMemo")),
 		connect.WithHandlerOptions(opts...),
 	)
+	memoServiceAcceptMemoTagSuggestionsHandler := connect.NewUnaryHandler(
+		MemoServiceAcceptMemoTagSuggestionsProcedure,
+		svc.AcceptMemoTagSuggestions,
+		connect.WithSchema(memoServiceMethods.ByName("AcceptMemoTagSuggestions")),
+		connect.WithHandlerOptions(opts...),
+	)
 	memoServiceDeleteMemoHandler := connect.NewUnaryHandler(
 		MemoServiceDeleteMemoProcedure,
 		svc.DeleteMemo,
@@ -384,6 +454,12 @@ func NewMemoServiceHandler(svc MemoServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(memoServiceMethods.ByName("ListMemoRelations")),
 		connect.WithHandlerOptions(opts...),
 	)
+	memoServiceListRelatedMemosHandler := connect.NewUnaryHandler(
+		MemoServiceListRelatedMemosProcedure,
+		svc.ListRelatedMemos,
+		connect.WithSchema(memoServiceMethods.ByName("ListRelatedMemos")),
+		connect.WithHandlerOptions(opts...),
+	)
 	memoServiceCreateMemoCommentHandler := connect.NewUnaryHandler(
 		MemoServiceCreateMemoCommentProcedure,
 		svc.CreateMemoComment,
@@ -420,10 +496,14 @@ func NewMemoServiceHandler(svc MemoServiceHandler, opts ...connect.HandlerOption
 			memoServiceCreateMemoHandler.ServeHTTP(w, r)
 		case MemoServiceListMemosProcedure:
 			memoServiceListMemosHandler.ServeHTTP(w, r)
+		case MemoServiceSearchMemosProcedure:
+			memoServiceSearchMemosHandler.ServeHTTP(w, r)
 		case MemoServiceGetMemoProcedure:
 			memoServiceGetMemoHandler.ServeHTTP(w, r)
 		case MemoServiceUpdateMemoProcedure:
 			memoServiceUpdateMemoHandler.ServeHTTP(w, r)
+		case MemoServiceAcceptMemoTagSuggestionsProcedure:
+			memoServiceAcceptMemoTagSuggestionsHandler.ServeHTTP(w, r)
 		case MemoServiceDeleteMemoProcedure:
 			memoServiceDeleteMemoHandler.ServeHTTP(w, r)
 		case MemoServiceSetMemoAttachmentsProcedure:
@@ -434,6 +514,8 @@ func NewMemoServiceHandler(svc MemoServiceHandler, opts ...connect.HandlerOption
 			memoServiceSetMemoRelationsHandler.ServeHTTP(w, r)
 		case MemoServiceListMemoRelationsProcedure:
 			memoServiceListMemoRelationsHandler.ServeHTTP(w, r)
+		case MemoServiceListRelatedMemosProcedure:
+			memoServiceListRelatedMemosHandler.ServeHTTP(w, r)
 		case MemoServiceCreateMemoCommentProcedure:
 			memoServiceCreateMemoCommentHandler.ServeHTTP(w, r)
 		case MemoServiceListMemoCommentsProcedure:
@@ -461,6 +543,10 @@ func (UnimplementedMemoServiceHandler) ListMemos(context.Context, *connect.Reque
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.ListMemos is not implemented"))
 }
 
+func (UnimplementedMemoServiceHandler) SearchMemos(context.Context, *connect.Request[v1.SearchMemosRequest]) (*connect.Response[v1.SearchMemosResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.SearchMemos is not implemented"))
+}
+
 func (UnimplementedMemoServiceHandler) GetMemo(context.Context, *connect.Request[v1.GetMemoRequest]) (*connect.Response[v1.Memo], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.GetMemo is not implemented"))
 }
@@ -469,6 +555,10 @@ func (UnimplementedMemoServiceHandler) UpdateMemo(context.Context, *connect.Requ
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.UpdateMemo is not implemented"))
 }
 
+func (UnimplementedMemoServiceHandler) AcceptMemoTagSuggestions(context.Context, *connect.Request[v1.AcceptMemoTagSuggestionsRequest]) (*connect.Response[v1.Memo], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.AcceptMemoTagSuggestions is not implemented"))
+}
+
 func (UnimplementedMemoServiceHandler) DeleteMemo(context.Context, *connect.Request[v1.DeleteMemoRequest]) (*connect.Response[emptypb.Empty], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.DeleteMemo is not implemented"))
 }
@@ -489,6 +579,10 @@ func (UnimplementedMemoServiceHandler) ListMemoRelations(context.Context, *conne
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.ListMemoRelations is not implemented"))
 }
 
+func (UnimplementedMemoServiceHandler) ListRelatedMemos(context.Context, *connect.Request[v1.ListRelatedMemosRequest]) (*connect.Response[v1.ListRelatedMemosResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.ListRelatedMemos is not implemented"))
+}
+
 func (UnimplementedMemoServiceHandler) CreateMemoComment(context.Context, *connect.Request[v1.CreateMemoCommentRequest]) (*connect.Response[v1.Memo], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.CreateMemoComment is not implemented"))
 }
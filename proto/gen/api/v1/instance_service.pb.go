@@ -11,6 +11,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -34,6 +35,8 @@ const (
 	InstanceSetting_STORAGE InstanceSetting_Key = 2
 	// MEMO_RELATED is the key for memo related settings.
 	InstanceSetting_MEMO_RELATED InstanceSetting_Key = 3
+	// AI is the key for AI settings.
+	InstanceSetting_AI InstanceSetting_Key = 4
 )
 
 // Enum value maps for InstanceSetting_Key.
@@ -43,12 +46,14 @@ var (
 		1: "GENERAL",
 		2: "STORAGE",
 		3: "MEMO_RELATED",
+		4: "AI",
 	}
 	InstanceSetting_Key_value = map[string]int32{
 		"KEY_UNSPECIFIED": 0,
 		"GENERAL":         1,
 		"STORAGE":         2,
 		"MEMO_RELATED":    3,
+		"AI":              4,
 	}
 )
 
@@ -135,6 +140,63 @@ func (InstanceSetting_StorageSetting_StorageType) EnumDescriptor() ([]byte, []in
 	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{2, 1, 0}
 }
 
+// WebAccess is how the assistant reads web pages.
+type InstanceSetting_AISetting_WebAccess int32
+
+const (
+	// WEB_ACCESS_UNSPECIFIED behaves like LOCAL.
+	InstanceSetting_AISetting_WEB_ACCESS_UNSPECIFIED InstanceSetting_AISetting_WebAccess = 0
+	// LOCAL fetches pages from the server and extracts their content there.
+	InstanceSetting_AISetting_LOCAL InstanceSetting_AISetting_WebAccess = 1
+	// READER reads pages through the reader service at reader_url.
+	InstanceSetting_AISetting_READER InstanceSetting_AISetting_WebAccess = 2
+	// DISABLED keeps the assistant off the web, including web search.
+	InstanceSetting_AISetting_DISABLED InstanceSetting_AISetting_WebAccess = 3
+)
+
+// Enum value maps for InstanceSetting_AISetting_WebAccess.
+var (
+	InstanceSetting_AISetting_WebAccess_name = map[int32]string{
+		0: "WEB_ACCESS_UNSPECIFIED",
+		1: "LOCAL",
+		2: "READER",
+		3: "DISABLED",
+	}
+	InstanceSetting_AISetting_WebAccess_value = map[string]int32{
+		"WEB_ACCESS_UNSPECIFIED": 0,
+		"LOCAL":                  1,
+		"READER":                 2,
+		"DISABLED":               3,
+	}
+)
+
+func (x InstanceSetting_AISetting_WebAccess) Enum() *InstanceSetting_AISetting_WebAccess {
+	p := new(InstanceSetting_AISetting_WebAccess)
+	*p = x
+	return p
+}
+
+func (x InstanceSetting_AISetting_WebAccess) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InstanceSetting_AISetting_WebAccess) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_instance_service_proto_enumTypes[2].Descriptor()
+}
+
+func (InstanceSetting_AISetting_WebAccess) Type() protoreflect.EnumType {
+	return &file_api_v1_instance_service_proto_enumTypes[2]
+}
+
+func (x InstanceSetting_AISetting_WebAccess) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InstanceSetting_AISetting_WebAccess.Descriptor instead.
+func (InstanceSetting_AISetting_WebAccess) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{2, 3, 0}
+}
+
 // Instance profile message containing basic instance information.
 type InstanceProfile struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -257,6 +319,7 @@ type InstanceSetting struct {
 	//	*InstanceSetting_GeneralSetting_
 	//	*InstanceSetting_StorageSetting_
 	//	*InstanceSetting_MemoRelatedSetting_
+	//	*InstanceSetting_AiSetting
 	Value         isInstanceSetting_Value `protobuf_oneof:"value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -333,6 +396,15 @@ func (x *InstanceSetting) GetMemoRelatedSetting() *InstanceSetting_MemoRelatedSe
 	return nil
 }
 
+func (x *InstanceSetting) GetAiSetting() *InstanceSetting_AISetting {
+	if x != nil {
+		if x, ok := x.Value.(*InstanceSetting_AiSetting); ok {
+			return x.AiSetting
+		}
+	}
+	return nil
+}
+
 type isInstanceSetting_Value interface {
 	isInstanceSetting_Value()
 }
@@ -349,12 +421,18 @@ type InstanceSetting_MemoRelatedSetting_ struct {
 	MemoRelatedSetting *InstanceSetting_MemoRelatedSetting `protobuf:"bytes,4,opt,name=memo_related_setting,json=memoRelatedSetting,proto3,oneof"`
 }
 
+type InstanceSetting_AiSetting struct {
+	AiSetting *InstanceSetting_AISetting `protobuf:"bytes,5,opt,name=ai_setting,json=aiSetting,proto3,oneof"`
+}
+
 func (*InstanceSetting_GeneralSetting_) isInstanceSetting_Value() {}
 
 func (*InstanceSetting_StorageSetting_) isInstanceSetting_Value() {}
 
 func (*InstanceSetting_MemoRelatedSetting_) isInstanceSetting_Value() {}
 
+func (*InstanceSetting_AiSetting) isInstanceSetting_Value() {}
+
 // Request message for GetInstanceSetting method.
 type GetInstanceSettingRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -457,6 +535,107 @@ func (x *UpdateInstanceSettingRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
 	return nil
 }
 
+// Request message for GetEmbeddingIndex method.
+type GetEmbeddingIndexRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEmbeddingIndexRequest) Reset() {
+	*x = GetEmbeddingIndexRequest{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEmbeddingIndexRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEmbeddingIndexRequest) ProtoMessage() {}
+
+func (x *GetEmbeddingIndexRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEmbeddingIndexRequest.ProtoReflect.Descriptor instead.
+func (*GetEmbeddingIndexRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{5}
+}
+
+// The embedding index used for semantic search.
+type EmbeddingIndex struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The embedding model of the index that searches use.
+	Model string `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
+	// The dimension of the vectors of the index, or 0 while it is empty.
+	Dimension int32 `protobuf:"varint,2,opt,name=dimension,proto3" json:"dimension,omitempty"`
+	// Set while memos are re-indexed.
+	Reindex       *EmbeddingIndex_Reindex `protobuf:"bytes,3,opt,name=reindex,proto3" json:"reindex,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmbeddingIndex) Reset() {
+	*x = EmbeddingIndex{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmbeddingIndex) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmbeddingIndex) ProtoMessage() {}
+
+func (x *EmbeddingIndex) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmbeddingIndex.ProtoReflect.Descriptor instead.
+func (*EmbeddingIndex) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EmbeddingIndex) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *EmbeddingIndex) GetDimension() int32 {
+	if x != nil {
+		return x.Dimension
+	}
+	return 0
+}
+
+func (x *EmbeddingIndex) GetReindex() *EmbeddingIndex_Reindex {
+	if x != nil {
+		return x.Reindex
+	}
+	return nil
+}
+
 // General instance settings configuration.
 type InstanceSetting_GeneralSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -484,7 +663,7 @@ type InstanceSetting_GeneralSetting struct {
 
 func (x *InstanceSetting_GeneralSetting) Reset() {
 	*x = InstanceSetting_GeneralSetting{}
-	mi := &file_api_v1_instance_service_proto_msgTypes[5]
+	mi := &file_api_v1_instance_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -496,7 +675,7 @@ func (x *InstanceSetting_GeneralSetting) String() string {
 func (*InstanceSetting_GeneralSetting) ProtoMessage() {}
 
 func (x *InstanceSetting_GeneralSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_instance_service_proto_msgTypes[5]
+	mi := &file_api_v1_instance_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -586,7 +765,7 @@ type InstanceSetting_StorageSetting struct {
 
 func (x *InstanceSetting_StorageSetting) Reset() {
 	*x = InstanceSetting_StorageSetting{}
-	mi := &file_api_v1_instance_service_proto_msgTypes[6]
+	mi := &file_api_v1_instance_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -598,7 +777,7 @@ func (x *InstanceSetting_StorageSetting) String() string {
 func (*InstanceSetting_StorageSetting) ProtoMessage() {}
 
 func (x *InstanceSetting_StorageSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_instance_service_proto_msgTypes[6]
+	mi := &file_api_v1_instance_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -663,7 +842,7 @@ type InstanceSetting_MemoRelatedSetting struct {
 
 func (x *InstanceSetting_MemoRelatedSetting) Reset() {
 	*x = InstanceSetting_MemoRelatedSetting{}
-	mi := &file_api_v1_instance_service_proto_msgTypes[7]
+	mi := &file_api_v1_instance_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -675,7 +854,7 @@ func (x *InstanceSetting_MemoRelatedSetting) String() string {
 func (*InstanceSetting_MemoRelatedSetting) ProtoMessage() {}
 
 func (x *InstanceSetting_MemoRelatedSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_instance_service_proto_msgTypes[7]
+	mi := &file_api_v1_instance_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -733,6 +912,142 @@ func (x *InstanceSetting_MemoRelatedSetting) GetReactions() []string {
 	return nil
 }
 
+// AI instance settings, including usage quotas.
+type InstanceSetting_AISetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// monthly_token_quota is the number of tokens each user may spend on AI
+	// features per calendar month (UTC). 0 means unlimited.
+	MonthlyTokenQuota int64 `protobuf:"varint,1,opt,name=monthly_token_quota,json=monthlyTokenQuota,proto3" json:"monthly_token_quota,omitempty"`
+	// role_monthly_token_quotas overrides monthly_token_quota for the users of
+	// a role, keyed by role, e.g. "USER".
+	RoleMonthlyTokenQuotas map[string]int64 `protobuf:"bytes,2,rep,name=role_monthly_token_quotas,json=roleMonthlyTokenQuotas,proto3" json:"role_monthly_token_quotas,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// user_monthly_token_quotas overrides the other quotas for single users,
+	// keyed by user name. Format: users/{user}
+	UserMonthlyTokenQuotas map[string]int64 `protobuf:"bytes,3,rep,name=user_monthly_token_quotas,json=userMonthlyTokenQuotas,proto3" json:"user_monthly_token_quotas,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// mcp_servers are external MCP servers whose tools the assistant of every
+	// user can use.
+	McpServers []*MCPServer `protobuf:"bytes,4,rep,name=mcp_servers,json=mcpServers,proto3" json:"mcp_servers,omitempty"`
+	// web_access is how the assistant reads web pages.
+	WebAccess InstanceSetting_AISetting_WebAccess `protobuf:"varint,5,opt,name=web_access,json=webAccess,proto3,enum=memos.api.v1.InstanceSetting_AISetting_WebAccess" json:"web_access,omitempty"`
+	// reader_url is the endpoint of the reader service used when web_access
+	// is READER. The page URL is appended to it, e.g. https://r.jina.ai/
+	ReaderUrl string `protobuf:"bytes,6,opt,name=reader_url,json=readerUrl,proto3" json:"reader_url,omitempty"`
+	// disabled_tools are built-in assistant tools, e.g. "delete_memo", that
+	// no user's assistant may use.
+	DisabledTools []string `protobuf:"bytes,7,rep,name=disabled_tools,json=disabledTools,proto3" json:"disabled_tools,omitempty"`
+	// allowed_models are the chat models users may pick. When empty, only the
+	// server's default model is used.
+	AllowedModels []string `protobuf:"bytes,8,rep,name=allowed_models,json=allowedModels,proto3" json:"allowed_models,omitempty"`
+	// system_prompt_preamble replaces the opening of the assistant's system
+	// prompt, which says who the assistant is.
+	SystemPromptPreamble string `protobuf:"bytes,9,opt,name=system_prompt_preamble,json=systemPromptPreamble,proto3" json:"system_prompt_preamble,omitempty"`
+	// max_agent_rounds caps the tool-use rounds of a chat turn, up to 50. 0
+	// means the default of 12.
+	MaxAgentRounds int32 `protobuf:"varint,10,opt,name=max_agent_rounds,json=maxAgentRounds,proto3" json:"max_agent_rounds,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InstanceSetting_AISetting) Reset() {
+	*x = InstanceSetting_AISetting{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceSetting_AISetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceSetting_AISetting) ProtoMessage() {}
+
+func (x *InstanceSetting_AISetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceSetting_AISetting.ProtoReflect.Descriptor instead.
+func (*InstanceSetting_AISetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{2, 3}
+}
+
+func (x *InstanceSetting_AISetting) GetMonthlyTokenQuota() int64 {
+	if x != nil {
+		return x.MonthlyTokenQuota
+	}
+	return 0
+}
+
+func (x *InstanceSetting_AISetting) GetRoleMonthlyTokenQuotas() map[string]int64 {
+	if x != nil {
+		return x.RoleMonthlyTokenQuotas
+	}
+	return nil
+}
+
+func (x *InstanceSetting_AISetting) GetUserMonthlyTokenQuotas() map[string]int64 {
+	if x != nil {
+		return x.UserMonthlyTokenQuotas
+	}
+	return nil
+}
+
+func (x *InstanceSetting_AISetting) GetMcpServers() []*MCPServer {
+	if x != nil {
+		return x.McpServers
+	}
+	return nil
+}
+
+func (x *InstanceSetting_AISetting) GetWebAccess() InstanceSetting_AISetting_WebAccess {
+	if x != nil {
+		return x.WebAccess
+	}
+	return InstanceSetting_AISetting_WEB_ACCESS_UNSPECIFIED
+}
+
+func (x *InstanceSetting_AISetting) GetReaderUrl() string {
+	if x != nil {
+		return x.ReaderUrl
+	}
+	return ""
+}
+
+func (x *InstanceSetting_AISetting) GetDisabledTools() []string {
+	if x != nil {
+		return x.DisabledTools
+	}
+	return nil
+}
+
+func (x *InstanceSetting_AISetting) GetAllowedModels() []string {
+	if x != nil {
+		return x.AllowedModels
+	}
+	return nil
+}
+
+func (x *InstanceSetting_AISetting) GetSystemPromptPreamble() string {
+	if x != nil {
+		return x.SystemPromptPreamble
+	}
+	return ""
+}
+
+func (x *InstanceSetting_AISetting) GetMaxAgentRounds() int32 {
+	if x != nil {
+		return x.MaxAgentRounds
+	}
+	return 0
+}
+
 // Custom profile configuration for instance branding.
 type InstanceSetting_GeneralSetting_CustomProfile struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -745,7 +1060,7 @@ type InstanceSetting_GeneralSetting_CustomProfile struct {
 
 func (x *InstanceSetting_GeneralSetting_CustomProfile) Reset() {
 	*x = InstanceSetting_GeneralSetting_CustomProfile{}
-	mi := &file_api_v1_instance_service_proto_msgTypes[8]
+	mi := &file_api_v1_instance_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -757,7 +1072,7 @@ func (x *InstanceSetting_GeneralSetting_CustomProfile) String() string {
 func (*InstanceSetting_GeneralSetting_CustomProfile) ProtoMessage() {}
 
 func (x *InstanceSetting_GeneralSetting_CustomProfile) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_instance_service_proto_msgTypes[8]
+	mi := &file_api_v1_instance_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -810,7 +1125,7 @@ type InstanceSetting_StorageSetting_S3Config struct {
 
 func (x *InstanceSetting_StorageSetting_S3Config) Reset() {
 	*x = InstanceSetting_StorageSetting_S3Config{}
-	mi := &file_api_v1_instance_service_proto_msgTypes[9]
+	mi := &file_api_v1_instance_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -822,7 +1137,7 @@ func (x *InstanceSetting_StorageSetting_S3Config) String() string {
 func (*InstanceSetting_StorageSetting_S3Config) ProtoMessage() {}
 
 func (x *InstanceSetting_StorageSetting_S3Config) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_instance_service_proto_msgTypes[9]
+	mi := &file_api_v1_instance_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -880,22 +1195,118 @@ func (x *InstanceSetting_StorageSetting_S3Config) GetUsePathStyle() bool {
 	return false
 }
 
+// Progress of re-indexing memos for a new embedding model. Searches keep
+// using the current index until every memo is in the new one.
+type EmbeddingIndex_Reindex struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The new embedding model.
+	Model string `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
+	// The dimension of the vectors of the new model, or 0 while none are
+	// stored.
+	Dimension int32 `protobuf:"varint,2,opt,name=dimension,proto3" json:"dimension,omitempty"`
+	// When re-indexing started.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// The number of memos in the new index so far.
+	IndexedMemos int32 `protobuf:"varint,4,opt,name=indexed_memos,json=indexedMemos,proto3" json:"indexed_memos,omitempty"`
+	// The number of memos that could not be embedded in this run. They are
+	// retried when the server restarts.
+	FailedMemos int32 `protobuf:"varint,5,opt,name=failed_memos,json=failedMemos,proto3" json:"failed_memos,omitempty"`
+	// The number of memos to index, or 0 until the run has started.
+	TotalMemos    int32 `protobuf:"varint,6,opt,name=total_memos,json=totalMemos,proto3" json:"total_memos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmbeddingIndex_Reindex) Reset() {
+	*x = EmbeddingIndex_Reindex{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmbeddingIndex_Reindex) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmbeddingIndex_Reindex) ProtoMessage() {}
+
+func (x *EmbeddingIndex_Reindex) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmbeddingIndex_Reindex.ProtoReflect.Descriptor instead.
+func (*EmbeddingIndex_Reindex) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *EmbeddingIndex_Reindex) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *EmbeddingIndex_Reindex) GetDimension() int32 {
+	if x != nil {
+		return x.Dimension
+	}
+	return 0
+}
+
+func (x *EmbeddingIndex_Reindex) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *EmbeddingIndex_Reindex) GetIndexedMemos() int32 {
+	if x != nil {
+		return x.IndexedMemos
+	}
+	return 0
+}
+
+func (x *EmbeddingIndex_Reindex) GetFailedMemos() int32 {
+	if x != nil {
+		return x.FailedMemos
+	}
+	return 0
+}
+
+func (x *EmbeddingIndex_Reindex) GetTotalMemos() int32 {
+	if x != nil {
+		return x.TotalMemos
+	}
+	return 0
+}
+
 var File_api_v1_instance_service_proto protoreflect.FileDescriptor
 
 const file_api_v1_instance_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1dapi/v1/instance_service.proto\x12\fmemos.api.v1\x1a\x19api/v1/user_service.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a google/protobuf/field_mask.proto\"\x8c\x01\n" +
+	"\x1dapi/v1/instance_service.proto\x12\fmemos.api.v1\x1a\x19api/v1/user_service.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x8c\x01\n" +
 	"\x0fInstanceProfile\x12\x18\n" +
 	"\aversion\x18\x02 \x01(\tR\aversion\x12\x12\n" +
 	"\x04demo\x18\x03 \x01(\bR\x04demo\x12!\n" +
 	"\finstance_url\x18\x06 \x01(\tR\vinstanceUrl\x12(\n" +
 	"\x05admin\x18\a \x01(\v2\x12.memos.api.v1.UserR\x05admin\"\x1b\n" +
-	"\x19GetInstanceProfileRequest\"\xd0\x0f\n" +
+	"\x19GetInstanceProfileRequest\"\x9d\x17\n" +
 	"\x0fInstanceSetting\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12W\n" +
 	"\x0fgeneral_setting\x18\x02 \x01(\v2,.memos.api.v1.InstanceSetting.GeneralSettingH\x00R\x0egeneralSetting\x12W\n" +
 	"\x0fstorage_setting\x18\x03 \x01(\v2,.memos.api.v1.InstanceSetting.StorageSettingH\x00R\x0estorageSetting\x12d\n" +
-	"\x14memo_related_setting\x18\x04 \x01(\v20.memos.api.v1.InstanceSetting.MemoRelatedSettingH\x00R\x12memoRelatedSetting\x1a\xca\x04\n" +
+	"\x14memo_related_setting\x18\x04 \x01(\v20.memos.api.v1.InstanceSetting.MemoRelatedSettingH\x00R\x12memoRelatedSetting\x12H\n" +
+	"\n" +
+	"ai_setting\x18\x05 \x01(\v2'.memos.api.v1.InstanceSetting.AISettingH\x00R\taiSetting\x1a\xca\x04\n" +
 	"\x0eGeneralSetting\x12<\n" +
 	"\x1adisallow_user_registration\x18\x02 \x01(\bR\x18disallowUserRegistration\x124\n" +
 	"\x16disallow_password_auth\x18\x03 \x01(\bR\x14disallowPasswordAuth\x12+\n" +
@@ -932,12 +1343,40 @@ const file_api_v1_instance_service_proto_rawDesc = "" +
 	"\x14content_length_limit\x18\x03 \x01(\x05R\x12contentLengthLimit\x127\n" +
 	"\x18enable_double_click_edit\x18\x04 \x01(\bR\x15enableDoubleClickEdit\x125\n" +
 	"\x17enable_custom_memo_date\x18\b \x01(\bR\x14enableCustomMemoDate\x12\x1c\n" +
-	"\treactions\x18\a \x03(\tR\treactions\"F\n" +
+	"\treactions\x18\a \x03(\tR\treactions\x1a\xf8\x06\n" +
+	"\tAISetting\x12.\n" +
+	"\x13monthly_token_quota\x18\x01 \x01(\x03R\x11monthlyTokenQuota\x12~\n" +
+	"\x19role_monthly_token_quotas\x18\x02 \x03(\v2C.memos.api.v1.InstanceSetting.AISetting.RoleMonthlyTokenQuotasEntryR\x16roleMonthlyTokenQuotas\x12~\n" +
+	"\x19user_monthly_token_quotas\x18\x03 \x03(\v2C.memos.api.v1.InstanceSetting.AISetting.UserMonthlyTokenQuotasEntryR\x16userMonthlyTokenQuotas\x128\n" +
+	"\vmcp_servers\x18\x04 \x03(\v2\x17.memos.api.v1.MCPServerR\n" +
+	"mcpServers\x12P\n" +
+	"\n" +
+	"web_access\x18\x05 \x01(\x0e21.memos.api.v1.InstanceSetting.AISetting.WebAccessR\twebAccess\x12\x1d\n" +
+	"\n" +
+	"reader_url\x18\x06 \x01(\tR\treaderUrl\x12%\n" +
+	"\x0edisabled_tools\x18\a \x03(\tR\rdisabledTools\x12%\n" +
+	"\x0eallowed_models\x18\b \x03(\tR\rallowedModels\x124\n" +
+	"\x16system_prompt_preamble\x18\t \x01(\tR\x14systemPromptPreamble\x12(\n" +
+	"\x10max_agent_rounds\x18\n" +
+	" \x01(\x05R\x0emaxAgentRounds\x1aI\n" +
+	"\x1bRoleMonthlyTokenQuotasEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\x1aI\n" +
+	"\x1bUserMonthlyTokenQuotasEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"L\n" +
+	"\tWebAccess\x12\x1a\n" +
+	"\x16WEB_ACCESS_UNSPECIFIED\x10\x00\x12\t\n" +
+	"\x05LOCAL\x10\x01\x12\n" +
+	"\n" +
+	"\x06READER\x10\x02\x12\f\n" +
+	"\bDISABLED\x10\x03\"N\n" +
 	"\x03Key\x12\x13\n" +
 	"\x0fKEY_UNSPECIFIED\x10\x00\x12\v\n" +
 	"\aGENERAL\x10\x01\x12\v\n" +
 	"\aSTORAGE\x10\x02\x12\x10\n" +
-	"\fMEMO_RELATED\x10\x03:a\xeaA^\n" +
+	"\fMEMO_RELATED\x10\x03\x12\x06\n" +
+	"\x02AI\x10\x04:a\xeaA^\n" +
 	"\x1cmemos.api.v1/InstanceSetting\x12\x1binstance/settings/{setting}*\x10instanceSettings2\x0finstanceSettingB\a\n" +
 	"\x05value\"U\n" +
 	"\x19GetInstanceSettingRequest\x128\n" +
@@ -946,11 +1385,26 @@ const file_api_v1_instance_service_proto_rawDesc = "" +
 	"\x1cUpdateInstanceSettingRequest\x12<\n" +
 	"\asetting\x18\x01 \x01(\v2\x1d.memos.api.v1.InstanceSettingB\x03\xe0A\x02R\asetting\x12@\n" +
 	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x01R\n" +
-	"updateMask2\xdb\x03\n" +
+	"updateMask\"\x1a\n" +
+	"\x18GetEmbeddingIndexRequest\"\xe8\x02\n" +
+	"\x0eEmbeddingIndex\x12\x14\n" +
+	"\x05model\x18\x01 \x01(\tR\x05model\x12\x1c\n" +
+	"\tdimension\x18\x02 \x01(\x05R\tdimension\x12>\n" +
+	"\areindex\x18\x03 \x01(\v2$.memos.api.v1.EmbeddingIndex.ReindexR\areindex\x1a\xe1\x01\n" +
+	"\aReindex\x12\x14\n" +
+	"\x05model\x18\x01 \x01(\tR\x05model\x12\x1c\n" +
+	"\tdimension\x18\x02 \x01(\x05R\tdimension\x129\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x12#\n" +
+	"\rindexed_memos\x18\x04 \x01(\x05R\findexedMemos\x12!\n" +
+	"\ffailed_memos\x18\x05 \x01(\x05R\vfailedMemos\x12\x1f\n" +
+	"\vtotal_memos\x18\x06 \x01(\x05R\n" +
+	"totalMemos2\xe0\x04\n" +
 	"\x0fInstanceService\x12~\n" +
 	"\x12GetInstanceProfile\x12'.memos.api.v1.GetInstanceProfileRequest\x1a\x1d.memos.api.v1.InstanceProfile\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/instance/profile\x12\x8f\x01\n" +
 	"\x12GetInstanceSetting\x12'.memos.api.v1.GetInstanceSettingRequest\x1a\x1d.memos.api.v1.InstanceSetting\"1\xdaA\x04name\x82\xd3\xe4\x93\x02$\x12\"/api/v1/{name=instance/settings/*}\x12\xb5\x01\n" +
-	"\x15UpdateInstanceSetting\x12*.memos.api.v1.UpdateInstanceSettingRequest\x1a\x1d.memos.api.v1.InstanceSetting\"Q\xdaA\x13setting,update_mask\x82\xd3\xe4\x93\x025:\asetting2*/api/v1/{setting.name=instance/settings/*}B\xac\x01\n" +
+	"\x15UpdateInstanceSetting\x12*.memos.api.v1.UpdateInstanceSettingRequest\x1a\x1d.memos.api.v1.InstanceSetting\"Q\xdaA\x13setting,update_mask\x82\xd3\xe4\x93\x025:\asetting2*/api/v1/{setting.name=instance/settings/*}\x12\x82\x01\n" +
+	"\x11GetEmbeddingIndex\x12&.memos.api.v1.GetEmbeddingIndexRequest\x1a\x1c.memos.api.v1.EmbeddingIndex\"'\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/instance/embeddingIndexB\xac\x01\n" +
 	"\x10com.memos.api.v1B\x14InstanceServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
 
 var (
@@ -965,45 +1419,63 @@ func file_api_v1_instance_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_instance_service_proto_rawDescData
 }
 
-var file_api_v1_instance_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_api_v1_instance_service_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_api_v1_instance_service_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_api_v1_instance_service_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_api_v1_instance_service_proto_goTypes = []any{
 	(InstanceSetting_Key)(0),                             // 0: memos.api.v1.InstanceSetting.Key
 	(InstanceSetting_StorageSetting_StorageType)(0),      // 1: memos.api.v1.InstanceSetting.StorageSetting.StorageType
-	(*InstanceProfile)(nil),                              // 2: memos.api.v1.InstanceProfile
-	(*GetInstanceProfileRequest)(nil),                    // 3: memos.api.v1.GetInstanceProfileRequest
-	(*InstanceSetting)(nil),                              // 4: memos.api.v1.InstanceSetting
-	(*GetInstanceSettingRequest)(nil),                    // 5: memos.api.v1.GetInstanceSettingRequest
-	(*UpdateInstanceSettingRequest)(nil),                 // 6: memos.api.v1.UpdateInstanceSettingRequest
-	(*InstanceSetting_GeneralSetting)(nil),               // 7: memos.api.v1.InstanceSetting.GeneralSetting
-	(*InstanceSetting_StorageSetting)(nil),               // 8: memos.api.v1.InstanceSetting.StorageSetting
-	(*InstanceSetting_MemoRelatedSetting)(nil),           // 9: memos.api.v1.InstanceSetting.MemoRelatedSetting
-	(*InstanceSetting_GeneralSetting_CustomProfile)(nil), // 10: memos.api.v1.InstanceSetting.GeneralSetting.CustomProfile
-	(*InstanceSetting_StorageSetting_S3Config)(nil),      // 11: memos.api.v1.InstanceSetting.StorageSetting.S3Config
-	(*User)(nil),                  // 12: memos.api.v1.User
-	(*fieldmaskpb.FieldMask)(nil), // 13: google.protobuf.FieldMask
+	(InstanceSetting_AISetting_WebAccess)(0),             // 2: memos.api.v1.InstanceSetting.AISetting.WebAccess
+	(*InstanceProfile)(nil),                              // 3: memos.api.v1.InstanceProfile
+	(*GetInstanceProfileRequest)(nil),                    // 4: memos.api.v1.GetInstanceProfileRequest
+	(*InstanceSetting)(nil),                              // 5: memos.api.v1.InstanceSetting
+	(*GetInstanceSettingRequest)(nil),                    // 6: memos.api.v1.GetInstanceSettingRequest
+	(*UpdateInstanceSettingRequest)(nil),                 // 7: memos.api.v1.UpdateInstanceSettingRequest
+	(*GetEmbeddingIndexRequest)(nil),                     // 8: memos.api.v1.GetEmbeddingIndexRequest
+	(*EmbeddingIndex)(nil),                               // 9: memos.api.v1.EmbeddingIndex
+	(*InstanceSetting_GeneralSetting)(nil),               // 10: memos.api.v1.InstanceSetting.GeneralSetting
+	(*InstanceSetting_StorageSetting)(nil),               // 11: memos.api.v1.InstanceSetting.StorageSetting
+	(*InstanceSetting_MemoRelatedSetting)(nil),           // 12: memos.api.v1.InstanceSetting.MemoRelatedSetting
+	(*InstanceSetting_AISetting)(nil),                    // 13: memos.api.v1.InstanceSetting.AISetting
+	(*InstanceSetting_GeneralSetting_CustomProfile)(nil), // 14: memos.api.v1.InstanceSetting.GeneralSetting.CustomProfile
+	(*InstanceSetting_StorageSetting_S3Config)(nil),      // 15: memos.api.v1.InstanceSetting.StorageSetting.S3Config
+	nil,                            // 16: memos.api.v1.InstanceSetting.AISetting.RoleMonthlyTokenQuotasEntry
+	nil,                            // 17: memos.api.v1.InstanceSetting.AISetting.UserMonthlyTokenQuotasEntry
+	(*EmbeddingIndex_Reindex)(nil), // 18: memos.api.v1.EmbeddingIndex.Reindex
+	(*User)(nil),                   // 19: memos.api.v1.User
+	(*fieldmaskpb.FieldMask)(nil),  // 20: google.protobuf.FieldMask
+	(*MCPServer)(nil),              // 21: memos.api.v1.MCPServer
+	(*timestamppb.Timestamp)(nil),  // 22: google.protobuf.Timestamp
 }
 var file_api_v1_instance_service_proto_depIdxs = []int32{
-	12, // 0: memos.api.v1.InstanceProfile.admin:type_name -> memos.api.v1.User
-	7,  // 1: memos.api.v1.InstanceSetting.general_setting:type_name -> memos.api.v1.InstanceSetting.GeneralSetting
-	8,  // 2: memos.api.v1.InstanceSetting.storage_setting:type_name -> memos.api.v1.InstanceSetting.StorageSetting
-	9,  // 3: memos.api.v1.InstanceSetting.memo_related_setting:type_name -> memos.api.v1.InstanceSetting.MemoRelatedSetting
-	4,  // 4: memos.api.v1.UpdateInstanceSettingRequest.setting:type_name -> memos.api.v1.InstanceSetting
-	13, // 5: memos.api.v1.UpdateInstanceSettingRequest.update_mask:type_name -> google.protobuf.FieldMask
-	10, // 6: memos.api.v1.InstanceSetting.GeneralSetting.custom_profile:type_name -> memos.api.v1.InstanceSetting.GeneralSetting.CustomProfile
-	1,  // 7: memos.api.v1.InstanceSetting.StorageSetting.storage_type:type_name -> memos.api.v1.InstanceSetting.StorageSetting.StorageType
-	11, // 8: memos.api.v1.InstanceSetting.StorageSetting.s3_config:type_name -> memos.api.v1.InstanceSetting.StorageSetting.S3Config
-	3,  // 9: memos.api.v1.InstanceService.GetInstanceProfile:input_type -> memos.api.v1.GetInstanceProfileRequest
-	5,  // 10: memos.api.v1.InstanceService.GetInstanceSetting:input_type -> memos.api.v1.GetInstanceSettingRequest
-	6,  // 11: memos.api.v1.InstanceService.UpdateInstanceSetting:input_type -> memos.api.v1.UpdateInstanceSettingRequest
-	2,  // 12: memos.api.v1.InstanceService.GetInstanceProfile:output_type -> memos.api.v1.InstanceProfile
-	4,  // 13: memos.api.v1.InstanceService.GetInstanceSetting:output_type -> memos.api.v1.InstanceSetting
-	4,  // 14: memos.api.v1.InstanceService.UpdateInstanceSetting:output_type -> memos.api.v1.InstanceSetting
-	12, // [12:15] is the sub-list for method output_type
-	9,  // [9:12] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	19, // 0: memos.api.v1.InstanceProfile.admin:type_name -> memos.api.v1.User
+	10, // 1: memos.api.v1.InstanceSetting.general_setting:type_name -> memos.api.v1.InstanceSetting.GeneralSetting
+	11, // 2: memos.api.v1.InstanceSetting.storage_setting:type_name -> memos.api.v1.InstanceSetting.StorageSetting
+	12, // 3: memos.api.v1.InstanceSetting.memo_related_setting:type_name -> memos.api.v1.InstanceSetting.MemoRelatedSetting
+	13, // 4: memos.api.v1.InstanceSetting.ai_setting:type_name -> memos.api.v1.InstanceSetting.AISetting
+	5,  // 5: memos.api.v1.UpdateInstanceSettingRequest.setting:type_name -> memos.api.v1.InstanceSetting
+	20, // 6: memos.api.v1.UpdateInstanceSettingRequest.update_mask:type_name -> google.protobuf.FieldMask
+	18, // 7: memos.api.v1.EmbeddingIndex.reindex:type_name -> memos.api.v1.EmbeddingIndex.Reindex
+	14, // 8: memos.api.v1.InstanceSetting.GeneralSetting.custom_profile:type_name -> memos.api.v1.InstanceSetting.GeneralSetting.CustomProfile
+	1,  // 9: memos.api.v1.InstanceSetting.StorageSetting.storage_type:type_name -> memos.api.v1.InstanceSetting.StorageSetting.StorageType
+	15, // 10: memos.api.v1.InstanceSetting.StorageSetting.s3_config:type_name -> memos.api.v1.InstanceSetting.StorageSetting.S3Config
+	16, // 11: memos.api.v1.InstanceSetting.AISetting.role_monthly_token_quotas:type_name -> memos.api.v1.InstanceSetting.AISetting.RoleMonthlyTokenQuotasEntry
+	17, // 12: memos.api.v1.InstanceSetting.AISetting.user_monthly_token_quotas:type_name -> memos.api.v1.InstanceSetting.AISetting.UserMonthlyTokenQuotasEntry
+	21, // 13: memos.api.v1.InstanceSetting.AISetting.mcp_servers:type_name -> memos.api.v1.MCPServer
+	2,  // 14: memos.api.v1.InstanceSetting.AISetting.web_access:type_name -> memos.api.v1.InstanceSetting.AISetting.WebAccess
+	22, // 15: memos.api.v1.EmbeddingIndex.Reindex.start_time:type_name -> google.protobuf.Timestamp
+	4,  // 16: memos.api.v1.InstanceService.GetInstanceProfile:input_type -> memos.api.v1.GetInstanceProfileRequest
+	6,  // 17: memos.api.v1.InstanceService.GetInstanceSetting:input_type -> memos.api.v1.GetInstanceSettingRequest
+	7,  // 18: memos.api.v1.InstanceService.UpdateInstanceSetting:input_type -> memos.api.v1.UpdateInstanceSettingRequest
+	8,  // 19: memos.api.v1.InstanceService.GetEmbeddingIndex:input_type -> memos.api.v1.GetEmbeddingIndexRequest
+	3,  // 20: memos.api.v1.InstanceService.GetInstanceProfile:output_type -> memos.api.v1.InstanceProfile
+	5,  // 21: memos.api.v1.InstanceService.GetInstanceSetting:output_type -> memos.api.v1.InstanceSetting
+	5,  // 22: memos.api.v1.InstanceService.UpdateInstanceSetting:output_type -> memos.api.v1.InstanceSetting
+	9,  // 23: memos.api.v1.InstanceService.GetEmbeddingIndex:output_type -> memos.api.v1.EmbeddingIndex
+	20, // [20:24] is the sub-list for method output_type
+	16, // [16:20] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_instance_service_proto_init() }
@@ -1016,14 +1488,15 @@ func file_api_v1_instance_service_proto_init() {
 		(*InstanceSetting_GeneralSetting_)(nil),
 		(*InstanceSetting_StorageSetting_)(nil),
 		(*InstanceSetting_MemoRelatedSetting_)(nil),
+		(*InstanceSetting_AiSetting)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_instance_service_proto_rawDesc), len(file_api_v1_instance_service_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   10,
+			NumEnums:      3,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -22,6 +22,7 @@ const (
 	InstanceService_GetInstanceProfile_FullMethodName    = "/memos.api.v1.InstanceService/GetInstanceProfile"
 	InstanceService_GetInstanceSetting_FullMethodName    = "/memos.api.v1.InstanceService/GetInstanceSetting"
 	InstanceService_UpdateInstanceSetting_FullMethodName = "/memos.api.v1.InstanceService/UpdateInstanceSetting"
+	InstanceService_GetEmbeddingIndex_FullMethodName     = "/memos.api.v1.InstanceService/GetEmbeddingIndex"
 )
 
 // InstanceServiceClient is the client API for InstanceService service.
@@ -34,6 +35,9 @@ type InstanceServiceClient interface {
 	GetInstanceSetting(ctx context.Context, in *GetInstanceSettingRequest, opts ...grpc.CallOption) (*InstanceSetting, error)
 	// Updates an instance setting.
 	UpdateInstanceSetting(ctx context.Context, in *UpdateInstanceSettingRequest, opts ...grpc.CallOption) (*InstanceSetting, error)
+	// Gets the state of the embedding index used for semantic search, including
+	// the progress of re-indexing memos after the embedding model changed.
+	GetEmbeddingIndex(ctx context.Context, in *GetEmbeddingIndexRequest, opts ...grpc.CallOption) (*EmbeddingIndex, error)
 }
 
 type instanceServiceClient struct {
@@ -74,6 +78,16 @@ func (c *instanceServiceClient) UpdateInstanceSetting(ctx context.Context, in *U
 	return out, nil
 }
 
+func (c *instanceServiceClient) GetEmbeddingIndex(ctx context.Context, in *GetEmbeddingIndexRequest, opts ...grpc.CallOption) (*EmbeddingIndex, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmbeddingIndex)
+	err := c.cc.Invoke(ctx, InstanceService_GetEmbeddingIndex_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InstanceServiceServer is the server API for InstanceService service.
 // All implementations must embed UnimplementedInstanceServiceServer
 // for forward compatibility.
@@ -84,6 +98,9 @@ type InstanceServiceServer interface {
 	GetInstanceSetting(context.Context, *GetInstanceSettingRequest) (*InstanceSetting, error)
 	// Updates an instance setting.
 	UpdateInstanceSetting(context.Context, *UpdateInstanceSettingRequest) (*InstanceSetting, error)
+	// Gets the state of the embedding index used for semantic search, including
+	// the progress of re-indexing memos after the embedding model changed.
+	GetEmbeddingIndex(context.Context, *GetEmbeddingIndexRequest) (*EmbeddingIndex, error)
 	mustEmbedUnimplementedInstanceServiceServer()
 }
 
@@ -103,6 +120,9 @@ func (UnimplementedInstanceServiceServer) GetInstanceSetting(context.Context, *G
 func (UnimplementedInstanceServiceServer) UpdateInstanceSetting(context.Context, *UpdateInstanceSettingRequest) (*InstanceSetting, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateInstanceSetting not implemented")
 }
+func (UnimplementedInstanceServiceServer) GetEmbeddingIndex(context.Context, *GetEmbeddingIndexRequest) (*EmbeddingIndex, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetEmbeddingIndex not implemented")
+}
 func (UnimplementedInstanceServiceServer) mustEmbedUnimplementedInstanceServiceServer() {}
 func (UnimplementedInstanceServiceServer) testEmbeddedByValue()                         {}
 
@@ -178,6 +198,24 @@ func _InstanceService_UpdateInstanceSetting_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InstanceService_GetEmbeddingIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEmbeddingIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).GetEmbeddingIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstanceService_GetEmbeddingIndex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).GetEmbeddingIndex(ctx, req.(*GetEmbeddingIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // InstanceService_ServiceDesc is the grpc.ServiceDesc for InstanceService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -197,6 +235,10 @@ var InstanceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateInstanceSetting",
 			Handler:    _InstanceService_UpdateInstanceSetting_Handler,
 		},
+		{
+			MethodName: "GetEmbeddingIndex",
+			Handler:    _InstanceService_GetEmbeddingIndex_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/instance_service.proto",
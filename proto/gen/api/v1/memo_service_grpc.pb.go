@@ -20,20 +20,23 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MemoService_CreateMemo_FullMethodName          = "/memos.api.v1.MemoService/CreateMemo"
-	MemoService_ListMemos_FullMethodName           = "/memos.api.v1.MemoService/ListMemos"
-	MemoService_GetMemo_FullMethodName             = "/memos.api.v1.MemoService/GetMemo"
-	MemoService_UpdateMemo_FullMethodName          = "/memos.api.v1.MemoService/UpdateMemo"
-	MemoService_DeleteMemo_FullMethodName          = "/memos.api.v1.MemoService/DeleteMemo"
-	MemoService_SetMemoAttachments_FullMethodName  = "/memos.api.v1.MemoService/SetMemoAttachments"
-	MemoService_ListMemoAttachments_FullMethodName = "/memos.api.v1.MemoService/ListMemoAttachments"
-	MemoService_SetMemoRelations_FullMethodName    = "/memos.api.v1.MemoService/SetMemoRelations"
-	MemoService_ListMemoRelations_FullMethodName   = "/memos.api.v1.MemoService/ListMemoRelations"
-	MemoService_CreateMemoComment_FullMethodName   = "/memos.api.v1.MemoService/CreateMemoComment"
-	MemoService_ListMemoComments_FullMethodName    = "/memos.api.v1.MemoService/ListMemoComments"
-	MemoService_ListMemoReactions_FullMethodName   = "/memos.api.v1.MemoService/ListMemoReactions"
-	MemoService_UpsertMemoReaction_FullMethodName  = "/memos.api.v1.MemoService/UpsertMemoReaction"
-	MemoService_DeleteMemoReaction_FullMethodName  = "/memos.api.v1.MemoService/DeleteMemoReaction"
+	MemoService_CreateMemo_FullMethodName               = "/memos.api.v1.MemoService/CreateMemo"
+	MemoService_ListMemos_FullMethodName                = "/memos.api.v1.MemoService/ListMemos"
+	MemoService_SearchMemos_FullMethodName              = "/memos.api.v1.MemoService/SearchMemos"
+	MemoService_GetMemo_FullMethodName                  = "/memos.api.v1.MemoService/GetMemo"
+	MemoService_UpdateMemo_FullMethodName               = "/memos.api.v1.MemoService/UpdateMemo"
+	MemoService_AcceptMemoTagSuggestions_FullMethodName = "/memos.api.v1.MemoService/AcceptMemoTagSuggestions"
+	MemoService_DeleteMemo_FullMethodName               = "/memos.api.v1.MemoService/DeleteMemo"
+	MemoService_SetMemoAttachments_FullMethodName       = "/memos.api.v1.MemoService/SetMemoAttachments"
+	MemoService_ListMemoAttachments_FullMethodName      = "/memos.api.v1.MemoService/ListMemoAttachments"
+	MemoService_SetMemoRelations_FullMethodName         = "/memos.api.v1.MemoService/SetMemoRelations"
+	MemoService_ListMemoRelations_FullMethodName        = "/memos.api.v1.MemoService/ListMemoRelations"
+	MemoService_ListRelatedMemos_FullMethodName         = "/memos.api.v1.MemoService/ListRelatedMemos"
+	MemoService_CreateMemoComment_FullMethodName        = "/memos.api.v1.MemoService/CreateMemoComment"
+	MemoService_ListMemoComments_FullMethodName         = "/memos.api.v1.MemoService/ListMemoComments"
+	MemoService_ListMemoReactions_FullMethodName        = "/memos.api.v1.MemoService/ListMemoReactions"
+	MemoService_UpsertMemoReaction_FullMethodName       = "/memos.api.v1.MemoService/UpsertMemoReaction"
+	MemoService_DeleteMemoReaction_FullMethodName       = "/memos.api.v1.MemoService/DeleteMemoReaction"
 )
 
 // MemoServiceClient is the client API for MemoService service.
@@ -44,10 +47,14 @@ type MemoServiceClient interface {
 	CreateMemo(ctx context.Context, in *CreateMemoRequest, opts ...grpc.CallOption) (*Memo, error)
 	// ListMemos lists memos with pagination and filter.
 	ListMemos(ctx context.Context, in *ListMemosRequest, opts ...grpc.CallOption) (*ListMemosResponse, error)
+	// SearchMemos searches memos by keyword and meaning, ranked by relevance.
+	SearchMemos(ctx context.Context, in *SearchMemosRequest, opts ...grpc.CallOption) (*SearchMemosResponse, error)
 	// GetMemo gets a memo.
 	GetMemo(ctx context.Context, in *GetMemoRequest, opts ...grpc.CallOption) (*Memo, error)
 	// UpdateMemo updates a memo.
 	UpdateMemo(ctx context.Context, in *UpdateMemoRequest, opts ...grpc.CallOption) (*Memo, error)
+	// AcceptMemoTagSuggestions adds suggested tags to a memo and dismisses the rest.
+	AcceptMemoTagSuggestions(ctx context.Context, in *AcceptMemoTagSuggestionsRequest, opts ...grpc.CallOption) (*Memo, error)
 	// DeleteMemo deletes a memo.
 	DeleteMemo(ctx context.Context, in *DeleteMemoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// SetMemoAttachments sets attachments for a memo.
@@ -58,6 +65,9 @@ type MemoServiceClient interface {
 	SetMemoRelations(ctx context.Context, in *SetMemoRelationsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// ListMemoRelations lists relations for a memo.
 	ListMemoRelations(ctx context.Context, in *ListMemoRelationsRequest, opts ...grpc.CallOption) (*ListMemoRelationsResponse, error)
+	// ListRelatedMemos lists the memos most semantically similar to a memo that
+	// are not yet linked to it.
+	ListRelatedMemos(ctx context.Context, in *ListRelatedMemosRequest, opts ...grpc.CallOption) (*ListRelatedMemosResponse, error)
 	// CreateMemoComment creates a comment for a memo.
 	CreateMemoComment(ctx context.Context, in *CreateMemoCommentRequest, opts ...grpc.CallOption) (*Memo, error)
 	// ListMemoComments lists comments for a memo.
@@ -98,6 +108,16 @@ func (c *memoServiceClient) ListMemos(ctx context.Context, in *ListMemosRequest,
 	return out, nil
 }
 
+func (c *memoServiceClient) SearchMemos(ctx context.Context, in *SearchMemosRequest, opts ...grpc.CallOption) (*SearchMemosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchMemosResponse)
+	err := c.cc.Invoke(ctx, MemoService_SearchMemos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *memoServiceClient) GetMemo(ctx context.Context, in *GetMemoRequest, opts ...grpc.CallOption) (*Memo, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Memo)
@@ -118,6 +138,16 @@ func (c *memoServiceClient) UpdateMemo(ctx context.Context, in *UpdateMemoReques
 	return out, nil
 }
 
+func (c *memoServiceClient) AcceptMemoTagSuggestions(ctx context.Context, in *AcceptMemoTagSuggestionsRequest, opts ...grpc.CallOption) (*Memo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Memo)
+	err := c.cc.Invoke(ctx, MemoService_AcceptMemoTagSuggestions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *memoServiceClient) DeleteMemo(ctx context.Context, in *DeleteMemoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -168,6 +198,16 @@ func (c *memoServiceClient) ListMemoRelations(ctx context.Context, in *ListMemoR
 	return out, nil
 }
 
+func (c *memoServiceClient) ListRelatedMemos(ctx context.Context, in *ListRelatedMemosRequest, opts ...grpc.CallOption) (*ListRelatedMemosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRelatedMemosResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListRelatedMemos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *memoServiceClient) CreateMemoComment(ctx context.Context, in *CreateMemoCommentRequest, opts ...grpc.CallOption) (*Memo, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Memo)
@@ -226,10 +266,14 @@ type MemoServiceServer interface {
 	CreateMemo(context.Context, *CreateMemoRequest) (*Memo, error)
 	// ListMemos lists memos with pagination and filter.
 	ListMemos(context.Context, *ListMemosRequest) (*ListMemosResponse, error)
+	// SearchMemos searches memos by keyword and meaning, ranked by relevance.
+	SearchMemos(context.Context, *SearchMemosRequest) (*SearchMemosResponse, error)
 	// GetMemo gets a memo.
 	GetMemo(context.Context, *GetMemoRequest) (*Memo, error)
 	// UpdateMemo updates a memo.
 	UpdateMemo(context.Context, *UpdateMemoRequest) (*Memo, error)
+	// AcceptMemoTagSuggestions adds suggested tags to a memo and dismisses the rest.
+	AcceptMemoTagSuggestions(context.Context, *AcceptMemoTagSuggestionsRequest) (*Memo, error)
 	// DeleteMemo deletes a memo.
 	DeleteMemo(context.Context, *DeleteMemoRequest) (*emptypb.Empty, error)
 	// SetMemoAttachments sets attachments for a memo.
@@ -240,6 +284,9 @@ type MemoServiceServer interface {
 	SetMemoRelations(context.Context, *SetMemoRelationsRequest) (*emptypb.Empty, error)
 	// ListMemoRelations lists relations for a memo.
 	ListMemoRelations(context.Context, *ListMemoRelationsRequest) (*ListMemoRelationsResponse, error)
+	// ListRelatedMemos lists the memos most semantically similar to a memo that
+	// are not yet linked to it.
+	ListRelatedMemos(context.Context, *ListRelatedMemosRequest) (*ListRelatedMemosResponse, error)
 	// CreateMemoComment creates a comment for a memo.
 	CreateMemoComment(context.Context, *CreateMemoCommentRequest) (*Memo, error)
 	// ListMemoComments lists comments for a memo.
@@ -266,12 +313,18 @@ func (UnimplementedMemoServiceServer) CreateMemo(context.Context, *CreateMemoReq
 func (UnimplementedMemoServiceServer) ListMemos(context.Context, *ListMemosRequest) (*ListMemosResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListMemos not implemented")
 }
+func (UnimplementedMemoServiceServer) SearchMemos(context.Context, *SearchMemosRequest) (*SearchMemosResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchMemos not implemented")
+}
 func (UnimplementedMemoServiceServer) GetMemo(context.Context, *GetMemoRequest) (*Memo, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetMemo not implemented")
 }
 func (UnimplementedMemoServiceServer) UpdateMemo(context.Context, *UpdateMemoRequest) (*Memo, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateMemo not implemented")
 }
+func (UnimplementedMemoServiceServer) AcceptMemoTagSuggestions(context.Context, *AcceptMemoTagSuggestionsRequest) (*Memo, error) {
+	return nil, status.Error(codes.Unimplemented, "method AcceptMemoTagSuggestions not implemented")
+}
 func (UnimplementedMemoServiceServer) DeleteMemo(context.Context, *DeleteMemoRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteMemo not implemented")
 }
@@ -287,6 +340,9 @@ func (UnimplementedMemoServiceServer) SetMemoRelations(context.Context, *SetMemo
 func (UnimplementedMemoServiceServer) ListMemoRelations(context.Context, *ListMemoRelationsRequest) (*ListMemoRelationsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListMemoRelations not implemented")
 }
+func (UnimplementedMemoServiceServer) ListRelatedMemos(context.Context, *ListRelatedMemosRequest) (*ListRelatedMemosResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRelatedMemos not implemented")
+}
 func (UnimplementedMemoServiceServer) CreateMemoComment(context.Context, *CreateMemoCommentRequest) (*Memo, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateMemoComment not implemented")
 }
@@ -359,6 +415,24 @@ func _MemoService_ListMemos_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MemoService_SearchMemos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchMemosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).SearchMemos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_SearchMemos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).SearchMemos(ctx, req.(*SearchMemosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MemoService_GetMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetMemoRequest)
 	if err := dec(in); err != nil {
@@ -395,6 +469,24 @@ func _MemoService_UpdateMemo_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MemoService_AcceptMemoTagSuggestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptMemoTagSuggestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).AcceptMemoTagSuggestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_AcceptMemoTagSuggestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).AcceptMemoTagSuggestions(ctx, req.(*AcceptMemoTagSuggestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MemoService_DeleteMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteMemoRequest)
 	if err := dec(in); err != nil {
@@ -485,6 +577,24 @@ func _MemoService_ListMemoRelations_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MemoService_ListRelatedMemos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRelatedMemosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListRelatedMemos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListRelatedMemos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListRelatedMemos(ctx, req.(*ListRelatedMemosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MemoService_CreateMemoComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateMemoCommentRequest)
 	if err := dec(in); err != nil {
@@ -590,6 +700,10 @@ var MemoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListMemos",
 			Handler:    _MemoService_ListMemos_Handler,
 		},
+		{
+			MethodName: "SearchMemos",
+			Handler:    _MemoService_SearchMemos_Handler,
+		},
 		{
 			MethodName: "GetMemo",
 			Handler:    _MemoService_GetMemo_Handler,
@@ -598,6 +712,10 @@ var MemoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateMemo",
 			Handler:    _MemoService_UpdateMemo_Handler,
 		},
+		{
+			MethodName: "AcceptMemoTagSuggestions",
+			Handler:    _MemoService_AcceptMemoTagSuggestions_Handler,
+		},
 		{
 			MethodName: "DeleteMemo",
 			Handler:    _MemoService_DeleteMemo_Handler,
@@ -618,6 +736,10 @@ var MemoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListMemoRelations",
 			Handler:    _MemoService_ListMemoRelations_Handler,
 		},
+		{
+			MethodName: "ListRelatedMemos",
+			Handler:    _MemoService_ListRelatedMemos_Handler,
+		},
 		{
 			MethodName: "CreateMemoComment",
 			Handler:    _MemoService_CreateMemoComment_Handler,
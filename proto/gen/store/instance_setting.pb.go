@@ -33,6 +33,8 @@ const (
 	InstanceSettingKey_STORAGE InstanceSettingKey = 3
 	// MEMO_RELATED is the key for memo related settings.
 	InstanceSettingKey_MEMO_RELATED InstanceSettingKey = 4
+	// AI is the key for AI settings.
+	InstanceSettingKey_AI InstanceSettingKey = 5
 )
 
 // Enum value maps for InstanceSettingKey.
@@ -43,6 +45,7 @@ var (
 		2: "GENERAL",
 		3: "STORAGE",
 		4: "MEMO_RELATED",
+		5: "AI",
 	}
 	InstanceSettingKey_value = map[string]int32{
 		"INSTANCE_SETTING_KEY_UNSPECIFIED": 0,
@@ -50,6 +53,7 @@ var (
 		"GENERAL":                          2,
 		"STORAGE":                          3,
 		"MEMO_RELATED":                     4,
+		"AI":                               5,
 	}
 )
 
@@ -135,6 +139,63 @@ func (InstanceStorageSetting_StorageType) EnumDescriptor() ([]byte, []int) {
 	return file_store_instance_setting_proto_rawDescGZIP(), []int{4, 0}
 }
 
+// WebAccess is how the assistant reads web pages.
+type InstanceAISetting_WebAccess int32
+
+const (
+	// WEB_ACCESS_UNSPECIFIED behaves like LOCAL.
+	InstanceAISetting_WEB_ACCESS_UNSPECIFIED InstanceAISetting_WebAccess = 0
+	// LOCAL fetches pages from the server and extracts their content there.
+	InstanceAISetting_LOCAL InstanceAISetting_WebAccess = 1
+	// READER reads pages through the reader service at reader_url.
+	InstanceAISetting_READER InstanceAISetting_WebAccess = 2
+	// DISABLED keeps the assistant off the web, including web search.
+	InstanceAISetting_DISABLED InstanceAISetting_WebAccess = 3
+)
+
+// Enum value maps for InstanceAISetting_WebAccess.
+var (
+	InstanceAISetting_WebAccess_name = map[int32]string{
+		0: "WEB_ACCESS_UNSPECIFIED",
+		1: "LOCAL",
+		2: "READER",
+		3: "DISABLED",
+	}
+	InstanceAISetting_WebAccess_value = map[string]int32{
+		"WEB_ACCESS_UNSPECIFIED": 0,
+		"LOCAL":                  1,
+		"READER":                 2,
+		"DISABLED":               3,
+	}
+)
+
+func (x InstanceAISetting_WebAccess) Enum() *InstanceAISetting_WebAccess {
+	p := new(InstanceAISetting_WebAccess)
+	*p = x
+	return p
+}
+
+func (x InstanceAISetting_WebAccess) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InstanceAISetting_WebAccess) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_instance_setting_proto_enumTypes[2].Descriptor()
+}
+
+func (InstanceAISetting_WebAccess) Type() protoreflect.EnumType {
+	return &file_store_instance_setting_proto_enumTypes[2]
+}
+
+func (x InstanceAISetting_WebAccess) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InstanceAISetting_WebAccess.Descriptor instead.
+func (InstanceAISetting_WebAccess) EnumDescriptor() ([]byte, []int) {
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{7, 0}
+}
+
 type InstanceSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	Key   InstanceSettingKey     `protobuf:"varint,1,opt,name=key,proto3,enum=memos.store.InstanceSettingKey" json:"key,omitempty"`
@@ -144,6 +205,7 @@ type InstanceSetting struct {
 	//	*InstanceSetting_GeneralSetting
 	//	*InstanceSetting_StorageSetting
 	//	*InstanceSetting_MemoRelatedSetting
+	//	*InstanceSetting_AiSetting
 	Value         isInstanceSetting_Value `protobuf_oneof:"value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -229,6 +291,15 @@ func (x *InstanceSetting) GetMemoRelatedSetting() *InstanceMemoRelatedSetting {
 	return nil
 }
 
+func (x *InstanceSetting) GetAiSetting() *InstanceAISetting {
+	if x != nil {
+		if x, ok := x.Value.(*InstanceSetting_AiSetting); ok {
+			return x.AiSetting
+		}
+	}
+	return nil
+}
+
 type isInstanceSetting_Value interface {
 	isInstanceSetting_Value()
 }
@@ -249,6 +320,10 @@ type InstanceSetting_MemoRelatedSetting struct {
 	MemoRelatedSetting *InstanceMemoRelatedSetting `protobuf:"bytes,5,opt,name=memo_related_setting,json=memoRelatedSetting,proto3,oneof"`
 }
 
+type InstanceSetting_AiSetting struct {
+	AiSetting *InstanceAISetting `protobuf:"bytes,6,opt,name=ai_setting,json=aiSetting,proto3,oneof"`
+}
+
 func (*InstanceSetting_BasicSetting) isInstanceSetting_Value() {}
 
 func (*InstanceSetting_GeneralSetting) isInstanceSetting_Value() {}
@@ -257,6 +332,8 @@ func (*InstanceSetting_StorageSetting) isInstanceSetting_Value() {}
 
 func (*InstanceSetting_MemoRelatedSetting) isInstanceSetting_Value() {}
 
+func (*InstanceSetting_AiSetting) isInstanceSetting_Value() {}
+
 type InstanceBasicSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The secret key for instance. Mainly used for session management.
@@ -729,17 +806,245 @@ func (x *InstanceMemoRelatedSetting) GetReactions() []string {
 	return nil
 }
 
+type InstanceAISetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// monthly_token_quota is the number of tokens each user may spend on AI
+	// features per calendar month (UTC). 0 means unlimited.
+	MonthlyTokenQuota int64 `protobuf:"varint,1,opt,name=monthly_token_quota,json=monthlyTokenQuota,proto3" json:"monthly_token_quota,omitempty"`
+	// role_monthly_token_quotas overrides monthly_token_quota for the users of a
+	// role, keyed by role, e.g. "USER".
+	RoleMonthlyTokenQuotas map[string]int64 `protobuf:"bytes,2,rep,name=role_monthly_token_quotas,json=roleMonthlyTokenQuotas,proto3" json:"role_monthly_token_quotas,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// user_monthly_token_quotas overrides the other quotas for single users,
+	// keyed by user ID.
+	UserMonthlyTokenQuotas map[int32]int64 `protobuf:"bytes,3,rep,name=user_monthly_token_quotas,json=userMonthlyTokenQuotas,proto3" json:"user_monthly_token_quotas,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// mcp_servers are external MCP servers whose tools the assistant of every
+	// user can use.
+	McpServers []*MCPServer `protobuf:"bytes,4,rep,name=mcp_servers,json=mcpServers,proto3" json:"mcp_servers,omitempty"`
+	// web_access is how the assistant reads web pages.
+	WebAccess InstanceAISetting_WebAccess `protobuf:"varint,5,opt,name=web_access,json=webAccess,proto3,enum=memos.store.InstanceAISetting_WebAccess" json:"web_access,omitempty"`
+	// reader_url is the endpoint of the reader service used when web_access is
+	// READER. The page URL is appended to it, e.g. https://r.jina.ai/
+	ReaderUrl string `protobuf:"bytes,6,opt,name=reader_url,json=readerUrl,proto3" json:"reader_url,omitempty"`
+	// disabled_tools are built-in assistant tools, e.g. "delete_memo", that
+	// no user's assistant may use.
+	DisabledTools []string `protobuf:"bytes,7,rep,name=disabled_tools,json=disabledTools,proto3" json:"disabled_tools,omitempty"`
+	// allowed_models are the chat models users may pick. When empty, only the
+	// server's default model is used.
+	AllowedModels []string `protobuf:"bytes,8,rep,name=allowed_models,json=allowedModels,proto3" json:"allowed_models,omitempty"`
+	// system_prompt_preamble replaces the opening of the assistant's system
+	// prompt, which says who the assistant is.
+	SystemPromptPreamble string `protobuf:"bytes,9,opt,name=system_prompt_preamble,json=systemPromptPreamble,proto3" json:"system_prompt_preamble,omitempty"`
+	// max_agent_rounds caps the tool-use rounds of a chat turn. 0 means the
+	// default of 12.
+	MaxAgentRounds int32 `protobuf:"varint,10,opt,name=max_agent_rounds,json=maxAgentRounds,proto3" json:"max_agent_rounds,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InstanceAISetting) Reset() {
+	*x = InstanceAISetting{}
+	mi := &file_store_instance_setting_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceAISetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceAISetting) ProtoMessage() {}
+
+func (x *InstanceAISetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_instance_setting_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceAISetting.ProtoReflect.Descriptor instead.
+func (*InstanceAISetting) Descriptor() ([]byte, []int) {
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *InstanceAISetting) GetMonthlyTokenQuota() int64 {
+	if x != nil {
+		return x.MonthlyTokenQuota
+	}
+	return 0
+}
+
+func (x *InstanceAISetting) GetRoleMonthlyTokenQuotas() map[string]int64 {
+	if x != nil {
+		return x.RoleMonthlyTokenQuotas
+	}
+	return nil
+}
+
+func (x *InstanceAISetting) GetUserMonthlyTokenQuotas() map[int32]int64 {
+	if x != nil {
+		return x.UserMonthlyTokenQuotas
+	}
+	return nil
+}
+
+func (x *InstanceAISetting) GetMcpServers() []*MCPServer {
+	if x != nil {
+		return x.McpServers
+	}
+	return nil
+}
+
+func (x *InstanceAISetting) GetWebAccess() InstanceAISetting_WebAccess {
+	if x != nil {
+		return x.WebAccess
+	}
+	return InstanceAISetting_WEB_ACCESS_UNSPECIFIED
+}
+
+func (x *InstanceAISetting) GetReaderUrl() string {
+	if x != nil {
+		return x.ReaderUrl
+	}
+	return ""
+}
+
+func (x *InstanceAISetting) GetDisabledTools() []string {
+	if x != nil {
+		return x.DisabledTools
+	}
+	return nil
+}
+
+func (x *InstanceAISetting) GetAllowedModels() []string {
+	if x != nil {
+		return x.AllowedModels
+	}
+	return nil
+}
+
+func (x *InstanceAISetting) GetSystemPromptPreamble() string {
+	if x != nil {
+		return x.SystemPromptPreamble
+	}
+	return ""
+}
+
+func (x *InstanceAISetting) GetMaxAgentRounds() int32 {
+	if x != nil {
+		return x.MaxAgentRounds
+	}
+	return 0
+}
+
+// MCPServer is an external Model Context Protocol server whose tools are
+// offered to the AI assistant.
+type MCPServer struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name identifies the server and prefixes the names of its tools.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// url is the endpoint of a streamable HTTP server.
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// headers are sent with every request to url, e.g. for authorization.
+	Headers map[string]string `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// command starts a stdio server instead of connecting to url.
+	Command string   `protobuf:"bytes,4,opt,name=command,proto3" json:"command,omitempty"`
+	Args    []string `protobuf:"bytes,5,rep,name=args,proto3" json:"args,omitempty"`
+	// env holds extra environment variables for command, as KEY=VALUE.
+	Env           []string `protobuf:"bytes,6,rep,name=env,proto3" json:"env,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MCPServer) Reset() {
+	*x = MCPServer{}
+	mi := &file_store_instance_setting_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MCPServer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MCPServer) ProtoMessage() {}
+
+func (x *MCPServer) ProtoReflect() protoreflect.Message {
+	mi := &file_store_instance_setting_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MCPServer.ProtoReflect.Descriptor instead.
+func (*MCPServer) Descriptor() ([]byte, []int) {
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MCPServer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MCPServer) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *MCPServer) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *MCPServer) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *MCPServer) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *MCPServer) GetEnv() []string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
 var File_store_instance_setting_proto protoreflect.FileDescriptor
 
 const file_store_instance_setting_proto_rawDesc = "" +
 	"\n" +
-	"\x1cstore/instance_setting.proto\x12\vmemos.store\"\x94\x03\n" +
+	"\x1cstore/instance_setting.proto\x12\vmemos.store\"\xd5\x03\n" +
 	"\x0fInstanceSetting\x121\n" +
 	"\x03key\x18\x01 \x01(\x0e2\x1f.memos.store.InstanceSettingKeyR\x03key\x12H\n" +
 	"\rbasic_setting\x18\x02 \x01(\v2!.memos.store.InstanceBasicSettingH\x00R\fbasicSetting\x12N\n" +
 	"\x0fgeneral_setting\x18\x03 \x01(\v2#.memos.store.InstanceGeneralSettingH\x00R\x0egeneralSetting\x12N\n" +
 	"\x0fstorage_setting\x18\x04 \x01(\v2#.memos.store.InstanceStorageSettingH\x00R\x0estorageSetting\x12[\n" +
-	"\x14memo_related_setting\x18\x05 \x01(\v2'.memos.store.InstanceMemoRelatedSettingH\x00R\x12memoRelatedSettingB\a\n" +
+	"\x14memo_related_setting\x18\x05 \x01(\v2'.memos.store.InstanceMemoRelatedSettingH\x00R\x12memoRelatedSetting\x12?\n" +
+	"\n" +
+	"ai_setting\x18\x06 \x01(\v2\x1e.memos.store.InstanceAISettingH\x00R\taiSettingB\a\n" +
 	"\x05value\"\\\n" +
 	"\x14InstanceBasicSetting\x12\x1d\n" +
 	"\n" +
@@ -781,13 +1086,51 @@ const file_store_instance_setting_proto_rawDesc = "" +
 	"\x14content_length_limit\x18\x03 \x01(\x05R\x12contentLengthLimit\x127\n" +
 	"\x18enable_double_click_edit\x18\x04 \x01(\bR\x15enableDoubleClickEdit\x125\n" +
 	"\x17enable_custom_memo_date\x18\b \x01(\bR\x14enableCustomMemoDate\x12\x1c\n" +
-	"\treactions\x18\a \x03(\tR\treactions*q\n" +
+	"\treactions\x18\a \x03(\tR\treactions\"\xe4\x06\n" +
+	"\x11InstanceAISetting\x12.\n" +
+	"\x13monthly_token_quota\x18\x01 \x01(\x03R\x11monthlyTokenQuota\x12u\n" +
+	"\x19role_monthly_token_quotas\x18\x02 \x03(\v2:.memos.store.InstanceAISetting.RoleMonthlyTokenQuotasEntryR\x16roleMonthlyTokenQuotas\x12u\n" +
+	"\x19user_monthly_token_quotas\x18\x03 \x03(\v2:.memos.store.InstanceAISetting.UserMonthlyTokenQuotasEntryR\x16userMonthlyTokenQuotas\x127\n" +
+	"\vmcp_servers\x18\x04 \x03(\v2\x16.memos.store.MCPServerR\n" +
+	"mcpServers\x12G\n" +
+	"\n" +
+	"web_access\x18\x05 \x01(\x0e2(.memos.store.InstanceAISetting.WebAccessR\twebAccess\x12\x1d\n" +
+	"\n" +
+	"reader_url\x18\x06 \x01(\tR\treaderUrl\x12%\n" +
+	"\x0edisabled_tools\x18\a \x03(\tR\rdisabledTools\x12%\n" +
+	"\x0eallowed_models\x18\b \x03(\tR\rallowedModels\x124\n" +
+	"\x16system_prompt_preamble\x18\t \x01(\tR\x14systemPromptPreamble\x12(\n" +
+	"\x10max_agent_rounds\x18\n" +
+	" \x01(\x05R\x0emaxAgentRounds\x1aI\n" +
+	"\x1bRoleMonthlyTokenQuotasEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\x1aI\n" +
+	"\x1bUserMonthlyTokenQuotasEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"L\n" +
+	"\tWebAccess\x12\x1a\n" +
+	"\x16WEB_ACCESS_UNSPECIFIED\x10\x00\x12\t\n" +
+	"\x05LOCAL\x10\x01\x12\n" +
+	"\n" +
+	"\x06READER\x10\x02\x12\f\n" +
+	"\bDISABLED\x10\x03\"\xec\x01\n" +
+	"\tMCPServer\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12=\n" +
+	"\aheaders\x18\x03 \x03(\v2#.memos.store.MCPServer.HeadersEntryR\aheaders\x12\x18\n" +
+	"\acommand\x18\x04 \x01(\tR\acommand\x12\x12\n" +
+	"\x04args\x18\x05 \x03(\tR\x04args\x12\x10\n" +
+	"\x03env\x18\x06 \x03(\tR\x03env\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01*y\n" +
 	"\x12InstanceSettingKey\x12$\n" +
 	" INSTANCE_SETTING_KEY_UNSPECIFIED\x10\x00\x12\t\n" +
 	"\x05BASIC\x10\x01\x12\v\n" +
 	"\aGENERAL\x10\x02\x12\v\n" +
 	"\aSTORAGE\x10\x03\x12\x10\n" +
-	"\fMEMO_RELATED\x10\x04B\x9f\x01\n" +
+	"\fMEMO_RELATED\x10\x04\x12\x06\n" +
+	"\x02AI\x10\x05B\x9f\x01\n" +
 	"\x0fcom.memos.storeB\x14InstanceSettingProtoP\x01Z)github.com/usememos/memos/proto/gen/store\xa2\x02\x03MSX\xaa\x02\vMemos.Store\xca\x02\vMemos\\Store\xe2\x02\x17Memos\\Store\\GPBMetadata\xea\x02\fMemos::Storeb\x06proto3"
 
 var (
@@ -802,33 +1145,45 @@ func file_store_instance_setting_proto_rawDescGZIP() []byte {
 	return file_store_instance_setting_proto_rawDescData
 }
 
-var file_store_instance_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_store_instance_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_store_instance_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_store_instance_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_store_instance_setting_proto_goTypes = []any{
 	(InstanceSettingKey)(0),                 // 0: memos.store.InstanceSettingKey
 	(InstanceStorageSetting_StorageType)(0), // 1: memos.store.InstanceStorageSetting.StorageType
-	(*InstanceSetting)(nil),                 // 2: memos.store.InstanceSetting
-	(*InstanceBasicSetting)(nil),            // 3: memos.store.InstanceBasicSetting
-	(*InstanceGeneralSetting)(nil),          // 4: memos.store.InstanceGeneralSetting
-	(*InstanceCustomProfile)(nil),           // 5: memos.store.InstanceCustomProfile
-	(*InstanceStorageSetting)(nil),          // 6: memos.store.InstanceStorageSetting
-	(*StorageS3Config)(nil),                 // 7: memos.store.StorageS3Config
-	(*InstanceMemoRelatedSetting)(nil),      // 8: memos.store.InstanceMemoRelatedSetting
+	(InstanceAISetting_WebAccess)(0),        // 2: memos.store.InstanceAISetting.WebAccess
+	(*InstanceSetting)(nil),                 // 3: memos.store.InstanceSetting
+	(*InstanceBasicSetting)(nil),            // 4: memos.store.InstanceBasicSetting
+	(*InstanceGeneralSetting)(nil),          // 5: memos.store.InstanceGeneralSetting
+	(*InstanceCustomProfile)(nil),           // 6: memos.store.InstanceCustomProfile
+	(*InstanceStorageSetting)(nil),          // 7: memos.store.InstanceStorageSetting
+	(*StorageS3Config)(nil),                 // 8: memos.store.StorageS3Config
+	(*InstanceMemoRelatedSetting)(nil),      // 9: memos.store.InstanceMemoRelatedSetting
+	(*InstanceAISetting)(nil),               // 10: memos.store.InstanceAISetting
+	(*MCPServer)(nil),                       // 11: memos.store.MCPServer
+	nil,                                     // 12: memos.store.InstanceAISetting.RoleMonthlyTokenQuotasEntry
+	nil,                                     // 13: memos.store.InstanceAISetting.UserMonthlyTokenQuotasEntry
+	nil,                                     // 14: memos.store.MCPServer.HeadersEntry
 }
 var file_store_instance_setting_proto_depIdxs = []int32{
-	0, // 0: memos.store.InstanceSetting.key:type_name -> memos.store.InstanceSettingKey
-	3, // 1: memos.store.InstanceSetting.basic_setting:type_name -> memos.store.InstanceBasicSetting
-	4, // 2: memos.store.InstanceSetting.general_setting:type_name -> memos.store.InstanceGeneralSetting
-	6, // 3: memos.store.InstanceSetting.storage_setting:type_name -> memos.store.InstanceStorageSetting
-	8, // 4: memos.store.InstanceSetting.memo_related_setting:type_name -> memos.store.InstanceMemoRelatedSetting
-	5, // 5: memos.store.InstanceGeneralSetting.custom_profile:type_name -> memos.store.InstanceCustomProfile
-	1, // 6: memos.store.InstanceStorageSetting.storage_type:type_name -> memos.store.InstanceStorageSetting.StorageType
-	7, // 7: memos.store.InstanceStorageSetting.s3_config:type_name -> memos.store.StorageS3Config
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	0,  // 0: memos.store.InstanceSetting.key:type_name -> memos.store.InstanceSettingKey
+	4,  // 1: memos.store.InstanceSetting.basic_setting:type_name -> memos.store.InstanceBasicSetting
+	5,  // 2: memos.store.InstanceSetting.general_setting:type_name -> memos.store.InstanceGeneralSetting
+	7,  // 3: memos.store.InstanceSetting.storage_setting:type_name -> memos.store.InstanceStorageSetting
+	9,  // 4: memos.store.InstanceSetting.memo_related_setting:type_name -> memos.store.InstanceMemoRelatedSetting
+	10, // 5: memos.store.InstanceSetting.ai_setting:type_name -> memos.store.InstanceAISetting
+	6,  // 6: memos.store.InstanceGeneralSetting.custom_profile:type_name -> memos.store.InstanceCustomProfile
+	1,  // 7: memos.store.InstanceStorageSetting.storage_type:type_name -> memos.store.InstanceStorageSetting.StorageType
+	8,  // 8: memos.store.InstanceStorageSetting.s3_config:type_name -> memos.store.StorageS3Config
+	12, // 9: memos.store.InstanceAISetting.role_monthly_token_quotas:type_name -> memos.store.InstanceAISetting.RoleMonthlyTokenQuotasEntry
+	13, // 10: memos.store.InstanceAISetting.user_monthly_token_quotas:type_name -> memos.store.InstanceAISetting.UserMonthlyTokenQuotasEntry
+	11, // 11: memos.store.InstanceAISetting.mcp_servers:type_name -> memos.store.MCPServer
+	2,  // 12: memos.store.InstanceAISetting.web_access:type_name -> memos.store.InstanceAISetting.WebAccess
+	14, // 13: memos.store.MCPServer.headers:type_name -> memos.store.MCPServer.HeadersEntry
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_store_instance_setting_proto_init() }
@@ -841,14 +1196,15 @@ func file_store_instance_setting_proto_init() {
 		(*InstanceSetting_GeneralSetting)(nil),
 		(*InstanceSetting_StorageSetting)(nil),
 		(*InstanceSetting_MemoRelatedSetting)(nil),
+		(*InstanceSetting_AiSetting)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_instance_setting_proto_rawDesc), len(file_store_instance_setting_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   7,
+			NumEnums:      3,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -31,7 +31,9 @@ var PublicMethods = map[string]struct{}{
 	// Memo Service - public memos (visibility filtering done in service layer)
 	"/memos.api.v1.MemoService/GetMemo":          {},
 	"/memos.api.v1.MemoService/ListMemos":        {},
+	"/memos.api.v1.MemoService/SearchMemos":      {},
 	"/memos.api.v1.MemoService/ListMemoComments": {},
+	"/memos.api.v1.MemoService/ListRelatedMemos": {},
 }
 
 // IsPublicMethod checks if a procedure path is public (no authentication required).
@@ -27,6 +27,8 @@ func TestPublicMethodsArePublic(t *testing.T) {
 		// Memo Service
 		"/memos.api.v1.MemoService/GetMemo",
 		"/memos.api.v1.MemoService/ListMemos",
+		"/memos.api.v1.MemoService/SearchMemos",
+		"/memos.api.v1.MemoService/ListRelatedMemos",
 	}
 
 	for _, method := range publicMethods {
@@ -62,6 +64,18 @@ func TestProtectedMethodsRequireAuth(t *testing.T) {
 		"/memos.api.v1.ShortcutService/DeleteShortcut",
 		// Activity Service
 		"/memos.api.v1.ActivityService/GetActivity",
+		// AI Service
+		"/memos.api.v1.AIService/ListAISessions",
+		"/memos.api.v1.AIService/SearchAISessions",
+		"/memos.api.v1.AIService/CreateAISession",
+		"/memos.api.v1.AIService/Chat",
+		"/memos.api.v1.AIService/CancelAITurn",
+		"/memos.api.v1.AIService/ResumeAITurn",
+		"/memos.api.v1.AIService/SaveAISessionAsMemo",
+		"/memos.api.v1.AIService/ListAIMemories",
+		"/memos.api.v1.AIService/DeleteAIMemory",
+		"/memos.api.v1.AIService/GenerateCompletion",
+		"/memos.api.v1.AIService/GetAIUsage",
 	}
 
 	for _, method := range protectedMethods {
@@ -0,0 +1,216 @@
+package v1
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// Chat messages form a tree through their parent pointers: editing a user
+// message or regenerating a reply starts a new branch beside the original.
+// The session remembers which branch is active, and only that branch is shown
+// to the user and replayed to the model.
+
+func (s *APIV1Service) ListAIBranches(ctx context.Context, request *v1pb.ListAIBranchesRequest) (*v1pb.ListAIBranchesResponse, error) {
+	_, sess, msgs, err := s.loadAIChatSession(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+	children := childrenByParent(msgs)
+	active := activeMessageID(sess, msgs)
+
+	response := &v1pb.ListAIBranchesResponse{Branches: []*v1pb.AIBranch{}}
+	for _, m := range msgs {
+		if len(children[m.ID]) > 0 {
+			continue
+		}
+		path := branchPath(msgs, m.ID)
+		branch := &v1pb.AIBranch{
+			LeafMessage:  constructAIMessageName(sess, m.ID),
+			MessageCount: int32(len(path)),
+			Active:       m.ID == active,
+			UpdateTime:   timestamppb.New(time.Unix(m.CreatedTs, 0)),
+		}
+		for i := len(path) - 1; i >= 0; i-- {
+			if path[i].Role == "user" {
+				branch.Preview = truncateSnippet(path[i].Content, 0)
+				break
+			}
+		}
+		for i := len(path) - 1; i >= 0; i-- {
+			if len(children[path[i].ParentID]) > 1 {
+				branch.ForkMessage = constructAIMessageName(sess, path[i].ParentID)
+				break
+			}
+		}
+		response.Branches = append(response.Branches, branch)
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) SwitchAIBranch(ctx context.Context, request *v1pb.SwitchAIBranchRequest) (*v1pb.AISession, error) {
+	_, sess, msgs, err := s.loadAIChatSession(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	sessionName, id, err := extractAIMessageFromName(request.Message)
+	if err != nil || sessionName != request.Name || findMessage(msgs, id) == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "message not found")
+	}
+	leafID := latestLeaf(msgs, id)
+	updated, err := s.Store.UpdateAIChatSession(ctx, &store.UpdateAIChatSession{
+		UID:             sess.UID,
+		ActiveMessageID: &leafID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update session: %v", err)
+	}
+	return convertAISessionFromStore(updated), nil
+}
+
+func (s *APIV1Service) RegenerateAIMessage(request *v1pb.RegenerateAIMessageRequest, stream grpc.ServerStreamingServer[v1pb.AIChatEvent]) error {
+	if s.LLM == nil {
+		return status.Errorf(codes.FailedPrecondition, "AI chat is not configured (missing AI_PROVIDER)")
+	}
+	sessionName, id, err := extractAIMessageFromName(request.Name)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid message name: %v", err)
+	}
+	ctx := stream.Context()
+	user, sess, msgs, err := s.loadAIChatSession(ctx, sessionName)
+	if err != nil {
+		return err
+	}
+
+	var userMsg *store.AIChatMessage
+	for m := findMessage(msgs, id); m != nil; m = findMessage(msgs, m.ParentID) {
+		if m.Role == "user" {
+			userMsg = m
+			break
+		}
+	}
+	if userMsg == nil {
+		return status.Errorf(codes.NotFound, "message not found")
+	}
+
+	return s.streamAIChatTurn(ctx, stream, user, sess, msgs, &chatTurn{
+		parentID:   userMsg.ID,
+		content:    userMsg.Content,
+		tagFilter:  request.TagFilter,
+		model:      request.Model,
+		regenerate: true,
+	})
+}
+
+// convertActiveBranch converts the messages on the session's active branch.
+func convertActiveBranch(sess *store.AIChatSession, msgs []*store.AIChatMessage) []*v1pb.AIMessage {
+	children := childrenByParent(msgs)
+	branch := branchPath(msgs, activeMessageID(sess, msgs))
+	messages := make([]*v1pb.AIMessage, 0, len(branch))
+	for _, m := range branch {
+		message := &v1pb.AIMessage{
+			Name:          constructAIMessageName(sess, m.ID),
+			ParentMessage: constructAIMessageName(sess, m.ParentID),
+			Role:          convertAIMessageRoleFromStore(m.Role),
+			Content:       m.Content,
+			ToolName:      m.ToolName,
+			ToolCallId:    m.ToolCallID,
+			Compacted:     m.Compacted,
+			CreateTime:    timestamppb.New(time.Unix(m.CreatedTs, 0)),
+		}
+		for _, id := range children[m.ParentID] {
+			message.SiblingMessages = append(message.SiblingMessages, constructAIMessageName(sess, id))
+		}
+		for _, tc := range decodeToolCalls(m.ToolCalls) {
+			message.ToolCalls = append(message.ToolCalls, &v1pb.AIMessage_ToolCall{
+				Id:        tc.ID,
+				Name:      tc.Function.Name,
+				Arguments: tc.Function.Arguments,
+			})
+		}
+		messages = append(messages, message)
+	}
+	return messages
+}
+
+func convertAIMessageRoleFromStore(role string) v1pb.AIMessage_Role {
+	switch role {
+	case "user":
+		return v1pb.AIMessage_USER
+	case "assistant":
+		return v1pb.AIMessage_ASSISTANT
+	case "tool":
+		return v1pb.AIMessage_TOOL
+	default:
+		return v1pb.AIMessage_ROLE_UNSPECIFIED
+	}
+}
+
+// activeMessageID returns the last message of the session's active branch,
+// falling back to the most recent message; 0 if there are none.
+func activeMessageID(sess *store.AIChatSession, msgs []*store.AIChatMessage) int32 {
+	if sess.ActiveMessageID != 0 && findMessage(msgs, sess.ActiveMessageID) != nil {
+		return sess.ActiveMessageID
+	}
+	if len(msgs) == 0 {
+		return 0
+	}
+	return msgs[len(msgs)-1].ID
+}
+
+func findMessage(msgs []*store.AIChatMessage, id int32) *store.AIChatMessage {
+	if id == 0 {
+		return nil
+	}
+	i := slices.IndexFunc(msgs, func(m *store.AIChatMessage) bool { return m.ID == id })
+	if i < 0 {
+		return nil
+	}
+	return msgs[i]
+}
+
+// branchPath returns the messages from the first message up to and including
+// leafID, oldest first.
+func branchPath(msgs []*store.AIChatMessage, leafID int32) []*store.AIChatMessage {
+	byID := make(map[int32]*store.AIChatMessage, len(msgs))
+	for _, m := range msgs {
+		byID[m.ID] = m
+	}
+	var path []*store.AIChatMessage
+	for m := byID[leafID]; m != nil; m = byID[m.ParentID] {
+		path = append(path, m)
+		// Parents always precede their children, which also guards against cycles.
+		if m.ParentID >= m.ID {
+			break
+		}
+	}
+	slices.Reverse(path)
+	return path
+}
+
+// latestLeaf follows the most recent reply from id down to the end of its branch.
+func latestLeaf(msgs []*store.AIChatMessage, id int32) int32 {
+	children := childrenByParent(msgs)
+	for len(children[id]) > 0 {
+		id = children[id][len(children[id])-1]
+	}
+	return id
+}
+
+// childrenByParent maps each message ID to its replies, oldest first. The
+// first messages of the session are keyed by 0.
+func childrenByParent(msgs []*store.AIChatMessage) map[int32][]int32 {
+	children := make(map[int32][]int32)
+	for _, m := range msgs {
+		children[m.ParentID] = append(children[m.ParentID], m.ID)
+	}
+	return children
+}
@@ -0,0 +1,51 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestChatBranches(t *testing.T) {
+	// 1 ─ 2 ─ 3
+	//   └ 4 ─ 5   (2 regenerated as 4)
+	// 6           (1 edited as 6)
+	msgs := []*store.AIChatMessage{
+		{ID: 1, Role: "user"},
+		{ID: 2, ParentID: 1, Role: "assistant"},
+		{ID: 3, ParentID: 2, Role: "user"},
+		{ID: 4, ParentID: 1, Role: "assistant"},
+		{ID: 5, ParentID: 4, Role: "user"},
+		{ID: 6, Role: "user"},
+	}
+	ids := func(path []*store.AIChatMessage) []int32 {
+		var ids []int32
+		for _, m := range path {
+			ids = append(ids, m.ID)
+		}
+		return ids
+	}
+
+	require.Equal(t, []int32{1, 2, 3}, ids(branchPath(msgs, 3)))
+	require.Equal(t, []int32{1, 4, 5}, ids(branchPath(msgs, 5)))
+	require.Empty(t, branchPath(msgs, 0))
+
+	require.Equal(t, int32(5), latestLeaf(msgs, 1))
+	require.Equal(t, int32(3), latestLeaf(msgs, 2))
+	require.Equal(t, int32(6), latestLeaf(msgs, 6))
+
+	// Without an active branch the most recent message is used.
+	require.Equal(t, int32(6), activeMessageID(&store.AIChatSession{}, msgs))
+	require.Equal(t, int32(3), activeMessageID(&store.AIChatSession{ActiveMessageID: 3}, msgs))
+	require.Equal(t, int32(6), activeMessageID(&store.AIChatSession{ActiveMessageID: 99}, msgs))
+
+	resp := convertActiveBranch(&store.AIChatSession{UID: "chat", CreatorID: 1, ActiveMessageID: 5}, msgs)
+	require.Len(t, resp, 3)
+	require.Equal(t, "users/1/aiSessions/chat/messages/4", resp[1].Name)
+	require.Equal(t, "users/1/aiSessions/chat/messages/1", resp[1].ParentMessage)
+	require.Empty(t, resp[0].ParentMessage)
+	require.Equal(t, []string{"users/1/aiSessions/chat/messages/1", "users/1/aiSessions/chat/messages/6"}, resp[0].SiblingMessages)
+	require.Equal(t, []string{"users/1/aiSessions/chat/messages/2", "users/1/aiSessions/chat/messages/4"}, resp[1].SiblingMessages)
+}
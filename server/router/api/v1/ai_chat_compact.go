@@ -0,0 +1,219 @@
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/llm"
+	"github.com/usememos/memos/store"
+)
+
+// Long chats are compacted before their prompt outgrows the model's context
+// window: the oldest messages of the branch are folded into a rolling summary
+// of the session and marked as compacted. They are kept in the database but no
+// longer sent to the model, which sees the summary in their place.
+
+const (
+	// compactThresholdPercent is the share of the context window the prompt may
+	// fill before it is compacted; the rest is left for the reply.
+	compactThresholdPercent = 75
+	// compactKeepPercent is the share of the context window that the recent
+	// messages kept verbatim may fill after compaction.
+	compactKeepPercent = 30
+	// summaryBatchPercent is the share of the context window a single
+	// summarisation prompt may fill. Larger backlogs are summarised in batches.
+	summaryBatchPercent = 50
+	// messageTokenOverhead is what a message costs beyond its content, for its
+	// role and delimiters.
+	messageTokenOverhead = 4
+)
+
+// liveHistory returns the summary that applies to branch and the messages of
+// branch after it. The session summary only applies to branches through the
+// last message it covers; other branches are sent whole.
+func liveHistory(sess *store.AIChatSession, branch []*store.AIChatMessage) (string, []*store.AIChatMessage) {
+	// Sessions compacted before summaries were tied to a message deleted the
+	// messages they covered.
+	if sess.SummaryMessageID == 0 {
+		return sess.Summary, branch
+	}
+	for i, m := range branch {
+		if m.ID == sess.SummaryMessageID {
+			return sess.Summary, branch[i+1:]
+		}
+	}
+	return "", branch
+}
+
+// messageTokens counts the tokens a stored message takes up in the prompt.
+func messageTokens(m *store.AIChatMessage) int {
+	return llm.CountTokens(m.Content) + llm.CountTokens(m.ToolCalls) + messageTokenOverhead
+}
+
+// compactionCut returns how many messages at the start of live to summarise
+// so that the remaining ones take up at most keep tokens. The cut is moved
+// forward to the start of a turn, so that tool calls stay with their results;
+// 0 means there is nothing to summarise.
+func compactionCut(live []*store.AIChatMessage, keep int) int {
+	cut := len(live)
+	kept := 0
+	for cut > 0 {
+		tokens := messageTokens(live[cut-1])
+		if kept+tokens > keep {
+			break
+		}
+		kept += tokens
+		cut--
+	}
+	for i := cut; i < len(live); i++ {
+		if live[i].Role == "user" {
+			return i
+		}
+	}
+	// The recent messages are a single long turn; cut between its rounds.
+	for cut < len(live) && live[cut].Role == "tool" {
+		cut++
+	}
+	return cut
+}
+
+// contextWindow returns the context window of the turn's model. The configured
+// window only applies to the default model.
+func (a *chatAgent) contextWindow() int {
+	if a.model == a.s.LLM.Model() {
+		return a.s.LLM.ContextWindow()
+	}
+	return llm.ModelContextWindow(a.model)
+}
+
+// compact summarises the oldest messages of branch when the prompt for the
+// turn would fill too much of the model's context window. pending is the
+// user message about to be added.
+func (a *chatAgent) compact(branch []*store.AIChatMessage, pending string) error {
+	window := a.contextWindow()
+	summary, live := liveHistory(a.sess, branch)
+
+	toolDefs, err := json.Marshal(a.toolDefs)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode tool definitions")
+	}
+	overhead := llm.CountTokens(a.systemPrompt(summary)) + llm.CountTokens(string(toolDefs)) + llm.CountTokens(pending)
+	total := overhead
+	for _, m := range live {
+		total += messageTokens(m)
+	}
+	if total <= window*compactThresholdPercent/100 {
+		return nil
+	}
+
+	cut := compactionCut(live, window*compactKeepPercent/100)
+	if cut == 0 {
+		return nil
+	}
+	old := live[:cut]
+
+	// Fold the messages into the summary batch by batch, so that no prompt
+	// outgrows the window either. Progress is saved even if a later batch fails.
+	batchBudget := window * summaryBatchPercent / 100
+	var summarised []*store.AIChatMessage
+	var summaryErr error
+	for len(summarised) < len(old) {
+		var batch strings.Builder
+		budget := batchBudget - llm.CountTokens(summary)
+		n := 0
+		for _, m := range old[len(summarised):] {
+			text := formatForSummary(m)
+			tokens := llm.CountTokens(text)
+			if n > 0 && tokens > budget {
+				break
+			}
+			// A single message too long for a batch is summarised in part.
+			batch.WriteString(truncateToTokens(text, tokens, max(budget, batchBudget/4)))
+			batch.WriteString("\n")
+			budget -= tokens
+			n++
+		}
+		updated, err := a.s.callLLM(a.ctx, a.user, aiUsageCompaction, buildSummaryPrompt(summary, batch.String()))
+		if err != nil {
+			summaryErr = err
+			break
+		}
+		if strings.TrimSpace(updated) == "" {
+			summaryErr = errors.New("the model returned an empty summary")
+			break
+		}
+		summary = strings.TrimSpace(updated)
+		summarised = append(summarised, old[len(summarised):len(summarised)+n]...)
+	}
+	if len(summarised) == 0 {
+		return summaryErr
+	}
+
+	ids := make([]int32, 0, len(summarised))
+	for _, m := range summarised {
+		ids = append(ids, m.ID)
+	}
+	lastID := ids[len(ids)-1]
+	if err := a.s.Store.CompactAIChatSession(a.ctx, &store.CompactAIChatSession{
+		SessionID:        a.sess.ID,
+		Summary:          summary,
+		SummaryMessageID: lastID,
+		MessageIDs:       ids,
+	}); err != nil {
+		return errors.Wrap(err, "failed to save summary")
+	}
+	a.sess.Summary = summary
+	a.sess.SummaryMessageID = lastID
+	for _, m := range summarised {
+		m.Compacted = true
+	}
+
+	slog.Info("context compacted", "session", a.sess.UID, "window", window, "tokens", total, "compacted_messages", len(ids))
+	return summaryErr
+}
+
+// formatForSummary renders a message as a line of the transcript to summarise.
+func formatForSummary(m *store.AIChatMessage) string {
+	switch {
+	case m.Role == "tool":
+		return fmt.Sprintf("tool result (%s): %s", m.ToolName, m.Content)
+	case m.ToolCalls != "":
+		var calls []string
+		for _, tc := range decodeToolCalls(m.ToolCalls) {
+			calls = append(calls, tc.Function.Name+" "+tc.Function.Arguments)
+		}
+		return fmt.Sprintf("%s: %s [called %s]", m.Role, m.Content, strings.Join(calls, "; "))
+	default:
+		return m.Role + ": " + m.Content
+	}
+}
+
+// buildSummaryPrompt asks the model to fold new messages into the summary.
+func buildSummaryPrompt(summary, transcript string) string {
+	if summary == "" {
+		summary = "(none yet)"
+	}
+	return fmt.Sprintf(`You maintain the running summary of a conversation between a user and their note-taking assistant.
+Update the summary with the new messages below. Preserve key facts, decisions, the user's preferences, open questions and the UIDs of notes that were mentioned. Drop small talk and details of tool output that no longer matter.
+Reply with the updated summary only.
+
+Current summary:
+%s
+
+New messages:
+%s`, summary, transcript)
+}
+
+// truncateToTokens shortens text of the given token count to about limit
+// tokens, keeping its beginning.
+func truncateToTokens(text string, tokens, limit int) string {
+	if tokens <= limit {
+		return text
+	}
+	runes := []rune(text)
+	return string(runes[:len(runes)*limit/tokens]) + " [truncated]"
+}
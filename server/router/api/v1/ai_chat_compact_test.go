@@ -0,0 +1,72 @@
+package v1
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/plugin/llm"
+	"github.com/usememos/memos/store"
+)
+
+func TestLiveHistory(t *testing.T) {
+	branch := []*store.AIChatMessage{{ID: 1}, {ID: 2}, {ID: 3}, {ID: 4}}
+
+	summary, live := liveHistory(&store.AIChatSession{Summary: "earlier", SummaryMessageID: 2}, branch)
+	require.Equal(t, "earlier", summary)
+	require.Equal(t, branch[2:], live)
+
+	// The summary covers another branch.
+	summary, live = liveHistory(&store.AIChatSession{Summary: "earlier", SummaryMessageID: 7}, branch)
+	require.Empty(t, summary)
+	require.Equal(t, branch, live)
+
+	// Summaries from before they were tied to a message still apply.
+	summary, live = liveHistory(&store.AIChatSession{Summary: "earlier"}, branch)
+	require.Equal(t, "earlier", summary)
+	require.Equal(t, branch, live)
+}
+
+func TestCompactionCut(t *testing.T) {
+	// Each message takes roughly 100 to 130 tokens.
+	text := strings.Repeat("word ", 100)
+	live := []*store.AIChatMessage{
+		{ID: 1, Role: "user", Content: text},
+		{ID: 2, Role: "assistant", Content: text},
+		{ID: 3, Role: "user", Content: text},
+		{ID: 4, Role: "assistant", Content: text},
+		{ID: 5, Role: "user", Content: text},
+		{ID: 6, Role: "assistant", Content: text},
+	}
+	require.Equal(t, 4, compactionCut(live, 300))
+	// Three messages would fit, but the cut moves to the start of a turn.
+	require.Equal(t, 4, compactionCut(live, 400))
+	require.Equal(t, 0, compactionCut(live, 10_000))
+
+	// Within one long turn the cut never separates a result from its call.
+	turn := []*store.AIChatMessage{
+		{ID: 1, Role: "user", Content: text},
+		{ID: 2, Role: "assistant", ToolCalls: `[{"id":"a"}]`},
+		{ID: 3, Role: "tool", ToolCallID: "a", Content: text},
+		{ID: 4, Role: "tool", ToolCallID: "b", Content: text},
+		{ID: 5, Role: "assistant", Content: text},
+	}
+	require.Equal(t, 4, compactionCut(turn, 300))
+	require.Equal(t, 5, compactionCut(turn, 50))
+}
+
+func TestChatAgentContextWindow(t *testing.T) {
+	provider, err := llm.NewProvider(&llm.Config{Backend: llm.BackendOpenAI, Model: "gpt-4o", ContextWindow: 64_000})
+	require.NoError(t, err)
+	s := &APIV1Service{LLM: provider}
+
+	require.Equal(t, 64_000, (&chatAgent{s: s, model: "gpt-4o"}).contextWindow())
+	// Other allowed models have their own, possibly smaller, window.
+	require.Equal(t, 8192, (&chatAgent{s: s, model: "gpt-4"}).contextWindow())
+}
+
+func TestTruncateToTokens(t *testing.T) {
+	require.Equal(t, "short", truncateToTokens("short", 2, 10))
+	require.Equal(t, "abcde [truncated]", truncateToTokens("abcdefghij", 10, 5))
+}
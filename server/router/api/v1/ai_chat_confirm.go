@@ -0,0 +1,256 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/pmezard/go-difflib/difflib"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/llm"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// Tool calls that need the user's approval pause the agent. The calls are saved
+// with the session as pending actions, so they survive a reload, and the user
+// confirms or rejects each one through ConfirmAIAction, which resumes the
+// agent once none are left.
+
+// defaultConfirmTools need approval until the user sets their own policy.
+var defaultConfirmTools = []string{"update_memo", "delete_memo"}
+
+// pendingAction is a tool call awaiting the user's approval.
+type pendingAction struct {
+	ToolCallID string `json:"toolCallId"`
+	ToolName   string `json:"toolName"`
+	Input      string `json:"input"`
+	// MessageID is the assistant message that made the call.
+	MessageID int32 `json:"messageId"`
+	// MemoUID and Diff describe the change the call would make to a memo, as a
+	// unified diff; both are empty for tools that do not write memos.
+	MemoUID string `json:"memoUid,omitempty"`
+	Diff    string `json:"diff,omitempty"`
+	// Query, TagFilter and Model are the turn's, for when the agent resumes.
+	Query     string `json:"query"`
+	TagFilter string `json:"tagFilter,omitempty"`
+	Model     string `json:"model,omitempty"`
+}
+
+func (s *APIV1Service) ConfirmAIAction(request *v1pb.ConfirmAIActionRequest, stream grpc.ServerStreamingServer[v1pb.AIChatEvent]) error {
+	if s.LLM == nil {
+		return status.Errorf(codes.FailedPrecondition, "AI chat is not configured (missing AI_PROVIDER)")
+	}
+	if request.ToolCallId == "" {
+		return status.Errorf(codes.InvalidArgument, "tool call ID is required")
+	}
+	ctx := stream.Context()
+	user, sess, msgs, err := s.loadAIChatSession(ctx, request.Name)
+	if err != nil {
+		return err
+	}
+
+	actions := decodePendingActions(sess.PendingActions)
+	i := slices.IndexFunc(actions, func(a *pendingAction) bool { return a.ToolCallID == request.ToolCallId })
+	if i < 0 {
+		return status.Errorf(codes.NotFound, "no pending action for this tool call")
+	}
+	action := actions[i]
+	branch := branchPath(msgs, activeMessageID(sess, msgs))
+	if !slices.ContainsFunc(branch, func(m *store.AIChatMessage) bool { return m.ID == action.MessageID }) {
+		return status.Errorf(codes.FailedPrecondition, "the action is not on the active branch")
+	}
+
+	// The turn's model may no longer be allowed, in which case the agent
+	// resumes with the default one.
+	instanceAISetting, err := s.Store.GetInstanceAISetting(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get instance AI setting: %v", err)
+	}
+	model := action.Model
+	if _, err := resolveChatModel(instanceAISetting, model, s.LLM.Model()); err != nil {
+		model = ""
+	}
+	agent, err := s.newChatAgent(ctx, user, sess, action.Query, action.TagFilter, model, branch[len(branch)-1].ID)
+	if err != nil {
+		return err
+	}
+	// Another confirmation of the same call may have run in the meantime. Now
+	// that this turn is registered no other can start, so check again that
+	// the call is still pending, lest it run twice.
+	if _, agent.sess, err = s.getAISession(ctx, request.Name); err != nil {
+		agent.job.finish()
+		return err
+	}
+	actions = decodePendingActions(agent.sess.PendingActions)
+	if i = slices.IndexFunc(actions, func(a *pendingAction) bool { return a.ToolCallID == request.ToolCallId }); i < 0 {
+		agent.job.finish()
+		return status.Errorf(codes.NotFound, "no pending action for this tool call")
+	}
+	action = actions[i]
+	return agent.runJob(stream, func() { agent.resume(action, slices.Delete(actions, i, i+1), request.Approved) })
+}
+
+// resume runs or rejects action, then carries on with the turn once no other
+// actions await the user's approval.
+func (a *chatAgent) resume(action *pendingAction, remaining []*pendingAction, approved bool) {
+	tc := llm.ToolCall{
+		ID:       action.ToolCallID,
+		Type:     "function",
+		Function: llm.FunctionCall{Name: action.ToolName, Arguments: action.Input},
+	}
+	if approved {
+		a.callTool(tc)
+	} else {
+		a.recordToolResult(tc, "The user rejected this action. Do not retry it unless they ask.")
+	}
+
+	if len(remaining) > 0 {
+		a.pause(remaining)
+		return
+	}
+	msgs, err := a.s.Store.ListAIChatMessages(a.ctx, &store.FindAIChatMessage{SessionID: a.sess.ID})
+	if err != nil {
+		a.emitError("failed to load chat history: " + err.Error())
+		return
+	}
+	a.loadHistory(branchPath(msgs, a.parentID))
+	a.run()
+}
+
+// getUserAISetting returns the user's AI setting, or the defaults when they
+// have not saved one.
+func (s *APIV1Service) getUserAISetting(ctx context.Context, userID int32) (*storepb.AIUserSetting, error) {
+	setting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_AI,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get user AI setting")
+	}
+	if setting == nil {
+		return &storepb.AIUserSetting{ConfirmTools: defaultConfirmTools}, nil
+	}
+	if setting.GetAi() == nil {
+		return &storepb.AIUserSetting{}, nil
+	}
+	return setting.GetAi(), nil
+}
+
+// proposeAction describes a tool call for the user to approve, including the
+// change it would make to a memo.
+func (a *chatAgent) proposeAction(tc llm.ToolCall, messageID int32) *pendingAction {
+	action := &pendingAction{
+		ToolCallID: tc.ID,
+		ToolName:   tc.Function.Name,
+		Input:      tc.Function.Arguments,
+		MessageID:  messageID,
+		Query:      a.query,
+		TagFilter:  a.tagFilter,
+		Model:      a.model,
+	}
+	var input struct {
+		UID     string   `json:"uid"`
+		Content string   `json:"content"`
+		NewTags []string `json:"new_tags"`
+	}
+	if err := json.Unmarshal([]byte(tc.Function.Arguments), &input); err != nil {
+		return action
+	}
+
+	var before string
+	if input.UID != "" {
+		// The tool itself reports a missing or foreign memo when it runs.
+		m, _ := getOwnMemo(a.ctx, a.s.Store, a.user.ID, input.UID)
+		if m == nil {
+			return action
+		}
+		before = m.Content
+	}
+	var after string
+	switch tc.Function.Name {
+	case "create_memo", "update_memo":
+		after = input.Content
+	case "append_to_memo":
+		after = appendMemoContent(before, input.Content)
+	case "update_memo_tags":
+		after = appendMemoContent(before, strings.Join(input.NewTags, " "))
+	case "delete_memo":
+	default:
+		return action
+	}
+	action.MemoUID = input.UID
+	action.Diff = memoDiff(input.UID, before, after)
+	return action
+}
+
+// memoDiff returns a unified diff of a memo's content, line by line.
+func memoDiff(uid, before, after string) string {
+	name := MemoNamePrefix + uid
+	if uid == "" {
+		name = "new memo"
+	}
+	lines := func(content string) []string {
+		if content == "" {
+			return nil
+		}
+		return difflib.SplitLines(content)
+	}
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        lines(before),
+		B:        lines(after),
+		FromFile: name,
+		ToFile:   name,
+		Context:  3,
+	})
+	if err != nil {
+		slog.Warn("failed to diff memo content", "err", err)
+		return ""
+	}
+	return diff
+}
+
+func encodePendingActions(actions []*pendingAction) string {
+	if len(actions) == 0 {
+		return ""
+	}
+	data, err := json.Marshal(actions)
+	if err != nil {
+		slog.Warn("failed to encode pending actions", "err", err)
+		return ""
+	}
+	return string(data)
+}
+
+func convertPendingAction(sess *store.AIChatSession, action *pendingAction) *v1pb.AIPendingAction {
+	pending := &v1pb.AIPendingAction{
+		ToolCallId: action.ToolCallID,
+		ToolName:   action.ToolName,
+		Input:      action.Input,
+		Message:    constructAIMessageName(sess, action.MessageID),
+		Diff:       action.Diff,
+	}
+	if action.MemoUID != "" {
+		pending.Memo = MemoNamePrefix + action.MemoUID
+	}
+	return pending
+}
+
+func decodePendingActions(raw string) []*pendingAction {
+	if raw == "" {
+		return nil
+	}
+	var actions []*pendingAction
+	if err := json.Unmarshal([]byte(raw), &actions); err != nil {
+		slog.Warn("failed to decode pending actions", "err", err)
+		return nil
+	}
+	return actions
+}
@@ -0,0 +1,30 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoDiff(t *testing.T) {
+	require.Equal(t, `--- memos/abc
++++ memos/abc
+@@ -1,3 +1,3 @@
+ # Groceries
+-milk
++oat milk
+ eggs
+`, memoDiff("abc", "# Groceries\nmilk\neggs", "# Groceries\noat milk\neggs"))
+
+	// Deleting removes every line; creating adds them.
+	require.Equal(t, "--- memos/abc\n+++ memos/abc\n@@ -1,2 +0,0 @@\n-one\n-two\n", memoDiff("abc", "one\ntwo", ""))
+	require.Equal(t, "--- new memo\n+++ new memo\n@@ -0,0 +1 @@\n+hello\n", memoDiff("", "", "hello"))
+}
+
+func TestPendingActionsRoundTrip(t *testing.T) {
+	require.Equal(t, "", encodePendingActions(nil))
+	require.Nil(t, decodePendingActions(""))
+
+	actions := []*pendingAction{{ToolCallID: "call_1", ToolName: "delete_memo", Input: `{"uid":"abc"}`, MessageID: 7, MemoUID: "abc"}}
+	require.Equal(t, actions, decodePendingActions(encodePendingActions(actions)))
+}
@@ -0,0 +1,210 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/lithammer/shortuuid/v4"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// Each chat turn runs as a job on the server, detached from the request that
+// started it. The job buffers the events of the reply, so a client that went
+// away, say on a page refresh, can resume the turn and replay what it missed;
+// the user can also cancel the turn, which stops it before its next model or
+// tool call.
+
+// chatJobRetention is how long the events of a finished turn can be replayed.
+const chatJobRetention = 10 * time.Minute
+
+// extractAITurnFromName returns the session name and turn ID of a turn name.
+// Format: users/{user}/aiSessions/{ai_session}/turns/{turn}.
+func extractAITurnFromName(name string) (string, string, error) {
+	tokens, err := GetNameParentTokens(name, UserNamePrefix, AISessionNamePrefix, AITurnNamePrefix)
+	if err != nil {
+		return "", "", err
+	}
+	return fmt.Sprintf("%s%s/%s%s", UserNamePrefix, tokens[0], AISessionNamePrefix, tokens[1]), tokens[2], nil
+}
+
+func (s *APIV1Service) CancelAITurn(ctx context.Context, request *v1pb.CancelAITurnRequest) (*emptypb.Empty, error) {
+	job, err := s.getChatJob(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	// Cancelling a finished turn does nothing.
+	job.cancel()
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) ResumeAITurn(request *v1pb.ResumeAITurnRequest, stream grpc.ServerStreamingServer[v1pb.AIChatEvent]) error {
+	if request.Offset < 0 {
+		return status.Errorf(codes.InvalidArgument, "offset must not be negative")
+	}
+	ctx := stream.Context()
+	job, err := s.getChatJob(ctx, request.Name)
+	if err != nil {
+		return err
+	}
+	return job.watch(ctx, stream, int(request.Offset))
+}
+
+// getChatJob returns the current user's turn of the given name.
+func (s *APIV1Service) getChatJob(ctx context.Context, name string) (*chatJob, error) {
+	sessionName, _, err := extractAITurnFromName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid turn name: %v", err)
+	}
+	if _, _, err := s.getAISession(ctx, sessionName); err != nil {
+		return nil, err
+	}
+	job := s.chatJobs.get(name)
+	if job == nil {
+		return nil, status.Errorf(codes.NotFound, "turn not found")
+	}
+	return job, nil
+}
+
+// chatJobRegistry holds the running turns, and the finished ones for a while.
+type chatJobRegistry struct {
+	mu   sync.Mutex
+	jobs map[string]*chatJob
+}
+
+// start registers a new turn of the session, unless one is still running.
+func (r *chatJobRegistry) start(ctx context.Context, sess *store.AIChatSession) (*chatJob, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.jobs == nil {
+		r.jobs = make(map[string]*chatJob)
+	}
+	for _, job := range r.jobs {
+		if job.sessionID == sess.ID && !job.finished() {
+			return nil, status.Errorf(codes.FailedPrecondition, "the session already has a running turn")
+		}
+	}
+
+	// The turn outlives the request, keeping its values such as the user.
+	jobCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	job := &chatJob{
+		name:      fmt.Sprintf("%s/%s%s", constructAISessionName(sess), AITurnNamePrefix, shortuuid.New()),
+		sessionID: sess.ID,
+		ctx:       jobCtx,
+		cancel:    cancel,
+		changed:   make(chan struct{}),
+	}
+	job.onFinish = func() {
+		time.AfterFunc(chatJobRetention, func() { r.remove(job.name) })
+	}
+	r.jobs[job.name] = job
+	job.send(&v1pb.AIChatEvent{Event: &v1pb.AIChatEvent_Turn{Turn: job.name}})
+	return job, nil
+}
+
+func (r *chatJobRegistry) get(name string) *chatJob {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.jobs[name]
+}
+
+// running returns the name of the session's running turn, if any.
+func (r *chatJobRegistry) running(sessionID int32) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, job := range r.jobs {
+		if job.sessionID == sessionID && !job.finished() {
+			return job.name
+		}
+	}
+	return ""
+}
+
+func (r *chatJobRegistry) remove(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.jobs, name)
+}
+
+// chatJob is a chat turn and the events of its reply so far.
+type chatJob struct {
+	name      string
+	sessionID int32
+	// ctx is cancelled when the user cancels the turn.
+	ctx      context.Context
+	cancel   context.CancelFunc
+	onFinish func()
+
+	mu     sync.Mutex
+	events []*v1pb.AIChatEvent
+	done   bool
+	// changed is closed, and replaced, when an event is added or the turn ends.
+	changed chan struct{}
+}
+
+// send adds an event to the reply.
+func (j *chatJob) send(event *v1pb.AIChatEvent) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.events = append(j.events, event)
+	close(j.changed)
+	j.changed = make(chan struct{})
+}
+
+// finish ends the turn; its events stay available for chatJobRetention.
+func (j *chatJob) finish() {
+	j.mu.Lock()
+	j.done = true
+	close(j.changed)
+	j.changed = make(chan struct{})
+	j.mu.Unlock()
+
+	j.cancel()
+	if j.onFinish != nil {
+		j.onFinish()
+	}
+}
+
+func (j *chatJob) finished() bool {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.done
+}
+
+// cancelled reports whether the user cancelled the turn.
+func (j *chatJob) cancelled() bool {
+	return j.ctx.Err() != nil
+}
+
+// watch streams the events of the turn from offset until the turn ends. It
+// returns early when the client goes away, leaving the turn running.
+func (j *chatJob) watch(ctx context.Context, stream grpc.ServerStreamingServer[v1pb.AIChatEvent], offset int) error {
+	for {
+		j.mu.Lock()
+		events := j.events[min(offset, len(j.events)):]
+		done, changed := j.done, j.changed
+		j.mu.Unlock()
+
+		for _, event := range events {
+			if err := stream.Send(event); err != nil {
+				return nil
+			}
+		}
+		offset += len(events)
+		if done {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
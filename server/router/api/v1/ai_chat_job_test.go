@@ -0,0 +1,99 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// recordingStream collects the events sent to a client.
+type recordingStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events []*v1pb.AIChatEvent
+}
+
+func (s *recordingStream) Context() context.Context { return s.ctx }
+
+func (s *recordingStream) Send(event *v1pb.AIChatEvent) error {
+	s.events = append(s.events, event)
+	return nil
+}
+
+func TestChatJobReplay(t *testing.T) {
+	var jobs chatJobRegistry
+	sess := &store.AIChatSession{ID: 1, UID: "abc", CreatorID: 2}
+	job, err := jobs.start(context.Background(), sess)
+	require.NoError(t, err)
+	require.Regexp(t, `^users/2/aiSessions/abc/turns/\w+$`, job.name)
+	require.Equal(t, job.name, jobs.running(sess.ID))
+
+	// Only one turn of a session runs at a time.
+	_, err = jobs.start(context.Background(), sess)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	watched := make(chan []*v1pb.AIChatEvent)
+	go func() {
+		stream := &recordingStream{ctx: context.Background()}
+		_ = job.watch(stream.ctx, stream, 0)
+		watched <- stream.events
+	}()
+	job.send(&v1pb.AIChatEvent{Event: &v1pb.AIChatEvent_Token{Token: "Hello"}})
+	job.send(&v1pb.AIChatEvent{Event: &v1pb.AIChatEvent_Token{Token: " there"}})
+	job.finish()
+
+	// A client watching from the start sees the turn, then the reply.
+	events := <-watched
+	require.Len(t, events, 3)
+	require.Equal(t, job.name, events[0].GetTurn())
+	require.Equal(t, " there", events[2].GetToken())
+	require.Empty(t, jobs.running(sess.ID))
+
+	// A client resuming after the turn ended gets the events it missed.
+	stream := &recordingStream{ctx: context.Background()}
+	require.NoError(t, job.watch(stream.ctx, stream, 2))
+	require.Equal(t, events[2:], stream.events)
+
+	// The session can start its next turn.
+	_, err = jobs.start(context.Background(), sess)
+	require.NoError(t, err)
+}
+
+func TestChatJobCancel(t *testing.T) {
+	var jobs chatJobRegistry
+	job, err := jobs.start(context.Background(), &store.AIChatSession{ID: 1, UID: "abc", CreatorID: 2})
+	require.NoError(t, err)
+	require.False(t, job.cancelled())
+	job.cancel()
+	require.True(t, job.cancelled())
+
+	// A client going away stops watching, but not the turn.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	stream := &recordingStream{ctx: ctx}
+	require.NoError(t, job.watch(ctx, stream, 0))
+	require.Len(t, stream.events, 1)
+	require.False(t, job.finished())
+}
+
+func TestChatJobPanic(t *testing.T) {
+	var jobs chatJobRegistry
+	sess := &store.AIChatSession{ID: 1, UID: "abc", CreatorID: 2}
+	job, err := jobs.start(context.Background(), sess)
+	require.NoError(t, err)
+
+	// A panicking turn ends with an error instead of taking the server down.
+	agent := &chatAgent{job: job}
+	stream := &recordingStream{ctx: context.Background()}
+	require.NoError(t, agent.runJob(stream, func() { panic("boom") }))
+	require.Len(t, stream.events, 2)
+	require.Equal(t, "internal error", stream.events[1].GetError())
+	require.Empty(t, jobs.running(sess.ID))
+}
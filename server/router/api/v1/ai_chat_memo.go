@@ -0,0 +1,146 @@
+package v1
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// citedNotePattern matches the notes listed by the memo tools, such as
+// "[1] Note abc123 (score 0.032):".
+var citedNotePattern = regexp.MustCompile(`(?m)^\[\d+\] Note ([^\s:]+)`)
+
+func (s *APIV1Service) SaveAISessionAsMemo(ctx context.Context, request *v1pb.SaveAISessionAsMemoRequest) (*v1pb.Memo, error) {
+	user, sess, msgs, err := s.loadAIChatSession(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	tag := strings.TrimPrefix(strings.TrimSpace(request.Tag), "#")
+	if strings.ContainsFunc(tag, unicode.IsSpace) || strings.Contains(tag, "#") {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tag %q", request.Tag)
+	}
+
+	var content string
+	// sourceMessages hold the tool results the saved text drew on.
+	var sourceMessages []*store.AIChatMessage
+	if request.Message != "" {
+		sessionName, id, err := extractAIMessageFromName(request.Message)
+		if err != nil || sessionName != request.Name {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid message name")
+		}
+		message := findMessage(msgs, id)
+		if message == nil {
+			return nil, status.Errorf(codes.NotFound, "message not found")
+		}
+		if message.Role != "assistant" || message.Content == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "only assistant answers can be saved")
+		}
+		content = message.Content
+		// The answer draws on the tools called since the question it answers.
+		path := branchPath(msgs, id)
+		for i := len(path) - 2; i >= 0 && path[i].Role != "user"; i-- {
+			sourceMessages = append(sourceMessages, path[i])
+		}
+	} else {
+		branch := branchPath(msgs, activeMessageID(sess, msgs))
+		content = renderAISessionMarkdown(sess, branch)
+		sourceMessages = branch
+	}
+	if content == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "the session has no answers to save")
+	}
+	if tag != "" {
+		content = appendTags(content, []string{tag})
+	}
+
+	relations, err := s.citedMemoRelations(ctx, user.ID, sourceMessages)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list cited memos: %v", err)
+	}
+	return s.CreateMemo(ctx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{
+			Content:    content,
+			Visibility: request.Visibility,
+			Relations:  relations,
+		},
+	})
+}
+
+// renderAISessionMarkdown renders the questions and answers of a branch as
+// markdown under the session title. Tool calls and their results are left out.
+func renderAISessionMarkdown(sess *store.AIChatSession, branch []*store.AIChatMessage) string {
+	var sb strings.Builder
+	for _, m := range branch {
+		if m.Content == "" {
+			continue
+		}
+		switch m.Role {
+		case "user":
+			sb.WriteString("**You:** ")
+		case "assistant":
+			sb.WriteString("**Assistant:** ")
+		default:
+			continue
+		}
+		sb.WriteString(strings.TrimSpace(m.Content))
+		sb.WriteString("\n\n")
+	}
+	if sb.Len() == 0 {
+		return ""
+	}
+	return "# " + sess.Title + "\n\n" + strings.TrimSpace(sb.String())
+}
+
+// citedMemoRelations returns references to the user's memos that the tool
+// results list, in the order they were first cited.
+func (s *APIV1Service) citedMemoRelations(ctx context.Context, userID int32, msgs []*store.AIChatMessage) ([]*v1pb.MemoRelation, error) {
+	var uids []string
+	seen := make(map[string]bool)
+	for _, m := range msgs {
+		if m.Role != "tool" {
+			continue
+		}
+		for _, match := range citedNotePattern.FindAllStringSubmatch(m.Content, -1) {
+			if uid := match[1]; !seen[uid] {
+				seen[uid] = true
+				uids = append(uids, uid)
+			}
+		}
+	}
+	if len(uids) == 0 {
+		return nil, nil
+	}
+	// Cited memos may have been deleted since.
+	rowStatus := store.Normal
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		UIDList:        uids,
+		CreatorID:      &userID,
+		RowStatus:      &rowStatus,
+		ExcludeContent: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	exists := make(map[string]bool, len(memos))
+	for _, memo := range memos {
+		exists[memo.UID] = true
+	}
+	var relations []*v1pb.MemoRelation
+	for _, uid := range uids {
+		if !exists[uid] {
+			continue
+		}
+		relations = append(relations, &v1pb.MemoRelation{
+			RelatedMemo: &v1pb.MemoRelation_Memo{Name: MemoNamePrefix + uid},
+			Type:        v1pb.MemoRelation_REFERENCE,
+		})
+	}
+	return relations, nil
+}
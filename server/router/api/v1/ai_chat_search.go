@@ -0,0 +1,125 @@
+package v1
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) SearchAISessions(ctx context.Context, request *v1pb.SearchAISessionsRequest) (*v1pb.SearchAISessionsResponse, error) {
+	userID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	terms := searchTerms(request.Query)
+	if len(terms) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "query is required")
+	}
+	currentUser, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil || currentUser.ID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	var limit, offset int
+	if request.PageToken != "" {
+		var pageToken v1pb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		limit = int(pageToken.Limit)
+		offset = int(pageToken.Offset)
+	} else {
+		limit = int(request.PageSize)
+	}
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	if limit > MaxPageSize {
+		limit = MaxPageSize
+	}
+	limitPlusOne := limit + 1
+	matches, err := s.Store.SearchAIChats(ctx, &store.SearchAIChat{
+		CreatorID: userID,
+		Terms:     terms,
+		Limit:     &limitPlusOne,
+		Offset:    &offset,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to search sessions: %v", err)
+	}
+
+	nextPageToken := ""
+	if len(matches) == limitPlusOne {
+		matches = matches[:limit]
+		nextPageToken, err = getPageToken(limit, offset+limit)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
+		}
+	}
+	response := &v1pb.SearchAISessionsResponse{
+		Results:       make([]*v1pb.SearchAISessionsResponse_Result, 0, len(matches)),
+		NextPageToken: nextPageToken,
+	}
+	for _, match := range matches {
+		sess := &store.AIChatSession{UID: match.SessionUID, CreatorID: userID}
+		text := match.Content
+		// A session matches on its title when its summary has none of the terms.
+		if match.MessageID == 0 && len(highlightTerms(text, terms)) == 0 {
+			text = match.SessionTitle
+		}
+		snippet := keywordSnippet(text, terms)
+		result := &v1pb.SearchAISessionsResponse_Result{
+			AiSession:  constructAISessionName(sess),
+			Title:      match.SessionTitle,
+			Message:    constructAIMessageName(sess, match.MessageID),
+			Role:       convertAIMessageRoleFromStore(match.Role),
+			Snippet:    snippet,
+			CreateTime: timestamppb.New(time.Unix(match.CreatedTs, 0)),
+		}
+		for _, h := range highlightTerms(snippet, terms) {
+			result.Highlights = append(result.Highlights, &v1pb.SearchAISessionsResponse_Highlight{
+				Start: int32(h[0]),
+				End:   int32(h[1]),
+			})
+		}
+		response.Results = append(response.Results, result)
+	}
+	return response, nil
+}
+
+// highlightTerms returns the ranges of text, in runes, where any of the
+// lowercase terms occur, ignoring case. Overlapping ranges are merged.
+func highlightTerms(text string, terms []string) [][2]int {
+	// Lowercasing maps rune by rune, so offsets into lower match text.
+	lower := []rune(strings.ToLower(text))
+	var ranges [][2]int
+	for _, term := range terms {
+		t := []rune(term)
+		for i := 0; i+len(t) <= len(lower); i++ {
+			if string(lower[i:i+len(t)]) == term {
+				ranges = append(ranges, [2]int{i, i + len(t)})
+			}
+		}
+	}
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i][0] < ranges[j][0] })
+	merged := ranges[:0]
+	for _, r := range ranges {
+		if n := len(merged); n > 0 && r[0] <= merged[n-1][1] {
+			merged[n-1][1] = max(merged[n-1][1], r[1])
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
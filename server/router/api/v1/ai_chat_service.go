@@ -1,8 +1,6 @@
 package v1
 
 import (
-	"bufio"
-	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -11,17 +9,28 @@ import (
 	"log/slog"
 	"net/http"
 	"net/url"
+	"runtime/debug"
+	"slices"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
-	"github.com/labstack/echo/v5"
-	"github.com/lithammer/shortuuid/v4"
 	"github.com/tmc/langchaingo/tools"
 	"github.com/tmc/langchaingo/tools/duckduckgo"
-
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/util"
+	"github.com/usememos/memos/plugin/httpgetter"
+	"github.com/usememos/memos/plugin/llm"
 	"github.com/usememos/memos/plugin/vectorstore"
-	"github.com/usememos/memos/server/auth"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
 )
 
@@ -30,417 +39,672 @@ import (
 // ─────────────────────────────────────────────────────────────────────────────
 
 const (
-	// compactThreshold is the total character count of messages that triggers compaction.
-	// Roughly 80% of a 128k-token context window (4 chars ≈ 1 token).
-	compactThreshold = 400_000
-
-	// keepRecentMessages is the number of recent messages to keep verbatim after compaction.
-	keepRecentMessages = 10
-
-	// maxAgentRounds caps the number of tool-use iterations per request.
+	// maxAgentRounds caps the number of tool-use iterations per request,
+	// unless the instance AI setting sets its own cap.
 	maxAgentRounds = 12
+	// maxAgentRoundsLimit is the highest cap the instance AI setting may set.
+	maxAgentRoundsLimit = 50
+
+	// defaultAISessionTitle is the title of a session until it is auto-titled.
+	defaultAISessionTitle = "New Chat"
 )
 
 // ─────────────────────────────────────────────────────────────────────────────
-// Request / Response types
+// Resource names
 // ─────────────────────────────────────────────────────────────────────────────
 
-type chatRequest struct {
-	Content   string `json:"content"`   // user message text
-	TagFilter string `json:"tagFilter"` // optional "#golang" etc.
-}
-
-type sessionRequest struct {
-	Title string `json:"title"`
+// extractAISessionFromName returns the user ID and session UID of a session name.
+// Format: users/{user}/aiSessions/{ai_session}.
+func extractAISessionFromName(name string) (int32, string, error) {
+	tokens, err := GetNameParentTokens(name, UserNamePrefix, AISessionNamePrefix)
+	if err != nil {
+		return 0, "", err
+	}
+	userID, err := util.ConvertStringToInt32(tokens[0])
+	if err != nil {
+		return 0, "", fmt.Errorf("invalid user ID %q", tokens[0])
+	}
+	return userID, tokens[1], nil
 }
 
-type sessionResponse struct {
-	UID       string `json:"uid"`
-	Title     string `json:"title"`
-	CreatedTs int64  `json:"createdTs"`
-	UpdatedTs int64  `json:"updatedTs"`
+// extractAIMessageFromName returns the session name and message ID of a message name.
+// Format: users/{user}/aiSessions/{ai_session}/messages/{message}.
+func extractAIMessageFromName(name string) (string, int32, error) {
+	tokens, err := GetNameParentTokens(name, UserNamePrefix, AISessionNamePrefix, AIMessageNamePrefix)
+	if err != nil {
+		return "", 0, err
+	}
+	id, err := util.ConvertStringToInt32(tokens[2])
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid message ID %q", tokens[2])
+	}
+	return fmt.Sprintf("%s%s/%s%s", UserNamePrefix, tokens[0], AISessionNamePrefix, tokens[1]), id, nil
 }
 
-type messageResponse struct {
-	ID        int32  `json:"id"`
-	Role      string `json:"role"`
-	Content   string `json:"content"`
-	ToolName  string `json:"toolName,omitempty"`
-	CreatedTs int64  `json:"createdTs"`
+func constructAISessionName(sess *store.AIChatSession) string {
+	return fmt.Sprintf("%s%d/%s%s", UserNamePrefix, sess.CreatorID, AISessionNamePrefix, sess.UID)
 }
 
-// ─────────────────────────────────────────────────────────────────────────────
-// Route registration (called from v1.go)
-// ─────────────────────────────────────────────────────────────────────────────
-
-func (s *APIV1Service) registerAIChatRoutes(e *echo.Echo) {
-	g := e.Group("/api/v1/ai")
-	g.GET("/sessions", s.listAIChatSessions)
-	g.POST("/sessions", s.createAIChatSession)
-	g.PATCH("/sessions/:uid", s.updateAIChatSession)
-	g.DELETE("/sessions/:uid", s.deleteAIChatSession)
-	g.GET("/sessions/:uid/messages", s.listAIChatMessages)
-	g.POST("/sessions/:uid/chat", s.handleAIChat)
-	g.POST("/completions/stream", s.handleAICompletionsStream)
+// constructAIMessageName returns the name of a message; 0 names no message.
+func constructAIMessageName(sess *store.AIChatSession, id int32) string {
+	if id == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%s/%s%d", constructAISessionName(sess), AIMessageNamePrefix, id)
 }
 
 // ─────────────────────────────────────────────────────────────────────────────
-// Session CRUD
+// Sessions
 // ─────────────────────────────────────────────────────────────────────────────
 
-func (s *APIV1Service) listAIChatSessions(c *echo.Context) error {
-	user, err := s.requireAuth(c)
+func (s *APIV1Service) ListAISessions(ctx context.Context, request *v1pb.ListAISessionsRequest) (*v1pb.ListAISessionsResponse, error) {
+	userID, err := ExtractUserIDFromName(request.Parent)
 	if err != nil {
-		return err
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	currentUser, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
 	}
-	sessions, err := s.Store.ListAIChatSessions(c.Request().Context(), &store.FindAIChatSession{
-		CreatorID: &user.ID,
+	if currentUser == nil || currentUser.ID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	var limit, offset int
+	if request.PageToken != "" {
+		var pageToken v1pb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		limit = int(pageToken.Limit)
+		offset = int(pageToken.Offset)
+	} else {
+		limit = int(request.PageSize)
+	}
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	if limit > MaxPageSize {
+		limit = MaxPageSize
+	}
+	limitPlusOne := limit + 1
+	sessions, err := s.Store.ListAIChatSessions(ctx, &store.FindAIChatSession{
+		CreatorID: &userID,
+		Limit:     &limitPlusOne,
+		Offset:    &offset,
 	})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return nil, status.Errorf(codes.Internal, "failed to list sessions: %v", err)
+	}
+
+	nextPageToken := ""
+	if len(sessions) == limitPlusOne {
+		sessions = sessions[:limit]
+		nextPageToken, err = getPageToken(limit, offset+limit)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
+		}
+	}
+	response := &v1pb.ListAISessionsResponse{
+		AiSessions:    make([]*v1pb.AISession, 0, len(sessions)),
+		NextPageToken: nextPageToken,
 	}
-	resp := make([]sessionResponse, 0, len(sessions))
 	for _, sess := range sessions {
-		resp = append(resp, sessionResponse{
-			UID:       sess.UID,
-			Title:     sess.Title,
-			CreatedTs: sess.CreatedTs,
-			UpdatedTs: sess.UpdatedTs,
-		})
+		response.AiSessions = append(response.AiSessions, convertAISessionFromStore(sess))
 	}
-	return c.JSON(http.StatusOK, resp)
+	return response, nil
 }
 
-func (s *APIV1Service) createAIChatSession(c *echo.Context) error {
-	user, err := s.requireAuth(c)
+func (s *APIV1Service) GetAISession(ctx context.Context, request *v1pb.GetAISessionRequest) (*v1pb.AISession, error) {
+	_, sess, err := s.getAISession(ctx, request.Name)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	aiSession := convertAISessionFromStore(sess)
+	aiSession.RunningTurn = s.chatJobs.running(sess.ID)
+	return aiSession, nil
+}
+
+func (s *APIV1Service) CreateAISession(ctx context.Context, request *v1pb.CreateAISessionRequest) (*v1pb.AISession, error) {
+	userID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	currentUser, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
 	}
-	var req sessionRequest
-	if err := c.Bind(&req); err != nil {
-		req.Title = "New Chat"
+	if currentUser == nil || currentUser.ID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
-	if req.Title == "" {
-		req.Title = "New Chat"
+
+	title := strings.TrimSpace(request.GetAiSession().GetTitle())
+	if title == "" {
+		title = defaultAISessionTitle
 	}
-	sess, err := s.Store.CreateAIChatSession(c.Request().Context(), &store.AIChatSession{
+	sess, err := s.Store.CreateAIChatSession(ctx, &store.AIChatSession{
 		UID:       uuid.New().String()[:8],
-		CreatorID: user.ID,
-		Title:     req.Title,
+		CreatorID: currentUser.ID,
+		Title:     title,
 	})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return nil, status.Errorf(codes.Internal, "failed to create session: %v", err)
 	}
-	return c.JSON(http.StatusCreated, sessionResponse{
-		UID:       sess.UID,
-		Title:     sess.Title,
-		CreatedTs: sess.CreatedTs,
-		UpdatedTs: sess.UpdatedTs,
-	})
+	return convertAISessionFromStore(sess), nil
 }
 
-func (s *APIV1Service) updateAIChatSession(c *echo.Context) error {
-	uid := c.Param("uid")
-	user, err := s.requireAuth(c)
-	if err != nil {
-		return err
+func (s *APIV1Service) UpdateAISession(ctx context.Context, request *v1pb.UpdateAISessionRequest) (*v1pb.AISession, error) {
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask is required")
 	}
-	// verify ownership
-	sess, err := s.Store.GetAIChatSession(c.Request().Context(), &store.FindAIChatSession{UID: &uid})
-	if err != nil || sess == nil || sess.CreatorID != user.ID {
-		return echo.NewHTTPError(http.StatusNotFound, "session not found")
+	_, sess, err := s.getAISession(ctx, request.GetAiSession().GetName())
+	if err != nil {
+		return nil, err
 	}
 
-	var req sessionRequest
-	if err := c.Bind(&req); err != nil || req.Title == "" {
-		return echo.NewHTTPError(http.StatusBadRequest, "title required")
+	update := &store.UpdateAIChatSession{UID: sess.UID}
+	for _, field := range request.UpdateMask.Paths {
+		switch field {
+		case "title":
+			title := strings.TrimSpace(request.AiSession.Title)
+			if title == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "title is required")
+			}
+			update.Title = &title
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported field in update mask: %s", field)
+		}
 	}
-	updated, err := s.Store.UpdateAIChatSession(c.Request().Context(), &store.UpdateAIChatSession{
-		UID:   uid,
-		Title: &req.Title,
-	})
+	updated, err := s.Store.UpdateAIChatSession(ctx, update)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return nil, status.Errorf(codes.Internal, "failed to update session: %v", err)
 	}
-	return c.JSON(http.StatusOK, sessionResponse{
-		UID:       updated.UID,
-		Title:     updated.Title,
-		UpdatedTs: updated.UpdatedTs,
-	})
+	return convertAISessionFromStore(updated), nil
 }
 
-func (s *APIV1Service) deleteAIChatSession(c *echo.Context) error {
-	uid := c.Param("uid")
-	user, err := s.requireAuth(c)
+func (s *APIV1Service) DeleteAISession(ctx context.Context, request *v1pb.DeleteAISessionRequest) (*emptypb.Empty, error) {
+	_, sess, err := s.getAISession(ctx, request.Name)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if err := s.Store.DeleteAIChatSession(ctx, sess.UID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete session: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ListAIMessages pages through the active branch of a session, which is
+// small enough to assemble in full for every page.
+func (s *APIV1Service) ListAIMessages(ctx context.Context, request *v1pb.ListAIMessagesRequest) (*v1pb.ListAIMessagesResponse, error) {
+	_, sess, msgs, err := s.loadAIChatSession(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+
+	var limit, offset int
+	if request.PageToken != "" {
+		var pageToken v1pb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		limit = int(pageToken.Limit)
+		offset = int(pageToken.Offset)
+	} else {
+		limit = int(request.PageSize)
 	}
-	sess, err := s.Store.GetAIChatSession(c.Request().Context(), &store.FindAIChatSession{UID: &uid})
-	if err != nil || sess == nil || sess.CreatorID != user.ID {
-		return echo.NewHTTPError(http.StatusNotFound, "session not found")
+	if limit <= 0 {
+		limit = DefaultPageSize
 	}
-	if err := s.Store.DeleteAIChatSession(c.Request().Context(), uid); err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	if limit > MaxPageSize {
+		limit = MaxPageSize
 	}
-	return c.NoContent(http.StatusNoContent)
+
+	branch := convertActiveBranch(sess, msgs)
+	nextPageToken := ""
+	if offset+limit < len(branch) {
+		nextPageToken, err = getPageToken(limit, offset+limit)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
+		}
+	}
+	return &v1pb.ListAIMessagesResponse{
+		AiMessages:    branch[min(offset, len(branch)):min(offset+limit, len(branch))],
+		NextPageToken: nextPageToken,
+	}, nil
 }
 
-func (s *APIV1Service) listAIChatMessages(c *echo.Context) error {
-	uid := c.Param("uid")
-	user, err := s.requireAuth(c)
+// getAISession returns the current user and their session of the given name.
+func (s *APIV1Service) getAISession(ctx context.Context, name string) (*store.User, *store.AIChatSession, error) {
+	userID, uid, err := extractAISessionFromName(name)
 	if err != nil {
-		return err
+		return nil, nil, status.Errorf(codes.InvalidArgument, "invalid session name: %v", err)
+	}
+	currentUser, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
 	}
-	sess, err := s.Store.GetAIChatSession(c.Request().Context(), &store.FindAIChatSession{UID: &uid})
-	if err != nil || sess == nil || sess.CreatorID != user.ID {
-		return echo.NewHTTPError(http.StatusNotFound, "session not found")
+	if currentUser == nil {
+		return nil, nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
 	}
-	msgs, err := s.Store.ListAIChatMessages(c.Request().Context(), &store.FindAIChatMessage{
-		SessionID: sess.ID,
-	})
+	if currentUser.ID != userID {
+		return nil, nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	sess, err := s.Store.GetAIChatSession(ctx, &store.FindAIChatSession{UID: &uid})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
-	}
-	resp := make([]messageResponse, 0, len(msgs))
-	for _, m := range msgs {
-		resp = append(resp, messageResponse{
-			ID:        m.ID,
-			Role:      m.Role,
-			Content:   m.Content,
-			ToolName:  m.ToolName,
-			CreatedTs: m.CreatedTs,
-		})
+		return nil, nil, status.Errorf(codes.Internal, "failed to get session: %v", err)
+	}
+	if sess == nil || sess.CreatorID != currentUser.ID {
+		return nil, nil, status.Errorf(codes.NotFound, "session not found")
 	}
-	return c.JSON(http.StatusOK, resp)
+	return currentUser, sess, nil
 }
 
-// ─────────────────────────────────────────────────────────────────────────────
-// Main chat handler (SSE)
-// ─────────────────────────────────────────────────────────────────────────────
-
-func (s *APIV1Service) handleAIChat(c *echo.Context) error {
-	if s.Profile.OpenRouterAPIKey == "" {
-		return echo.NewHTTPError(http.StatusServiceUnavailable, "AI chat is not configured (missing OPENROUTER_API_KEY)")
+// loadAIChatSession returns the current user and their session of the given
+// name along with all of its messages.
+func (s *APIV1Service) loadAIChatSession(ctx context.Context, name string) (*store.User, *store.AIChatSession, []*store.AIChatMessage, error) {
+	user, sess, err := s.getAISession(ctx, name)
+	if err != nil {
+		return nil, nil, nil, err
 	}
-
-	uid := c.Param("uid")
-	user, err := s.requireAuth(c)
+	msgs, err := s.Store.ListAIChatMessages(ctx, &store.FindAIChatMessage{SessionID: sess.ID})
 	if err != nil {
-		return err
+		return nil, nil, nil, status.Errorf(codes.Internal, "failed to list messages: %v", err)
 	}
+	return user, sess, msgs, nil
+}
 
-	var req chatRequest
-	if err := c.Bind(&req); err != nil || strings.TrimSpace(req.Content) == "" {
-		return echo.NewHTTPError(http.StatusBadRequest, "content required")
+func convertAISessionFromStore(sess *store.AIChatSession) *v1pb.AISession {
+	aiSession := &v1pb.AISession{
+		Name:       constructAISessionName(sess),
+		Title:      sess.Title,
+		CreateTime: timestamppb.New(time.Unix(sess.CreatedTs, 0)),
+		UpdateTime: timestamppb.New(time.Unix(sess.UpdatedTs, 0)),
+	}
+	for _, action := range decodePendingActions(sess.PendingActions) {
+		aiSession.PendingActions = append(aiSession.PendingActions, convertPendingAction(sess, action))
 	}
+	return aiSession
+}
 
-	ctx := c.Request().Context()
+// ─────────────────────────────────────────────────────────────────────────────
+// Main chat handler
+// ─────────────────────────────────────────────────────────────────────────────
 
-	// ── 1. Load session ──────────────────────────────────────────────────────
-	sess, err := s.Store.GetAIChatSession(ctx, &store.FindAIChatSession{UID: &uid})
-	if err != nil || sess == nil || sess.CreatorID != user.ID {
-		return echo.NewHTTPError(http.StatusNotFound, "session not found")
+func (s *APIV1Service) Chat(request *v1pb.ChatRequest, stream grpc.ServerStreamingServer[v1pb.AIChatEvent]) error {
+	if s.LLM == nil {
+		return status.Errorf(codes.FailedPrecondition, "AI chat is not configured (missing AI_PROVIDER)")
+	}
+	if strings.TrimSpace(request.Content) == "" {
+		return status.Errorf(codes.InvalidArgument, "content is required")
 	}
+	ctx := stream.Context()
 
-	// ── 2. Load history from DB ───────────────────────────────────────────────
-	dbMsgs, err := s.Store.ListAIChatMessages(ctx, &store.FindAIChatMessage{SessionID: sess.ID})
+	// ── 1-2. Load session and history ────────────────────────────────────────
+	user, sess, dbMsgs, err := s.loadAIChatSession(ctx, request.Name)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return err
 	}
+	parentID := activeMessageID(sess, dbMsgs)
+	if request.ParentMessage != nil {
+		parentID = 0
+		if *request.ParentMessage != "" {
+			sessionName, id, err := extractAIMessageFromName(*request.ParentMessage)
+			if err != nil || sessionName != request.Name || findMessage(dbMsgs, id) == nil {
+				return status.Errorf(codes.InvalidArgument, "parent message not found")
+			}
+			parentID = id
+		}
+	}
+
+	return s.streamAIChatTurn(ctx, stream, user, sess, dbMsgs, &chatTurn{
+		parentID:  parentID,
+		content:   request.Content,
+		tagFilter: request.TagFilter,
+		model:     request.Model,
+	})
+}
+
+// chatTurn is one user message and the agent's reply to it.
+type chatTurn struct {
+	// parentID is the message the turn follows on its branch.
+	parentID  int32
+	content   string
+	tagFilter string
+	// model is the chat model the user asked for, if any.
+	model string
+	// regenerate answers the existing user message parentID again instead of
+	// adding a new one.
+	regenerate bool
+}
 
-	// ── 3. Context compaction ─────────────────────────────────────────────────
-	dbMsgs, sess, err = s.maybeCompact(ctx, sess, dbMsgs, user.ID)
+// streamAIChatTurn runs the agent for a turn and streams its reply.
+// Everything the turn persists forms a new branch from turn.parentID, which
+// becomes the session's active branch.
+func (s *APIV1Service) streamAIChatTurn(ctx context.Context, stream grpc.ServerStreamingServer[v1pb.AIChatEvent], user *store.User, sess *store.AIChatSession, dbMsgs []*store.AIChatMessage, turn *chatTurn) error {
+	// ── 3. Set up the agent ──────────────────────────────────────────────────
+	agent, err := s.newChatAgent(ctx, user, sess, turn.content, turn.tagFilter, turn.model, turn.parentID)
 	if err != nil {
-		slog.Warn("context compaction failed", "err", err)
+		return err
 	}
+	return agent.runJob(stream, func() { agent.runTurn(dbMsgs, turn) })
+}
 
-	// ── 4. Set up SSE ─────────────────────────────────────────────────────────
-	rw := c.Response()
-	rw.Header().Set("Content-Type", "text/event-stream")
-	rw.Header().Set("Cache-Control", "no-cache")
-	rw.Header().Set("Connection", "keep-alive")
-	rw.Header().Set("X-Accel-Buffering", "no")
-	rw.WriteHeader(http.StatusOK)
-
-	emit := func(eventType, payload string) {
-		data, _ := json.Marshal(map[string]string{"type": eventType, "content": payload})
-		fmt.Fprintf(rw, "data: %s\n\n", data)
-		if f, ok := rw.(http.Flusher); ok {
-			f.Flush()
-		}
+// runTurn persists the user message of the turn and runs the agent loop.
+func (a *chatAgent) runTurn(dbMsgs []*store.AIChatMessage, turn *chatTurn) {
+	// ── 4. Context compaction ─────────────────────────────────────────────────
+	branch := branchPath(dbMsgs, turn.parentID)
+	history := branch
+	if turn.regenerate {
+		// The user message being answered again must stay verbatim.
+		history = branch[:len(branch)-1]
 	}
-	emitJSON := func(eventType string, obj any) {
-		inner, _ := json.Marshal(obj)
-		data, _ := json.Marshal(map[string]json.RawMessage{
-			"type":    json.RawMessage(`"` + eventType + `"`),
-			"payload": inner,
-		})
-		fmt.Fprintf(rw, "data: %s\n\n", data)
-		if f, ok := rw.(http.Flusher); ok {
-			f.Flush()
-		}
+	if err := a.compact(history, turn.content); err != nil {
+		slog.Warn("context compaction failed", "err", err)
 	}
 
 	// ── 5. Persist user message ───────────────────────────────────────────────
-	if _, err := s.Store.CreateAIChatMessage(ctx, &store.CreateAIChatMessage{
-		SessionID:  sess.ID,
-		Role:       "user",
-		Content:    req.Content,
-		TokenCount: int32(len(req.Content) / 4),
-	}); err != nil {
-		slog.Warn("failed to persist user message", "err", err)
+	if !turn.regenerate {
+		a.persist(llm.Message{Role: "user", Content: turn.content}, "", estimateTokens(turn.content))
 	}
 
 	// ── 6. Auto-title on first message ───────────────────────────────────────
-	if len(dbMsgs) == 0 && sess.Title == "New Chat" {
-		go s.autoTitleSession(context.Background(), sess.UID, req.Content)
+	if len(dbMsgs) == 0 && a.sess.Title == defaultAISessionTitle {
+		go a.s.autoTitleSession(context.Background(), a.user, a.sess.UID, turn.content)
 	}
 
-	// ── 7-11. Native OpenRouter function-calling agent loop ───────────────────
-	// We bypass langchaingo's brittle text-based ReAct agent and call OpenRouter
-	// directly using the OpenAI-compatible `tools` API, which is reliable on any
+	// ── 7-13. Native function-calling agent loop ──────────────────────────────
+	// The turn replaces any actions still awaiting approval; their calls go
+	// unanswered and are left out of the history.
+	a.loadHistory(branch)
+	if !turn.regenerate {
+		a.messages = append(a.messages, llm.Message{Role: "user", Content: turn.content})
+	}
+	a.run()
+}
+
+// chatAgent runs the tool-calling loop of a chat turn and streams its events.
+type chatAgent struct {
+	s *APIV1Service
+	// ctx outlives the request, so a turn carries on when the client goes
+	// away; the job's context is cancelled when the user stops the turn.
+	ctx  context.Context
+	user *store.User
+	sess *store.AIChatSession
+	job  *chatJob
+	// query is the user message being answered; it also selects the cited sources.
+	query     string
+	tagFilter string
+	// parentID is the last message persisted on the turn's branch; everything
+	// the agent persists follows it.
+	parentID int32
+	// model answers the turn; preamble opens the system prompt and maxRounds
+	// caps the tool-use rounds, as the instance AI setting allows.
+	model     string
+	preamble  string
+	maxRounds int
+	// memories are what the assistant remembers about the user that is most
+	// relevant to the query.
+	memories []*store.AIMemory
+	// We bypass langchaingo's brittle text-based ReAct agent and call the LLM
+	// provider directly using its native `tools` API, which is reliable on any
 	// function-capable model.
+	tools map[string]tools.Tool
+	// toolDefs describe tools to the model, including those of MCP servers.
+	toolDefs []llm.Tool
+	// confirmTools are the tools that wait for the user's approval.
+	confirmTools map[string]bool
+	messages     []llm.Message
+}
 
-	// Build our tool registry (same tools as before, but now dispatched natively)
-	toolRegistry := map[string]tools.Tool{
-		"search_internet":   &ddgToolAdapter{},
-		"scrape_url":        &scraperToolAdapter{},
-		"search_memos":      newSearchMemosTool(s.VectorStore, user.ID, req.TagFilter),
-		"query_memos":       newQueryMemosTool(s.Store, user.ID),
-		"create_memo":       newCreateMemoTool(s.Store, user.ID),
-		"append_to_memo":    newAppendToMemoTool(s.Store, user.ID),
-		"update_memo":       newUpdateMemoTool(s.Store, user.ID),
-		"update_memo_tags":  newUpdateMemoTagsTool(s.Store, user.ID),
-		"delete_memo":       newDeleteMemoTool(s.Store, user.ID),
-		"get_user_stats":    newGetUserStatsTool(s.Store, user.ID),
-		"list_memos_by_tag": newListMemosByTagTool(s.Store, user.ID),
-	}
-
-	// Tool schema definitions sent to the LLM
-	toolDefs := []map[string]any{
-		buildToolDef("search_internet", "Search the internet for current events, facts, or information using DuckDuckGo.", map[string]any{
-			"query": map[string]any{"type": "string", "description": "The internet search query"},
-		}, []string{"query"}),
-		buildToolDef("scrape_url", "Scrape text content from a specific web URL.", map[string]any{
-			"url": map[string]any{"type": "string", "description": "The exact URL to scrape"},
-		}, []string{"url"}),
-		buildToolDef("search_memos", "Search the user's notes semantically for a concept or topic. Use for general/conceptual questions.", map[string]any{
-			"query": map[string]any{"type": "string", "description": "The search query"},
-		}, []string{"query"}),
-		buildToolDef("query_memos", "Search the user's notes by exact date range or keyword. ALWAYS use this for date-specific questions like 'what did I post on Jan 26'.", map[string]any{
-			"text_search": map[string]any{"type": "string", "description": "Exact keyword to search (optional)"},
-			"date_start":  map[string]any{"type": "string", "description": "Start date in YYYY-MM-DD (optional)"},
-			"date_end":    map[string]any{"type": "string", "description": "End date in YYYY-MM-DD (optional)"},
-		}, []string{}),
-		buildToolDef("create_memo", "Create a new note for the user.", map[string]any{
-			"content": map[string]any{"type": "string", "description": "The content of the new note"},
-		}, []string{"content"}),
-		buildToolDef("append_to_memo", "Append text to an existing note without overwriting it.", map[string]any{
-			"uid":     map[string]any{"type": "string", "description": "Note UID"},
-			"content": map[string]any{"type": "string", "description": "Text to append"},
-		}, []string{"uid", "content"}),
-		buildToolDef("update_memo", "Fully rewrite the content of an existing note.", map[string]any{
-			"uid":     map[string]any{"type": "string", "description": "Note UID"},
-			"content": map[string]any{"type": "string", "description": "New content"},
-		}, []string{"uid", "content"}),
-		buildToolDef("update_memo_tags", "Add hashtags to an existing note.", map[string]any{
-			"uid":      map[string]any{"type": "string", "description": "Note UID"},
-			"new_tags": map[string]any{"type": "array", "items": map[string]any{"type": "string"}, "description": "Tags to add, e.g. ['#dev','#work']"},
-		}, []string{"uid", "new_tags"}),
-		buildToolDef("delete_memo", "Permanently delete a note.", map[string]any{
-			"uid": map[string]any{"type": "string", "description": "Note UID"},
-		}, []string{"uid"}),
-		buildToolDef("get_user_stats", "Get note statistics (total count, etc). No parameters needed.", map[string]any{}, []string{}),
-		buildToolDef("list_memos_by_tag", "List all notes tagged with a specific hashtag.", map[string]any{
-			"tag": map[string]any{"type": "string", "description": "Tag including hash, e.g. '#work'"},
-		}, []string{"tag"}),
+// newChatAgent checks the user's quota and sets up their tools, leaving out
+// those the instance AI setting disables, then starts the turn's job.
+func (s *APIV1Service) newChatAgent(ctx context.Context, user *store.User, sess *store.AIChatSession, query, tagFilter, model string, parentID int32) (*chatAgent, error) {
+	if err := s.checkAIQuotaStatus(ctx, user); err != nil {
+		return nil, err
 	}
-
-	// Build message history
-	systemText := buildSystemPrompt(sess.Summary, time.Now())
-	messages := []map[string]any{
-		{"role": "system", "content": systemText},
+	aiSetting, err := s.getUserAISetting(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	instanceAISetting, err := s.Store.GetInstanceAISetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance AI setting: %v", err)
 	}
-	for _, m := range dbMsgs {
-		if m.Role == "user" || m.Role == "assistant" {
-			messages = append(messages, map[string]any{"role": m.Role, "content": m.Content})
+	model, err = resolveChatModel(instanceAISetting, model, s.LLM.Model())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	mcpTools, mcpToolDefs := s.loadMCPTools(ctx, aiSetting)
+
+	a := &chatAgent{
+		s:         s,
+		ctx:       context.WithoutCancel(ctx),
+		user:      user,
+		sess:      sess,
+		query:     query,
+		tagFilter: tagFilter,
+		parentID:  parentID,
+		model:     model,
+		preamble:  instanceAISetting.SystemPromptPreamble,
+		maxRounds: maxAgentRounds,
+		tools: map[string]tools.Tool{
+			"search_internet":   &ddgToolAdapter{},
+			"scrape_url":        &scraperToolAdapter{},
+			"search_memos":      newSearchMemosTool(s, user.ID, tagFilter),
+			"query_memos":       newQueryMemosTool(s.Store, user.ID),
+			"create_memo":       newCreateMemoTool(s),
+			"append_to_memo":    newAppendToMemoTool(s, user.ID),
+			"update_memo":       newUpdateMemoTool(s, user.ID),
+			"update_memo_tags":  newUpdateMemoTagsTool(s, user.ID),
+			"delete_memo":       newDeleteMemoTool(s, user.ID),
+			"get_user_stats":    newGetUserStatsTool(s.Store, user.ID),
+			"list_memos_by_tag": newListMemosByTagTool(s.Store, user.ID),
+			"remember":          newRememberTool(s.Store, user.ID),
+			"forget":            newForgetTool(s.Store, user.ID),
+		},
+		toolDefs:     append(chatToolDefs(), mcpToolDefs...),
+		confirmTools: make(map[string]bool, len(aiSetting.ConfirmTools)),
+	}
+	for name, tool := range mcpTools {
+		a.tools[name] = tool
+	}
+	switch instanceAISetting.WebAccess {
+	case storepb.InstanceAISetting_READER:
+		a.tools["scrape_url"] = &scraperToolAdapter{readerURL: instanceAISetting.ReaderUrl}
+	case storepb.InstanceAISetting_DISABLED:
+		delete(a.tools, "search_internet")
+		delete(a.tools, "scrape_url")
+	}
+	for _, name := range instanceAISetting.DisabledTools {
+		delete(a.tools, name)
+	}
+	a.toolDefs = slices.DeleteFunc(a.toolDefs, func(def llm.Tool) bool {
+		_, ok := a.tools[def.Function.Name]
+		return !ok
+	})
+	if instanceAISetting.MaxAgentRounds > 0 {
+		a.maxRounds = int(instanceAISetting.MaxAgentRounds)
+	}
+	if a.hasMemoryTools() {
+		a.memories = s.loadAIMemories(ctx, user.ID, query)
+	}
+	for _, name := range aiSetting.ConfirmTools {
+		a.confirmTools[name] = true
+	}
+	if a.job, err = s.chatJobs.start(ctx, sess); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// resolveChatModel returns the model to answer with when the user asked for
+// requested, which may be empty. Without allowed models only the default
+// model is used; otherwise the default stands in for the first allowed model
+// only if it is allowed itself.
+func resolveChatModel(setting *storepb.InstanceAISetting, requested, defaultModel string) (string, error) {
+	allowed := setting.AllowedModels
+	if len(allowed) == 0 {
+		allowed = []string{defaultModel}
+	}
+	if requested == "" {
+		if slices.Contains(allowed, defaultModel) {
+			return defaultModel, nil
 		}
+		return allowed[0], nil
+	}
+	if !slices.Contains(allowed, requested) {
+		return "", fmt.Errorf("model %q is not allowed", requested)
 	}
-	messages = append(messages, map[string]any{"role": "user", "content": req.Content})
+	return requested, nil
+}
 
-	slog.Info("[AGENT INIT]", "model", s.Profile.AIModel, "tools", len(toolDefs))
-	slog.Info("[AGENT PROMPT]", "input", req.Content)
+// runJob runs work as the turn's job and streams the reply until the turn
+// ends or the client goes away, which leaves the turn running.
+func (a *chatAgent) runJob(stream grpc.ServerStreamingServer[v1pb.AIChatEvent], work func()) error {
+	go func() {
+		defer a.job.finish()
+		// The turn runs outside the request, so no interceptor recovers it.
+		defer func() {
+			if r := recover(); r != nil {
+				slog.Error("chat turn panicked", "turn", a.job.name, "panic", r, "stack", string(debug.Stack()))
+				a.emitError("internal error")
+			}
+		}()
+		work()
+	}()
+	return a.job.watch(stream.Context(), stream, 0)
+}
 
-	var finalAnswer string
+// emit adds an event to the reply, for every client watching the turn.
+func (a *chatAgent) emit(event *v1pb.AIChatEvent) {
+	a.job.send(event)
+}
 
-	for round := 0; round < maxAgentRounds; round++ {
-		// Call OpenRouter
-		reqBody := map[string]any{
-			"model":    s.Profile.AIModel,
-			"messages": messages,
-			"tools":    toolDefs,
-		}
-		bodyBytes, _ := json.Marshal(reqBody)
+func (a *chatAgent) emitToken(token string) {
+	a.emit(&v1pb.AIChatEvent{Event: &v1pb.AIChatEvent_Token{Token: token}})
+}
 
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost,
-			"https://openrouter.ai/api/v1/chat/completions",
-			bytes.NewReader(bodyBytes))
-		if err != nil {
-			emit("error", "failed to build request: "+err.Error())
-			break
-		}
-		httpReq.Header.Set("Authorization", "Bearer "+s.Profile.OpenRouterAPIKey)
-		httpReq.Header.Set("Content-Type", "application/json")
+func (a *chatAgent) emitError(message string) {
+	a.emit(&v1pb.AIChatEvent{Event: &v1pb.AIChatEvent_Error{Error: message}})
+}
 
-		resp, err := http.DefaultClient.Do(httpReq)
-		if err != nil {
-			emit("error", "LLM request failed: "+err.Error())
-			break
+// persist saves a message after the previous one on the turn's branch. Every
+// tool call and result is persisted as it happens, so later turns can reuse
+// earlier results and the agent's actions can be audited.
+func (a *chatAgent) persist(m llm.Message, toolName string, tokenCount int32) {
+	created, err := a.s.Store.CreateAIChatMessage(a.ctx, &store.CreateAIChatMessage{
+		SessionID:  a.sess.ID,
+		ParentID:   a.parentID,
+		Role:       m.Role,
+		Content:    m.Content,
+		ToolName:   toolName,
+		ToolCallID: m.ToolCallID,
+		ToolCalls:  encodeToolCalls(m.ToolCalls),
+		TokenCount: tokenCount,
+	})
+	if err != nil {
+		slog.Warn("failed to persist chat message", "role", m.Role, "err", err)
+		return
+	}
+	a.parentID = created.ID
+}
+
+// loadHistory starts the model's context with the system prompt and branch.
+// Messages covered by the session summary are replaced by the summary.
+func (a *chatAgent) loadHistory(branch []*store.AIChatMessage) {
+	summary, live := liveHistory(a.sess, branch)
+	a.messages = []llm.Message{
+		{Role: "system", Content: a.systemPrompt(summary)},
+	}
+	a.messages = append(a.messages, replayHistory(live)...)
+}
+
+// systemPrompt builds the system prompt of the turn, with the internet rules
+// only when the agent may go online and the memory rules only when it may
+// remember.
+func (a *chatAgent) systemPrompt(summary string) string {
+	_, search := a.tools["search_internet"]
+	_, scrape := a.tools["scrape_url"]
+	return buildSystemPrompt(a.preamble, summary, a.memories, search || scrape, a.hasMemoryTools(), time.Now())
+}
+
+// hasMemoryTools reports whether the agent can remember or forget facts about
+// the user; without either, it is not told what it remembers.
+func (a *chatAgent) hasMemoryTools() bool {
+	_, remember := a.tools["remember"]
+	_, forget := a.tools["forget"]
+	return remember || forget
+}
+
+// run lets the model answer, calling tools as it asks for them, until it gives
+// a final answer or a tool call needs the user's approval.
+func (a *chatAgent) run() {
+	slog.Info("[AGENT INIT]", "model", a.model, "tools", len(a.toolDefs))
+	slog.Info("[AGENT PROMPT]", "input", a.query)
+
+	var finalAnswer string
+	var finalTokens int32
+
+	for round := 0; round < a.maxRounds; round++ {
+		if a.job.cancelled() {
+			a.stop("")
+			return
 		}
-		var apiResp struct {
-			Choices []struct {
-				Message struct {
-					Role      string `json:"role"`
-					Content   string `json:"content"`
-					ToolCalls []struct {
-						ID       string `json:"id"`
-						Type     string `json:"type"`
-						Function struct {
-							Name      string `json:"name"`
-							Arguments string `json:"arguments"`
-						} `json:"function"`
-					} `json:"tool_calls"`
-				} `json:"message"`
-			} `json:"choices"`
+		// Stream the round: content deltas go straight to the client while
+		// tool call fragments are assembled by the provider.
+		var streamed strings.Builder
+		resp, err := a.s.chatLLM(a.job.ctx, a.user, aiUsageChat, &llm.ChatRequest{
+			Messages: a.messages,
+			Tools:    a.toolDefs,
+			Model:    a.model,
+		}, func(delta string) {
+			streamed.WriteString(delta)
+			a.emitToken(delta)
+		})
+		if err != nil && a.job.cancelled() {
+			a.stop(streamed.String())
+			return
 		}
-		if err := json.NewDecoder(resp.Body).Decode(&apiResp); err != nil || len(apiResp.Choices) == 0 {
-			resp.Body.Close()
-			emit("error", "failed to decode LLM response")
+		if err != nil {
+			var quotaErr *quotaExceededError
+			if errors.As(err, &quotaErr) {
+				a.emitError(quotaErr.Error())
+			} else {
+				a.emitError("LLM request failed: " + err.Error())
+			}
 			break
 		}
-		resp.Body.Close()
-
-		msg := apiResp.Choices[0].Message
+		msg := resp.Message
 
 		// No tool calls → final text answer
 		if len(msg.ToolCalls) == 0 {
 			if msg.Content == "" {
 				slog.Warn("[AGENT EMPTY RESPONSE]", "round", round)
 				finalAnswer = "I'm sorry, I was unable to generate a response. The tool execution or website scrape might have failed or timed out."
+				a.emitToken(finalAnswer)
 			} else {
 				finalAnswer = msg.Content
+				finalTokens = int32(resp.Usage.CompletionTokens)
 			}
 			slog.Info("[AGENT FINISH]", "answer", finalAnswer)
 			break
 		}
 
+		// Text streamed before a tool call is a preamble ("Let me check…");
+		// separate it from whatever the next round streams.
+		if msg.Content != "" {
+			a.emitToken("\n\n")
+		}
+
 		// Append assistant's tool-call message to context
-		assistantMsg := map[string]any{
-			"role":       "assistant",
-			"content":    msg.Content,
-			"tool_calls": msg.ToolCalls,
+		toolCallMsg := llm.Message{
+			Role:      "assistant",
+			Content:   msg.Content,
+			ToolCalls: msg.ToolCalls,
 		}
-		messages = append(messages, assistantMsg)
+		a.messages = append(a.messages, toolCallMsg)
+		a.persist(toolCallMsg, "", int32(resp.Usage.CompletionTokens))
+		toolCallMsgID := a.parentID
 
 		// Execute each tool call and append results
 		// Deduplicate calls — some models repeat the same tool_call_id in one response
@@ -448,15 +712,22 @@ func (s *APIV1Service) handleAIChat(c *echo.Context) error {
 		// Some models emit the same logical call twice with different IDs.
 		seenCallIDs := make(map[string]bool)
 		seenFingerprints := make(map[string]bool)
+		var pending []*pendingAction
 		for _, tc := range msg.ToolCalls {
+			// Calls left unanswered are dropped from the history.
+			if a.job.cancelled() {
+				a.stop("")
+				return
+			}
 			toolName := tc.Function.Name
 			toolInput := tc.Function.Arguments
 
 			if seenCallIDs[tc.ID] {
 				slog.Warn("[AGENT DEDUP] skipping duplicate tool_call_id", "id", tc.ID)
-				messages = append(messages, map[string]any{
-					"role": "tool", "tool_call_id": tc.ID,
-					"content": "Duplicate call skipped.",
+				// The first call already answered this ID, so nothing is persisted.
+				a.messages = append(a.messages, llm.Message{
+					Role: "tool", ToolCallID: tc.ID,
+					Content: "Duplicate call skipped.",
 				})
 				continue
 			}
@@ -465,177 +736,203 @@ func (s *APIV1Service) handleAIChat(c *echo.Context) error {
 			fingerprint := toolName + "|" + toolInput
 			if seenFingerprints[fingerprint] {
 				slog.Warn("[AGENT DEDUP] skipping identical tool call", "tool", toolName)
-				messages = append(messages, map[string]any{
-					"role": "tool", "tool_call_id": tc.ID,
-					"content": "Duplicate call skipped — this exact tool+input was already executed this round.",
-				})
+				skipped := llm.Message{
+					Role: "tool", ToolCallID: tc.ID,
+					Content: "Duplicate call skipped — this exact tool+input was already executed this round.",
+				}
+				a.messages = append(a.messages, skipped)
+				a.persist(skipped, toolName, estimateTokens(skipped.Content))
 				continue
 			}
 			seenFingerprints[fingerprint] = true
 
-			slog.Info("[AGENT TOOL CALL]", "tool", toolName, "input", toolInput)
-			emitJSON("tool_call", map[string]string{"name": toolName, "input": toolInput})
-
-			var toolResult string
-			if t, ok := toolRegistry[toolName]; ok {
-				toolResult, err = t.Call(ctx, toolInput)
-				if err != nil {
-					toolResult = "Error: " + err.Error()
-				}
-			} else {
-				toolResult = "Unknown tool: " + toolName
+			if _, ok := a.tools[toolName]; ok && a.confirmTools[toolName] {
+				pending = append(pending, a.proposeAction(tc, toolCallMsgID))
+				continue
 			}
-			slog.Info("[AGENT TOOL RESULT]", "tool", toolName, "result", toolResult)
+			a.callTool(tc)
+		}
 
-			messages = append(messages, map[string]any{
-				"role":         "tool",
-				"tool_call_id": tc.ID,
-				"content":      toolResult,
-			})
+		// Pause until the user decides; ConfirmAIAction resumes the loop.
+		if len(pending) > 0 {
+			a.pause(pending)
+			return
 		}
 	}
 
 	if finalAnswer == "" {
-		slog.Warn("[AGENT MAX ROUNDS EXCEEDED]", "rounds", maxAgentRounds)
+		slog.Warn("[AGENT MAX ROUNDS EXCEEDED]", "rounds", a.maxRounds)
 		finalAnswer = "I'm sorry, I was unable to compile a final answer because my tools encountered too many errors or the limit for searching was reached."
+		a.emitToken(finalAnswer)
 	}
 	slog.Info("[AGENT RAW RESULT]", "answer", finalAnswer)
 
-	if finalAnswer != "" {
-		for _, word := range strings.Fields(finalAnswer) {
-			emit("token", word+" ")
-			time.Sleep(8 * time.Millisecond)
-		}
-	}
-
 	// ── 11. Persist assistant answer ──────────────────────────────────────────
 	if finalAnswer != "" {
-		if _, err := s.Store.CreateAIChatMessage(ctx, &store.CreateAIChatMessage{
-			SessionID:  sess.ID,
-			Role:       "assistant",
-			Content:    finalAnswer,
-			TokenCount: int32(len(finalAnswer) / 4),
-		}); err != nil {
-			slog.Warn("failed to persist assistant message", "err", err)
+		if finalTokens == 0 {
+			finalTokens = estimateTokens(finalAnswer)
 		}
+		a.persist(llm.Message{Role: "assistant", Content: finalAnswer}, "", finalTokens)
 	}
 
 	// ── 12. Emit source citations from vector search results ──────────────────
-	if s.VectorStore != nil {
-		sources, _ := s.VectorStore.SearchSimilar(ctx, user.ID, req.Content, 3)
+	// Each source cites the best-matching passage and its offsets in the memo,
+	// or in the attachment it was extracted from.
+	if a.s.VectorStore != nil {
+		filter := &vectorstore.Filter{Tags: parseTagFilter(a.tagFilter)}
+		sources, _ := a.s.VectorStore.SearchSimilar(a.ctx, a.user.ID, a.query, 3, filter)
 		for _, src := range sources {
-			emitJSON("source", map[string]any{
-				"memo_uid": src.MemoUID,
-				"snippet":  src.Content[:min(200, len(src.Content))],
-			})
+			source := &v1pb.AIChatEvent_Source{
+				Memo:    MemoNamePrefix + src.MemoUID,
+				Snippet: src.Content,
+				Start:   int32(src.Start),
+				End:     int32(src.End),
+			}
+			if src.Attachment != "" {
+				source.Attachment = AttachmentNamePrefix + src.Attachment
+				source.Filename = src.Filename
+			}
+			a.emit(&v1pb.AIChatEvent{Event: &v1pb.AIChatEvent_Source_{Source: source}})
 		}
 	}
 
-	// ── 13. Update session timestamp ──────────────────────────────────────────
-	empty := ""
-	_, _ = s.Store.UpdateAIChatSession(ctx, &store.UpdateAIChatSession{
-		UID:     uid,
-		Title:   nil,
-		Summary: &empty,
-	})
-
-	emit("done", uid)
-
-	return nil
+	// ── 13. Make the new branch active and update session timestamp ──────────
+	a.savePendingActions(nil)
 }
 
-// ─────────────────────────────────────────────────────────────────────────────
-// Context compaction
-// ─────────────────────────────────────────────────────────────────────────────
-
-// maybeCompact summarises older messages when the total character count exceeds
-// compactThreshold, keeping only the most recent keepRecentMessages verbatim.
-func (s *APIV1Service) maybeCompact(
-	ctx context.Context,
-	sess *store.AIChatSession,
-	msgs []*store.AIChatMessage,
-	userID int32,
-) ([]*store.AIChatMessage, *store.AIChatSession, error) {
-	if s.Profile.OpenRouterAPIKey == "" {
-		return msgs, sess, nil
-	}
-
-	total := 0
-	for _, m := range msgs {
-		total += len(m.Content)
-	}
-	if total <= compactThreshold {
-		return msgs, sess, nil
-	}
-
-	// Split: old = everything except last keepRecentMessages
-	cutAt := len(msgs) - keepRecentMessages
-	if cutAt <= 0 {
-		return msgs, sess, nil
+// stop ends a cancelled turn, keeping the answer streamed so far, if any.
+func (a *chatAgent) stop(partial string) {
+	slog.Info("[AGENT CANCELLED]", "turn", a.job.name)
+	if partial != "" {
+		a.persist(llm.Message{Role: "assistant", Content: partial}, "", estimateTokens(partial))
 	}
-	old := msgs[:cutAt]
-	recent := msgs[cutAt:]
+	a.savePendingActions(nil)
+	a.emit(&v1pb.AIChatEvent{Event: &v1pb.AIChatEvent_Cancelled{Cancelled: true}})
+}
 
-	// Build a prompt for the summarisation model
-	var sb strings.Builder
-	sb.WriteString("Summarise this conversation concisely, preserving key facts and decisions:\n\n")
-	for _, m := range old {
-		sb.WriteString(m.Role + ": " + m.Content + "\n")
+// callTool runs a tool call and records its result.
+func (a *chatAgent) callTool(tc llm.ToolCall) {
+	toolName := tc.Function.Name
+	toolInput := tc.Function.Arguments
+	slog.Info("[AGENT TOOL CALL]", "tool", toolName, "input", toolInput)
+	a.emit(&v1pb.AIChatEvent{Event: &v1pb.AIChatEvent_ToolCall{ToolCall: &v1pb.AIMessage_ToolCall{
+		Id:        tc.ID,
+		Name:      toolName,
+		Arguments: toolInput,
+	}}})
+
+	var toolResult string
+	if t, ok := a.tools[toolName]; ok {
+		var err error
+		toolResult, err = t.Call(a.ctx, toolInput)
+		if err != nil {
+			toolResult = "Error: " + err.Error()
+		}
+	} else {
+		toolResult = "Unknown tool: " + toolName
 	}
+	slog.Info("[AGENT TOOL RESULT]", "tool", toolName, "result", toolResult)
+	a.recordToolResult(tc, toolResult)
+}
 
-	// Call OpenRouter directly to summarize the old messages
-	summary, err := s.callLLM(ctx, sb.String())
-	if err != nil {
-		return msgs, sess, err
+func (a *chatAgent) recordToolResult(tc llm.ToolCall, result string) {
+	resultMsg := llm.Message{
+		Role:       "tool",
+		ToolCallID: tc.ID,
+		Content:    result,
 	}
+	a.messages = append(a.messages, resultMsg)
+	a.persist(resultMsg, tc.Function.Name, estimateTokens(result))
+}
 
-	// Persist summary & delete old messages
-	// Add existing summary as prefix
-	existingSummary := sess.Summary
-	fullSummary := summary
-	if existingSummary != "" {
-		fullSummary = existingSummary + "\n\n" + summary
+// pause saves the actions awaiting approval with the session and asks the
+// user to confirm each of them.
+func (a *chatAgent) pause(pending []*pendingAction) {
+	a.savePendingActions(pending)
+	for _, action := range pending {
+		a.emit(&v1pb.AIChatEvent{Event: &v1pb.AIChatEvent_ConfirmationRequired{
+			ConfirmationRequired: convertPendingAction(a.sess, action),
+		}})
 	}
+}
 
-	updatedSess, err := s.Store.UpdateAIChatSession(ctx, &store.UpdateAIChatSession{
-		UID:     sess.UID,
-		Summary: &fullSummary,
-	})
-	if err != nil {
-		return msgs, sess, err
+// savePendingActions replaces the session's pending actions and makes the
+// turn's branch active.
+func (a *chatAgent) savePendingActions(pending []*pendingAction) {
+	encoded := encodePendingActions(pending)
+	if _, err := a.s.Store.UpdateAIChatSession(a.ctx, &store.UpdateAIChatSession{
+		UID:             a.sess.UID,
+		ActiveMessageID: &a.parentID,
+		PendingActions:  &encoded,
+	}); err != nil {
+		slog.Warn("failed to update chat session", "err", err)
 	}
+}
 
-	// Delete only the compacted messages (the old ones) by deleting all and re-inserting recent
-	if err := s.Store.DeleteAIChatMessages(ctx, sess.ID); err != nil {
-		return msgs, sess, err
-	}
-	for _, m := range recent {
-		_, _ = s.Store.CreateAIChatMessage(ctx, &store.CreateAIChatMessage{
-			SessionID:  sess.ID,
-			Role:       m.Role,
-			Content:    m.Content,
-			ToolName:   m.ToolName,
-			TokenCount: m.TokenCount,
-		})
+// chatToolDefs returns the tool schema definitions sent to the LLM.
+func chatToolDefs() []llm.Tool {
+	return []llm.Tool{
+		buildToolDef("search_internet", "Search the internet for current events, facts, or information using DuckDuckGo.", map[string]any{
+			"query": map[string]any{"type": "string", "description": "The internet search query"},
+		}, []string{"query"}),
+		buildToolDef("scrape_url", "Scrape text content from a specific web URL.", map[string]any{
+			"url": map[string]any{"type": "string", "description": "The exact URL to scrape"},
+		}, []string{"url"}),
+		buildToolDef("search_memos", "Search the user's notes by keyword and meaning for a concept or topic. Use for general/conceptual questions.", map[string]any{
+			"query":      map[string]any{"type": "string", "description": "The search query"},
+			"tags":       map[string]any{"type": "array", "items": map[string]any{"type": "string"}, "description": "Only search notes with any of these tags (optional)"},
+			"date_start": map[string]any{"type": "string", "description": "Start date in YYYY-MM-DD (optional)"},
+			"date_end":   map[string]any{"type": "string", "description": "End date in YYYY-MM-DD (optional)"},
+		}, []string{"query"}),
+		buildToolDef("query_memos", "Search the user's notes by exact date range or keyword. ALWAYS use this for date-specific questions like 'what did I post on Jan 26'.", map[string]any{
+			"text_search": map[string]any{"type": "string", "description": "Exact keyword to search (optional)"},
+			"date_start":  map[string]any{"type": "string", "description": "Start date in YYYY-MM-DD (optional)"},
+			"date_end":    map[string]any{"type": "string", "description": "End date in YYYY-MM-DD (optional)"},
+		}, []string{}),
+		buildToolDef("create_memo", "Create a new note for the user.", map[string]any{
+			"content": map[string]any{"type": "string", "description": "The content of the new note"},
+		}, []string{"content"}),
+		buildToolDef("append_to_memo", "Append text to an existing note without overwriting it.", map[string]any{
+			"uid":     map[string]any{"type": "string", "description": "Note UID"},
+			"content": map[string]any{"type": "string", "description": "Text to append"},
+		}, []string{"uid", "content"}),
+		buildToolDef("update_memo", "Fully rewrite the content of an existing note.", map[string]any{
+			"uid":     map[string]any{"type": "string", "description": "Note UID"},
+			"content": map[string]any{"type": "string", "description": "New content"},
+		}, []string{"uid", "content"}),
+		buildToolDef("update_memo_tags", "Add hashtags to an existing note.", map[string]any{
+			"uid":      map[string]any{"type": "string", "description": "Note UID"},
+			"new_tags": map[string]any{"type": "array", "items": map[string]any{"type": "string"}, "description": "Tags to add, e.g. ['#dev','#work']"},
+		}, []string{"uid", "new_tags"}),
+		buildToolDef("delete_memo", "Permanently delete a note.", map[string]any{
+			"uid": map[string]any{"type": "string", "description": "Note UID"},
+		}, []string{"uid"}),
+		buildToolDef("get_user_stats", "Get note statistics (total count, etc). No parameters needed.", map[string]any{}, []string{}),
+		buildToolDef("list_memos_by_tag", "List all notes tagged with a specific hashtag.", map[string]any{
+			"tag": map[string]any{"type": "string", "description": "Tag including hash, e.g. '#work'"},
+		}, []string{"tag"}),
+		buildToolDef("remember", "Remember a durable fact or preference about the user, such as their projects or conventions, for future conversations.", map[string]any{
+			"content": map[string]any{"type": "string", "description": "The fact, as one short self-contained sentence"},
+		}, []string{"content"}),
+		buildToolDef("forget", "Forget a remembered fact that is wrong or outdated.", map[string]any{
+			"id": map[string]any{"type": "integer", "description": "The memory ID"},
+		}, []string{"id"}),
 	}
-
-	slog.Info("context compacted", "session", sess.UID, "summary_len", len(fullSummary), "kept_messages", len(recent))
-	return recent, updatedSess, nil
 }
 
 // ─────────────────────────────────────────────────────────────────────────────
 // Auto-title
 // ─────────────────────────────────────────────────────────────────────────────
 
-func (s *APIV1Service) autoTitleSession(ctx context.Context, uid, firstMessage string) {
-	if s.Profile.OpenRouterAPIKey == "" {
+func (s *APIV1Service) autoTitleSession(ctx context.Context, user *store.User, uid, firstMessage string) {
+	if s.LLM == nil {
 		return
 	}
 	prompt := fmt.Sprintf(
 		"Generate a short (5-7 word) title for a chat that starts with:\n\"%s\"\nReturn only the title, no quotes.",
 		firstMessage,
 	)
-	title, err := s.callLLM(ctx, prompt)
+	title, err := s.callLLM(ctx, user, aiUsageTitle, prompt)
 	if err != nil || strings.TrimSpace(title) == "" {
 		return
 	}
@@ -644,19 +941,80 @@ func (s *APIV1Service) autoTitleSession(ctx context.Context, uid, firstMessage s
 }
 
 // ─────────────────────────────────────────────────────────────────────────────
-// Helper: requireAuth (convenience wrapper)
+// History replay
 // ─────────────────────────────────────────────────────────────────────────────
 
-func (s *APIV1Service) requireAuth(c *echo.Context) (*store.User, error) {
-	authHeader := c.Request().Header.Get("Authorization")
-	cookieHeader := c.Request().Header.Get("Cookie")
-	user, err := auth.NewAuthenticator(s.Store, s.Secret).AuthenticateToUser(
-		c.Request().Context(), authHeader, cookieHeader,
-	)
-	if err != nil || user == nil {
-		return nil, echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+// replayHistory converts stored messages back into model messages, including
+// tool calls and their results. An assistant tool call is only replayed when
+// every call it made has a stored result, since providers reject unanswered
+// calls; this drops rounds cut short by an error or split by compaction.
+func replayHistory(msgs []*store.AIChatMessage) []llm.Message {
+	var messages []llm.Message
+	for i := 0; i < len(msgs); i++ {
+		m := msgs[i]
+		switch m.Role {
+		case "user":
+			messages = append(messages, llm.Message{Role: m.Role, Content: m.Content})
+		case "assistant":
+			toolCalls := decodeToolCalls(m.ToolCalls)
+			if len(toolCalls) == 0 {
+				messages = append(messages, llm.Message{Role: m.Role, Content: m.Content})
+				continue
+			}
+			results := make(map[string]*store.AIChatMessage, len(toolCalls))
+			j := i + 1
+			for ; j < len(msgs) && msgs[j].Role == "tool"; j++ {
+				if _, ok := results[msgs[j].ToolCallID]; !ok {
+					results[msgs[j].ToolCallID] = msgs[j]
+				}
+			}
+			answered := true
+			for _, tc := range toolCalls {
+				if results[tc.ID] == nil {
+					answered = false
+					break
+				}
+			}
+			if answered {
+				messages = append(messages, llm.Message{Role: m.Role, Content: m.Content, ToolCalls: toolCalls})
+				for _, tc := range toolCalls {
+					messages = append(messages, llm.Message{Role: "tool", ToolCallID: tc.ID, Content: results[tc.ID].Content})
+				}
+			} else if m.Content != "" {
+				messages = append(messages, llm.Message{Role: m.Role, Content: m.Content})
+			}
+			i = j - 1
+		default:
+			// Tool results are replayed with the call they answer; any other
+			// result lost its call to compaction.
+		}
 	}
-	return user, nil
+	return messages
+}
+
+// encodeToolCalls serializes tool calls for storage; no calls encode to "".
+func encodeToolCalls(toolCalls []llm.ToolCall) string {
+	if len(toolCalls) == 0 {
+		return ""
+	}
+	data, err := json.Marshal(toolCalls)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+// decodeToolCalls parses tool calls stored by encodeToolCalls.
+func decodeToolCalls(raw string) []llm.ToolCall {
+	if raw == "" {
+		return nil
+	}
+	var toolCalls []llm.ToolCall
+	if err := json.Unmarshal([]byte(raw), &toolCalls); err != nil {
+		slog.Warn("failed to decode stored tool calls", "err", err)
+		return nil
+	}
+	return toolCalls
 }
 
 // ─────────────────────────────────────────────────────────────────────────────
@@ -664,34 +1022,67 @@ func (s *APIV1Service) requireAuth(c *echo.Context) (*store.User, error) {
 // ─────────────────────────────────────────────────────────────────────────────
 
 type searchMemosTool struct {
-	vs        *vectorstore.Store
+	service   *APIV1Service
 	userID    int32
 	tagFilter string
 }
 
-func newSearchMemosTool(vs *vectorstore.Store, userID int32, tagFilter string) tools.Tool {
-	return &searchMemosTool{vs: vs, userID: userID, tagFilter: tagFilter}
+// parseTagFilter splits a chat tag filter like "#work #ideas" into tags.
+func parseTagFilter(tagFilter string) []string {
+	var tags []string
+	for _, field := range strings.Fields(tagFilter) {
+		if tag := strings.TrimPrefix(field, "#"); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+func newSearchMemosTool(service *APIV1Service, userID int32, tagFilter string) tools.Tool {
+	return &searchMemosTool{service: service, userID: userID, tagFilter: tagFilter}
 }
 
 func (t *searchMemosTool) Name() string { return "search_memos" }
 func (t *searchMemosTool) Description() string {
-	return "Search through the user's personal notes (memos) for relevant information. Input should be a search query."
+	return "Search through the user's personal notes (memos) and the files attached to them for relevant information. Input should be a search query."
 }
 func (t *searchMemosTool) Call(ctx context.Context, input string) (string, error) {
 	slog.Info("[AGENT TOOL CALL]", "tool", t.Name(), "input", input)
-	if t.vs == nil {
-		return "Vector store not available.", nil
-	}
 	// The LLM sends JSON like {"query": "..."} — extract the actual query string.
 	query := input
 	var payload struct {
-		Query string `json:"query"`
-	}
+		Query     string   `json:"query"`
+		Tags      []string `json:"tags"`
+		DateStart string   `json:"date_start"`
+		DateEnd   string   `json:"date_end"`
+	}
+	var tags []string
+	// Only the user's own notes are searched, as the vector index is per user.
+	conditions := []string{fmt.Sprintf("creator_id == %d", t.userID)}
 	if err := json.Unmarshal([]byte(input), &payload); err == nil && payload.Query != "" {
 		query = payload.Query
+		tags = parseTagFilter(strings.Join(payload.Tags, " "))
+		if parsed, err := time.Parse("2006-01-02", payload.DateStart); err == nil {
+			conditions = append(conditions, fmt.Sprintf("created_ts >= %d", parsed.Unix()))
+		}
+		if parsed, err := time.Parse("2006-01-02", payload.DateEnd); err == nil {
+			// Add 24 hours to include the whole end day
+			conditions = append(conditions, fmt.Sprintf("created_ts < %d", parsed.Add(24*time.Hour).Unix()))
+		}
+	}
+	// A chat scoped to tags never searches outside them.
+	if chatTags := parseTagFilter(t.tagFilter); len(chatTags) > 0 {
+		tags = chatTags
+	}
+	if len(tags) > 0 {
+		quoted := make([]string, len(tags))
+		for i, tag := range tags {
+			quoted[i] = fmt.Sprintf("%q", tag)
+		}
+		conditions = append(conditions, fmt.Sprintf("tag in [%s]", strings.Join(quoted, ", ")))
 	}
-	slog.Info("[SEARCH MEMOS]", "query", query)
-	results, err := t.vs.SearchSimilar(ctx, t.userID, query, 5)
+	slog.Info("[SEARCH MEMOS]", "query", query, "tags", tags)
+	results, err := t.service.HybridSearchMemos(ctx, t.userID, query, strings.Join(conditions, " && "), 5)
 	if err != nil {
 		return "", err
 	}
@@ -700,26 +1091,68 @@ func (t *searchMemosTool) Call(ctx context.Context, input string) (string, error
 	}
 	var sb strings.Builder
 	for i, r := range results {
-		preview := r.Content
-		if len(preview) > 400 {
-			preview = preview[:400] + "..."
+		// Say where a passage from an attachment comes from, so that answers can
+		// cite the file.
+		if r.AttachmentUID != "" {
+			sb.WriteString(fmt.Sprintf("[%d] Note %s (score %.3f, attached file %q):\n%s\n\n", i+1, r.Memo.UID, r.Score, r.Filename, r.Snippet))
+			continue
 		}
-		sb.WriteString(fmt.Sprintf("[%d] Note %s (score %.2f):\n%s\n\n", i+1, r.MemoUID, r.Score, preview))
+		sb.WriteString(fmt.Sprintf("[%d] Note %s (score %.3f):\n%s\n\n", i+1, r.Memo.UID, r.Score, r.Snippet))
 	}
 	return sb.String(), nil
 }
 
+// The memo-writing tools below go through the same service methods as the
+// API, so notes written by the assistant get their payload rebuilt, are
+// re-indexed for search, and trigger webhooks and live refresh like any other
+// edit. The tools' context must carry the user (see newChatAgent).
+
+// getOwnMemo returns the user's memo with the given UID, or a message for the
+// model explaining why it cannot be used.
+func getOwnMemo(ctx context.Context, s *store.Store, userID int32, uid string) (*store.Memo, string) {
+	m, err := s.GetMemo(ctx, &store.FindMemo{UID: &uid})
+	if err != nil || m == nil {
+		return nil, "Error: note not found."
+	}
+	if m.CreatorID != userID {
+		return nil, "Error: unauthorized to access this note."
+	}
+	return m, ""
+}
+
+// updateMemoContent replaces the content of the memo with the given UID.
+func (s *APIV1Service) updateMemoContent(ctx context.Context, uid, content string) error {
+	_, err := s.UpdateMemo(ctx, &v1pb.UpdateMemoRequest{
+		Memo: &v1pb.Memo{
+			Name:    MemoNamePrefix + uid,
+			Content: content,
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	return err
+}
+
+// appendMemoContent returns content with text added as a new paragraph.
+func appendMemoContent(content, text string) string {
+	return content + "\n\n" + text
+}
+
+// toolErrorMessage returns the user-facing part of a service error.
+func toolErrorMessage(err error) string {
+	return status.Convert(err).Message()
+}
+
 // ─────────────────────────────────────────────────────────────────────────────
 // Helper: UpdateMemo tool
 // ─────────────────────────────────────────────────────────────────────────────
 
 type updateMemoTool struct {
-	store  *store.Store
-	userID int32
+	service *APIV1Service
+	userID  int32
 }
 
-func newUpdateMemoTool(store *store.Store, userID int32) tools.Tool {
-	return &updateMemoTool{store: store, userID: userID}
+func newUpdateMemoTool(service *APIV1Service, userID int32) tools.Tool {
+	return &updateMemoTool{service: service, userID: userID}
 }
 
 func (t *updateMemoTool) Name() string { return "update_memo" }
@@ -736,20 +1169,12 @@ func (t *updateMemoTool) Call(ctx context.Context, input string) (string, error)
 		return "Error: failed to parse input JSON.", nil
 	}
 
-	m, err := t.store.GetMemo(ctx, &store.FindMemo{UID: &payload.UID})
-	if err != nil || m == nil {
-		return "Error: note not found.", nil
-	}
-	if m.CreatorID != t.userID {
-		return "Error: unauthorized to update this note.", nil
+	m, msg := getOwnMemo(ctx, t.service.Store, t.userID, payload.UID)
+	if m == nil {
+		return msg, nil
 	}
-
-	err = t.store.UpdateMemo(ctx, &store.UpdateMemo{
-		ID:      m.ID,
-		Content: &payload.Content,
-	})
-	if err != nil {
-		return "Error: " + err.Error(), nil
+	if err := t.service.updateMemoContent(ctx, m.UID, payload.Content); err != nil {
+		return "Error: " + toolErrorMessage(err), nil
 	}
 	return "Note successfully updated.", nil
 }
@@ -759,12 +1184,11 @@ func (t *updateMemoTool) Call(ctx context.Context, input string) (string, error)
 // ─────────────────────────────────────────────────────────────────────────────
 
 type createMemoTool struct {
-	store  *store.Store
-	userID int32
+	service *APIV1Service
 }
 
-func newCreateMemoTool(store *store.Store, userID int32) tools.Tool {
-	return &createMemoTool{store: store, userID: userID}
+func newCreateMemoTool(service *APIV1Service) tools.Tool {
+	return &createMemoTool{service: service}
 }
 
 func (t *createMemoTool) Name() string { return "create_memo" }
@@ -780,18 +1204,16 @@ func (t *createMemoTool) Call(ctx context.Context, input string) (string, error)
 		return "Error: failed to parse input JSON.", nil
 	}
 
-	// Use the same shortuuid format that Memos uses for all memo UIDs
-	uid := shortuuid.New()
-	_, err := t.store.CreateMemo(ctx, &store.Memo{
-		UID:        uid,
-		CreatorID:  t.userID,
-		Content:    payload.Content,
-		Visibility: store.Private,
+	memo, err := t.service.CreateMemo(ctx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{
+			Content:    payload.Content,
+			Visibility: v1pb.Visibility_PRIVATE,
+		},
 	})
 	if err != nil {
-		return "Error creating note: " + err.Error(), nil
+		return "Error creating note: " + toolErrorMessage(err), nil
 	}
-	return fmt.Sprintf("Note successfully created with UID: %s", uid), nil
+	return fmt.Sprintf("Note successfully created with UID: %s", strings.TrimPrefix(memo.Name, MemoNamePrefix)), nil
 }
 
 // ─────────────────────────────────────────────────────────────────────────────
@@ -799,12 +1221,12 @@ func (t *createMemoTool) Call(ctx context.Context, input string) (string, error)
 // ─────────────────────────────────────────────────────────────────────────────
 
 type appendToMemoTool struct {
-	store  *store.Store
-	userID int32
+	service *APIV1Service
+	userID  int32
 }
 
-func newAppendToMemoTool(store *store.Store, userID int32) tools.Tool {
-	return &appendToMemoTool{store: store, userID: userID}
+func newAppendToMemoTool(service *APIV1Service, userID int32) tools.Tool {
+	return &appendToMemoTool{service: service, userID: userID}
 }
 
 func (t *appendToMemoTool) Name() string { return "append_to_memo" }
@@ -821,21 +1243,12 @@ func (t *appendToMemoTool) Call(ctx context.Context, input string) (string, erro
 		return "Error: failed to parse input JSON.", nil
 	}
 
-	m, err := t.store.GetMemo(ctx, &store.FindMemo{UID: &payload.UID})
-	if err != nil || m == nil {
-		return "Error: note not found.", nil
+	m, msg := getOwnMemo(ctx, t.service.Store, t.userID, payload.UID)
+	if m == nil {
+		return msg, nil
 	}
-	if m.CreatorID != t.userID {
-		return "Error: unauthorized to modify this note.", nil
-	}
-
-	newContent := m.Content + "\n\n" + payload.Content
-	err = t.store.UpdateMemo(ctx, &store.UpdateMemo{
-		ID:      m.ID,
-		Content: &newContent,
-	})
-	if err != nil {
-		return "Error appending to note: " + err.Error(), nil
+	if err := t.service.updateMemoContent(ctx, m.UID, appendMemoContent(m.Content, payload.Content)); err != nil {
+		return "Error appending to note: " + toolErrorMessage(err), nil
 	}
 	return "Content successfully appended to note.", nil
 }
@@ -845,12 +1258,12 @@ func (t *appendToMemoTool) Call(ctx context.Context, input string) (string, erro
 // ─────────────────────────────────────────────────────────────────────────────
 
 type updateMemoTagsTool struct {
-	store  *store.Store
-	userID int32
+	service *APIV1Service
+	userID  int32
 }
 
-func newUpdateMemoTagsTool(store *store.Store, userID int32) tools.Tool {
-	return &updateMemoTagsTool{store: store, userID: userID}
+func newUpdateMemoTagsTool(service *APIV1Service, userID int32) tools.Tool {
+	return &updateMemoTagsTool{service: service, userID: userID}
 }
 
 func (t *updateMemoTagsTool) Name() string { return "update_memo_tags" }
@@ -867,21 +1280,12 @@ func (t *updateMemoTagsTool) Call(ctx context.Context, input string) (string, er
 		return "Error: failed to parse input JSON.", nil
 	}
 
-	m, err := t.store.GetMemo(ctx, &store.FindMemo{UID: &payload.UID})
-	if err != nil || m == nil {
-		return "Error: note not found.", nil
+	m, msg := getOwnMemo(ctx, t.service.Store, t.userID, payload.UID)
+	if m == nil {
+		return msg, nil
 	}
-	if m.CreatorID != t.userID {
-		return "Error: unauthorized to modify this note.", nil
-	}
-
-	newContent := m.Content + "\n\n" + strings.Join(payload.NewTags, " ")
-	err = t.store.UpdateMemo(ctx, &store.UpdateMemo{
-		ID:      m.ID,
-		Content: &newContent,
-	})
-	if err != nil {
-		return "Error appending tags: " + err.Error(), nil
+	if err := t.service.updateMemoContent(ctx, m.UID, appendMemoContent(m.Content, strings.Join(payload.NewTags, " "))); err != nil {
+		return "Error appending tags: " + toolErrorMessage(err), nil
 	}
 	return "Tags successfully added to the note body.", nil
 }
@@ -891,12 +1295,12 @@ func (t *updateMemoTagsTool) Call(ctx context.Context, input string) (string, er
 // ─────────────────────────────────────────────────────────────────────────────
 
 type deleteMemoTool struct {
-	store  *store.Store
-	userID int32
+	service *APIV1Service
+	userID  int32
 }
 
-func newDeleteMemoTool(store *store.Store, userID int32) tools.Tool {
-	return &deleteMemoTool{store: store, userID: userID}
+func newDeleteMemoTool(service *APIV1Service, userID int32) tools.Tool {
+	return &deleteMemoTool{service: service, userID: userID}
 }
 
 func (t *deleteMemoTool) Name() string { return "delete_memo" }
@@ -912,17 +1316,12 @@ func (t *deleteMemoTool) Call(ctx context.Context, input string) (string, error)
 		return "Error: failed to parse input JSON.", nil
 	}
 
-	m, err := t.store.GetMemo(ctx, &store.FindMemo{UID: &payload.UID})
-	if err != nil || m == nil {
-		return "Error: note not found.", nil
-	}
-	if m.CreatorID != t.userID {
-		return "Error: unauthorized to access this note.", nil
+	m, msg := getOwnMemo(ctx, t.service.Store, t.userID, payload.UID)
+	if m == nil {
+		return msg, nil
 	}
-
-	err = t.store.DeleteMemo(ctx, &store.DeleteMemo{ID: m.ID})
-	if err != nil {
-		return "Error deleting note: " + err.Error(), nil
+	if _, err := t.service.DeleteMemo(ctx, &v1pb.DeleteMemoRequest{Name: MemoNamePrefix + m.UID}); err != nil {
+		return "Error deleting note: " + toolErrorMessage(err), nil
 	}
 	return "Note successfully and permanently deleted.", nil
 }
@@ -1105,9 +1504,17 @@ func (t *queryMemosTool) Call(ctx context.Context, input string) (string, error)
 // Helpers
 // ─────────────────────────────────────────────────────────────────────────────
 
-func buildSystemPrompt(summary string, now time.Time) string {
+// defaultSystemPromptPreamble opens the system prompt unless the instance AI
+// setting replaces it.
+const defaultSystemPromptPreamble = "You are an AI assistant for the user's personal knowledge base (Memos app)."
+
+func buildSystemPrompt(preamble, summary string, memories []*store.AIMemory, internet, memory bool, now time.Time) string {
+	preamble = strings.TrimSpace(preamble)
+	if preamble == "" {
+		preamble = defaultSystemPromptPreamble
+	}
 	base := fmt.Sprintf(
-		`You are an AI assistant for the user's personal knowledge base (Memos app).
+		`%s
 Today's local date: %s.
 
 You have access to tools that let you read the user's database. YOU CURRENTLY HAVE ZERO KNOWLEDGE OF THE USER'S NOTES.
@@ -1116,29 +1523,45 @@ CRITICAL INSTRUCTIONS:
 2. For questions about a SPECIFIC DATE or exact keyword, YOU MUST use "query_memos". This is mandatory.
 3. For general conceptual questions, use "search_memos".
 4. To create, append, tag, or delete notes, use the respective tools.
-5. NEVER hallucinate note content. If a tool returns no results, tell the user exactly that.
+5. NEVER hallucinate note content. If a tool returns no results, tell the user exactly that.`,
+		preamble, now.Format("2006-01-02 15:04:05"),
+	)
+	if internet {
+		base += `
 
 INTERNET SEARCH RULES (search_internet + scrape_url):
 - NEVER use advanced operators like "site:", "inurl:", "filetype:" — they trigger bot detection and get blocked.
 - The search result includes a Title, Description, and URL for each result. The Description snippet often contains enough information to answer the question without scraping.
 - Only call scrape_url if you genuinely need the full article text. If a scrape returns an error, move on — DO NOT retry the same URL.
-- If multiple scrapes fail, synthesize your answer from the search result snippets and descriptions you already have. Do not keep trying new searches for the same information.`,
-		now.Format("2006-01-02 15:04:05"),
-	)
+- If multiple scrapes fail, synthesize your answer from the search result snippets and descriptions you already have. Do not keep trying new searches for the same information.`
+	}
+	if memory {
+		base += `
+
+MEMORY RULES (remember + forget):
+- When the user shares a durable fact or preference, such as their projects, conventions or how they like answers, use "remember" so you never have to ask again. Do not remember secrets or one-off details.
+- When a remembered fact turns out wrong or outdated, "forget" it by its ID and remember the correct one.`
+		if len(memories) > 0 {
+			base += "\n\nWhat you remember about the user from earlier conversations:"
+			for _, m := range memories {
+				base += fmt.Sprintf("\n- [%d] %s", m.ID, m.Content)
+			}
+		}
+	}
 	if summary != "" {
 		base += "\n\nSummary of earlier conversation:\n" + summary
 	}
 	return base
 }
 
-// buildToolDef constructs an OpenAI-compatible tool definition map.
-func buildToolDef(name, description string, properties map[string]any, required []string) map[string]any {
-	return map[string]any{
-		"type": "function",
-		"function": map[string]any{
-			"name":        name,
-			"description": description,
-			"parameters": map[string]any{
+// buildToolDef constructs a function tool definition.
+func buildToolDef(name, description string, properties map[string]any, required []string) llm.Tool {
+	return llm.Tool{
+		Type: "function",
+		Function: llm.ToolFunction{
+			Name:        name,
+			Description: description,
+			Parameters: map[string]any{
 				"type":       "object",
 				"properties": properties,
 				"required":   required,
@@ -1147,41 +1570,15 @@ func buildToolDef(name, description string, properties map[string]any, required
 	}
 }
 
-// callLLM makes a simple single-turn chat completion request to OpenRouter.
-func (s *APIV1Service) callLLM(ctx context.Context, prompt string) (string, error) {
-	reqBody := map[string]any{
-		"model":    s.Profile.AIModel,
-		"messages": []map[string]any{{"role": "user", "content": prompt}},
-	}
-	bodyBytes, _ := json.Marshal(reqBody)
-	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost,
-		"https://openrouter.ai/api/v1/chat/completions",
-		bytes.NewReader(bodyBytes))
-	if err != nil {
-		return "", err
-	}
-	httpReq.Header.Set("Authorization", "Bearer "+s.Profile.OpenRouterAPIKey)
-	httpReq.Header.Set("Content-Type", "application/json")
-
-	resp, err := http.DefaultClient.Do(httpReq)
+// callLLM makes a simple single-turn chat completion request for the user.
+func (s *APIV1Service) callLLM(ctx context.Context, user *store.User, kind, prompt string) (string, error) {
+	resp, err := s.chatLLM(ctx, user, kind, &llm.ChatRequest{
+		Messages: []llm.Message{{Role: "user", Content: prompt}},
+	}, nil)
 	if err != nil {
 		return "", err
 	}
-	defer resp.Body.Close()
-	var apiResp struct {
-		Choices []struct {
-			Message struct {
-				Content string `json:"content"`
-			} `json:"message"`
-		} `json:"choices"`
-	}
-	if err := json.NewDecoder(resp.Body).Decode(&apiResp); err != nil {
-		return "", err
-	}
-	if len(apiResp.Choices) == 0 {
-		return "", fmt.Errorf("empty response from LLM")
-	}
-	return apiResp.Choices[0].Message.Content, nil
+	return resp.Message.Content, nil
 }
 
 func min(a, b int) int {
@@ -1308,7 +1705,14 @@ func (t *ddgToolAdapter) Call(ctx context.Context, input string) (string, error)
 	return result, nil
 }
 
-type scraperToolAdapter struct{}
+// scraperToolAdapter reads a web page for the model. Pages are fetched and
+// extracted on the server unless the instance sends them through a reader
+// service.
+type scraperToolAdapter struct {
+	// readerURL is the endpoint of the reader service; empty extracts pages
+	// locally.
+	readerURL string
+}
 
 func (t *scraperToolAdapter) Name() string        { return "scrape_url" }
 func (t *scraperToolAdapter) Description() string { return "" }
@@ -1341,154 +1745,134 @@ func (t *scraperToolAdapter) Call(ctx context.Context, input string) (string, er
 		targetURL.Scheme = "https"
 	}
 
-	// Use Jina Reader (free, no key) — returns clean markdown from any page,
-	// including JS-heavy sites that our plain HTTP scraper can't handle.
-	jinaURL := "https://r.jina.ai/" + targetURL.String()
-
 	ctx, cancel := context.WithTimeout(ctx, 25*time.Second)
 	defer cancel()
+	var text string
+	if t.readerURL == "" {
+		text, err = readPage(ctx, targetURL.String())
+	} else {
+		text, err = readPageWithReader(ctx, t.readerURL, targetURL.String())
+	}
+	if err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return "Error: Timeout reading page. The site may be too slow. Use information already gathered.", nil
+		}
+		return "Error: " + err.Error(), nil
+	}
+
+	// Trim to 8000 bytes for the LLM.
+	if len(text) > maxScrapedLength {
+		cut := maxScrapedLength
+		for cut > 0 && !utf8.RuneStart(text[cut]) {
+			cut--
+		}
+		text = text[:cut]
+	}
+	return text, nil
+}
+
+// maxScrapedLength caps the bytes of a page passed to the model.
+const maxScrapedLength = 8000
+
+// readPage fetches a page and extracts its main content as markdown, headed by
+// its title and byline.
+func readPage(ctx context.Context, pageURL string) (string, error) {
+	content, err := httpgetter.GetHTMLContent(ctx, pageURL)
+	if err != nil {
+		if errors.Is(err, httpgetter.ErrNoContent) {
+			return "", errors.New("no readable content on this page")
+		}
+		return "", fmt.Errorf("failed to read page: %w", err)
+	}
+	var sb strings.Builder
+	for _, field := range []struct{ name, value string }{
+		{"Title", content.Title},
+		{"Byline", content.Byline},
+		{"Site", content.SiteName},
+		{"URL", pageURL},
+	} {
+		if field.value != "" {
+			sb.WriteString(field.name + ": " + field.value + "\n")
+		}
+	}
+	sb.WriteString("\n" + content.Markdown)
+	return sb.String(), nil
+}
 
-	req, err := http.NewRequestWithContext(ctx, "GET", jinaURL, nil)
+// readPageWithReader reads a page through a reader service, which is sent the
+// page URL appended to its endpoint and returns the page as text, like Jina
+// Reader.
+func readPageWithReader(ctx context.Context, readerURL, pageURL string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", readerURL+pageURL, nil)
 	if err != nil {
-		return "Error creating request: " + err.Error(), nil
+		return "", fmt.Errorf("failed to create request: %w", err)
 	}
 	req.Header.Set("Accept", "text/plain")
-	// Ask Jina to skip images and links to keep the output compact.
+	// Ask the reader to skip images and links to keep the output compact.
 	req.Header.Set("X-Remove-Selector", "img, nav, footer, aside, .ads")
 	req.Header.Set("X-Return-Format", "text")
 
 	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
-		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
-			return "Error: Timeout reading page via Jina. The site may be too slow. Use information already gathered.", nil
-		}
-		return "Error fetching via Jina: " + err.Error(), nil
+		return "", fmt.Errorf("failed to fetch via reader: %w", err)
 	}
 	defer resp.Body.Close()
-
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Sprintf("Error: Jina returned status %d for %s", resp.StatusCode, targetURL.String()), nil
+		return "", fmt.Errorf("reader returned status %d for %s", resp.StatusCode, pageURL)
 	}
 
-	// Read up to 50 KB then trim to 8000 chars for the LLM.
+	// Read up to 50 KB; the caller trims it further.
 	buf := make([]byte, 51200)
 	n, _ := io.ReadFull(resp.Body, buf)
 	text := strings.TrimSpace(string(buf[:n]))
-
-	if len(text) > 8000 {
-		text = text[:8000]
-	}
 	if len(text) < 80 {
-		return "Error: Jina returned no readable content for this page.", nil
+		return "", errors.New("reader returned no readable content for this page")
 	}
-
 	return text, nil
 }
 
-// proxySSEFromOpenRouter is a helper for future true streaming from OpenRouter.
-// Currently unused — kept for when langchaingo streaming is wired.
-func proxySSEFromOpenRouter(dst io.Writer, resp *http.Response) {
-	scanner := bufio.NewScanner(resp.Body)
-	for scanner.Scan() {
-		line := scanner.Text()
-		if !strings.HasPrefix(line, "data: ") {
-			continue
-		}
-		payload := strings.TrimPrefix(line, "data: ")
-		if payload == "[DONE]" {
-			break
-		}
-		var chunk struct {
-			Choices []struct {
-				Delta struct {
-					Content string `json:"content"`
-				} `json:"delta"`
-			} `json:"choices"`
-		}
-		if err := json.Unmarshal([]byte(payload), &chunk); err != nil {
-			continue
-		}
-		for _, ch := range chunk.Choices {
-			if ch.Delta.Content != "" {
-				data, _ := json.Marshal(map[string]string{"type": "token", "content": ch.Delta.Content})
-				fmt.Fprintf(dst, "data: %s\n\n", data)
-				if f, ok := dst.(http.Flusher); ok {
-					f.Flush()
-				}
-			}
-		}
-	}
-	_ = bytes.NewBuffer(nil) // suppress unused import
-}
-
 // ─────────────────────────────────────────────────────────────────────────────
 // Stream Completions
 // ─────────────────────────────────────────────────────────────────────────────
 
-func (s *APIV1Service) handleAICompletionsStream(c *echo.Context) error {
-	if s.Profile.OpenRouterAPIKey == "" {
-		return echo.NewHTTPError(http.StatusServiceUnavailable, "AI is not configured (missing OPENROUTER_API_KEY)")
+func (s *APIV1Service) GenerateCompletion(request *v1pb.GenerateCompletionRequest, stream grpc.ServerStreamingServer[v1pb.GenerateCompletionResponse]) error {
+	if s.LLM == nil {
+		return status.Errorf(codes.FailedPrecondition, "AI is not configured (missing AI_PROVIDER)")
 	}
-
-	_, err := s.requireAuth(c)
-	if err != nil {
-		return err
+	if strings.TrimSpace(request.Prompt) == "" {
+		return status.Errorf(codes.InvalidArgument, "prompt is required")
 	}
-
-	var reqBody struct {
-		Prompt string `json:"prompt"`
-		System string `json:"system"`
+	ctx := stream.Context()
+	user, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get current user: %v", err)
 	}
-	if err := c.Bind(&reqBody); err != nil || strings.TrimSpace(reqBody.Prompt) == "" {
-		return echo.NewHTTPError(http.StatusBadRequest, "prompt required")
+	if user == nil {
+		return status.Errorf(codes.Unauthenticated, "user not authenticated")
 	}
-
-	ctx := c.Request().Context()
-	rw := c.Response()
-
-	rw.Header().Set("Content-Type", "text/event-stream")
-	rw.Header().Set("Cache-Control", "no-cache")
-	rw.Header().Set("Connection", "keep-alive")
-	rw.Header().Set("X-Accel-Buffering", "no")
-	rw.WriteHeader(http.StatusOK)
-
-	messages := []map[string]any{}
-	if reqBody.System != "" {
-		messages = append(messages, map[string]any{"role": "system", "content": reqBody.System})
+	if err := s.checkAIQuotaStatus(ctx, user); err != nil {
+		return err
 	}
-	messages = append(messages, map[string]any{"role": "user", "content": reqBody.Prompt})
 
-	orReqBody := map[string]any{
-		"model":    s.Profile.AIModel,
-		"messages": messages,
-		"stream":   true,
+	messages := []llm.Message{}
+	if request.System != "" {
+		messages = append(messages, llm.Message{Role: "system", Content: request.System})
 	}
-	bodyBytes, _ := json.Marshal(orReqBody)
+	messages = append(messages, llm.Message{Role: "user", Content: request.Prompt})
 
-	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost,
-		"https://openrouter.ai/api/v1/chat/completions",
-		bytes.NewReader(bodyBytes))
-	if err != nil {
-		slog.Error("failed to create openrouter request", "err", err)
-		return nil
-	}
-	httpReq.Header.Set("Authorization", "Bearer "+s.Profile.OpenRouterAPIKey)
-	httpReq.Header.Set("Content-Type", "application/json")
-
-	resp, err := http.DefaultClient.Do(httpReq)
+	// Forward each content delta as it arrives.
+	var sendErr error
+	_, err = s.chatLLM(ctx, user, aiUsageCompletion, &llm.ChatRequest{Messages: messages}, func(delta string) {
+		if sendErr == nil {
+			sendErr = stream.Send(&v1pb.GenerateCompletionResponse{Content: delta})
+		}
+	})
 	if err != nil {
-		slog.Error("failed to call openrouter", "err", err)
-		return nil
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		slog.Error("openrouter returned error", "status", resp.Status)
-		return nil
+		var quotaErr *quotaExceededError
+		if errors.As(err, &quotaErr) {
+			return status.Errorf(codes.ResourceExhausted, "%s", quotaErr.Error())
+		}
+		return status.Errorf(codes.Internal, "failed to stream completion: %v", err)
 	}
-
-	// Proxy the stream using our helper, which translates it into our custom SSE format
-	proxySSEFromOpenRouter(rw, resp)
-
-	return nil
+	return sendErr
 }
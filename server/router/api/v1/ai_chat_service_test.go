@@ -0,0 +1,112 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/plugin/llm"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestReplayHistory(t *testing.T) {
+	call := func(id string) llm.ToolCall {
+		return llm.ToolCall{ID: id, Type: "function", Function: llm.FunctionCall{Name: "search_memos", Arguments: `{"query":"x"}`}}
+	}
+	msgs := []*store.AIChatMessage{
+		// A result whose call was compacted away.
+		{Role: "tool", ToolCallID: "old", Content: "stale"},
+		{Role: "user", Content: "find my notes"},
+		{Role: "assistant", ToolCalls: encodeToolCalls([]llm.ToolCall{call("a"), call("b")})},
+		{Role: "tool", ToolName: "search_memos", ToolCallID: "b", Content: "result b"},
+		{Role: "tool", ToolName: "search_memos", ToolCallID: "a", Content: "result a"},
+		{Role: "assistant", Content: "Here they are."},
+		{Role: "user", Content: "and more?"},
+		// An interrupted round: the call never got its result.
+		{Role: "assistant", Content: "Let me check.", ToolCalls: encodeToolCalls([]llm.ToolCall{call("c")})},
+		{Role: "user", Content: "hello?"},
+	}
+
+	require.Equal(t, []llm.Message{
+		{Role: "user", Content: "find my notes"},
+		{Role: "assistant", ToolCalls: []llm.ToolCall{call("a"), call("b")}},
+		{Role: "tool", ToolCallID: "a", Content: "result a"},
+		{Role: "tool", ToolCallID: "b", Content: "result b"},
+		{Role: "assistant", Content: "Here they are."},
+		{Role: "user", Content: "and more?"},
+		{Role: "assistant", Content: "Let me check."},
+		{Role: "user", Content: "hello?"},
+	}, replayHistory(msgs))
+}
+
+func TestScraperToolAdapter(t *testing.T) {
+	var requested string
+	reader := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = r.URL.Path
+		w.Write([]byte(strings.Repeat("Basil needs sun. ", 10)))
+	}))
+	defer reader.Close()
+
+	// Reader services get the page URL appended to their endpoint.
+	scraper := &scraperToolAdapter{readerURL: reader.URL + "/"}
+	text, err := scraper.Call(context.Background(), `{"url": "https://garden.example.com/basil"}`)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(text, "Basil needs sun."))
+	require.Equal(t, "/https://garden.example.com/basil", requested)
+
+	// Pages are fetched locally by default, which keeps off internal hosts.
+	requested = ""
+	text, err = (&scraperToolAdapter{}).Call(context.Background(), reader.URL)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(text, "Error:"), text)
+	require.Empty(t, requested)
+}
+
+func TestResolveChatModel(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		allowed   []string
+		requested string
+		want      string
+		wantErr   bool
+	}{
+		{name: "default", want: "gpt-4o-mini"},
+		{name: "only the default without allowed models", requested: "gpt-4o", wantErr: true},
+		{name: "allowed default", allowed: []string{"gpt-4o", "gpt-4o-mini"}, want: "gpt-4o-mini"},
+		{name: "first allowed when the default is not", allowed: []string{"gpt-4o"}, want: "gpt-4o"},
+		{name: "requested", allowed: []string{"gpt-4o", "gpt-4o-mini"}, requested: "gpt-4o", want: "gpt-4o"},
+		{name: "requested default not allowed", allowed: []string{"gpt-4o"}, requested: "gpt-4o-mini", wantErr: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			model, err := resolveChatModel(&storepb.InstanceAISetting{AllowedModels: tc.allowed}, tc.requested, "gpt-4o-mini")
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, model)
+		})
+	}
+}
+
+func TestBuildSystemPrompt(t *testing.T) {
+	now := time.Date(2024, 5, 1, 9, 30, 0, 0, time.UTC)
+	prompt := buildSystemPrompt("", "", nil, true, false, now)
+	require.True(t, strings.HasPrefix(prompt, defaultSystemPromptPreamble+"\nToday's local date: 2024-05-01 09:30:00."))
+	require.Contains(t, prompt, "INTERNET SEARCH RULES")
+	require.NotContains(t, prompt, "MEMORY RULES")
+
+	memories := []*store.AIMemory{{ID: 3, Content: "Prefers metric units."}}
+	prompt = buildSystemPrompt("You are the ACME knowledge assistant.", "We talked about basil.", memories, false, true, now)
+	require.True(t, strings.HasPrefix(prompt, "You are the ACME knowledge assistant.\n"))
+	require.NotContains(t, prompt, "INTERNET SEARCH RULES")
+	require.Contains(t, prompt, "MEMORY RULES")
+	require.Contains(t, prompt, "\n- [3] Prefers metric units.")
+	require.True(t, strings.HasSuffix(prompt, "Summary of earlier conversation:\nWe talked about basil."))
+}
@@ -0,0 +1,418 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	mcpclient "github.com/mark3labs/mcp-go/client"
+	"github.com/mark3labs/mcp-go/client/transport"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/pkg/errors"
+	"github.com/tmc/langchaingo/tools"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/usememos/memos/plugin/httpgetter"
+	"github.com/usememos/memos/plugin/llm"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// External MCP servers, registered by admins for everyone or by users for
+// themselves, extend the chat agent's tools. Their tools are offered to the
+// model as "<server>__<tool>" next to the built-in ones.
+
+const (
+	// mcpConnectTimeout bounds starting a server and listing its tools.
+	mcpConnectTimeout = 15 * time.Second
+	// mcpCallTimeout bounds a single tool call.
+	mcpCallTimeout = 60 * time.Second
+	// mcpIdleTimeout is how long an unused connection is kept open.
+	mcpIdleTimeout = 10 * time.Minute
+)
+
+var (
+	mcpServerNamePattern = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,32}$`)
+	// Tool names the LLM providers accept.
+	mcpToolNameInvalidChars = regexp.MustCompile(`[^a-zA-Z0-9_-]`)
+)
+
+// mcpPool keeps a connection per distinct server configuration, so that every
+// chat turn does not start a server again.
+type mcpPool struct {
+	mu    sync.Mutex
+	conns map[string]*mcpConn
+}
+
+// mcpConn is a lazily started connection to one MCP server. It reconnects
+// after a failed call.
+type mcpConn struct {
+	server *storepb.MCPServer
+	// restricted connections refuse redirects and connections to internal
+	// addresses, for servers registered by users.
+	restricted bool
+
+	mu       sync.Mutex
+	client   *mcpclient.Client
+	tools    []mcp.Tool
+	lastUsed time.Time
+}
+
+// get returns the connection for server, closing connections that have not
+// been used for a while, such as those of servers that were since changed.
+// Connections to the servers of users are restricted to public addresses.
+func (p *mcpPool) get(server *storepb.MCPServer, restricted bool) *mcpConn {
+	key, _ := proto.MarshalOptions{Deterministic: true}.Marshal(server)
+	if restricted {
+		key = append(key, '!')
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.conns == nil {
+		p.conns = make(map[string]*mcpConn)
+	}
+	for k, conn := range p.conns {
+		if k != string(key) && conn.idle() {
+			conn.close()
+			delete(p.conns, k)
+		}
+	}
+	conn, ok := p.conns[string(key)]
+	if !ok {
+		conn = &mcpConn{server: server, restricted: restricted, lastUsed: time.Now()}
+		p.conns[string(key)] = conn
+	}
+	return conn
+}
+
+// idle reports whether the connection is unused; one busy connecting is not.
+func (c *mcpConn) idle() bool {
+	if !c.mu.TryLock() {
+		return false
+	}
+	defer c.mu.Unlock()
+	return time.Since(c.lastUsed) > mcpIdleTimeout
+}
+
+func (c *mcpConn) close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.reset()
+}
+
+// reset drops the client; c.mu must be held.
+func (c *mcpConn) reset() {
+	if c.client != nil {
+		c.client.Close()
+	}
+	c.client = nil
+	c.tools = nil
+}
+
+// connect starts the server and lists its tools unless that already happened;
+// c.mu must be held.
+func (c *mcpConn) connect(ctx context.Context) error {
+	c.lastUsed = time.Now()
+	if c.client != nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, mcpConnectTimeout)
+	defer cancel()
+
+	var client *mcpclient.Client
+	var err error
+	if c.server.Command != "" {
+		// Stdio clients start their process right away.
+		client, err = mcpclient.NewStdioMCPClient(c.server.Command, c.server.Env, c.server.Args...)
+		if err != nil {
+			return errors.Wrap(err, "failed to start server")
+		}
+	} else {
+		options := []transport.StreamableHTTPCOption{transport.WithHTTPHeaders(c.server.Headers)}
+		if c.restricted {
+			options = append(options, transport.WithHTTPBasicClient(httpgetter.NewSafeClient()))
+		}
+		client, err = mcpclient.NewStreamableHttpClient(c.server.Url, options...)
+		if err != nil {
+			return errors.Wrap(err, "failed to create client")
+		}
+		if err := client.Start(ctx); err != nil {
+			client.Close()
+			return errors.Wrap(err, "failed to start client")
+		}
+	}
+
+	initRequest := mcp.InitializeRequest{}
+	initRequest.Params.ProtocolVersion = mcp.LATEST_PROTOCOL_VERSION
+	initRequest.Params.ClientInfo = mcp.Implementation{Name: "memos", Version: "1.0.0"}
+	if _, err := client.Initialize(ctx, initRequest); err != nil {
+		client.Close()
+		return errors.Wrap(err, "failed to initialize")
+	}
+	result, err := client.ListTools(ctx, mcp.ListToolsRequest{})
+	if err != nil {
+		client.Close()
+		return errors.Wrap(err, "failed to list tools")
+	}
+	c.client = client
+	c.tools = result.Tools
+	return nil
+}
+
+// listTools returns the tools of the server.
+func (c *mcpConn) listTools(ctx context.Context) ([]mcp.Tool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.connect(ctx); err != nil {
+		return nil, err
+	}
+	return c.tools, nil
+}
+
+// callTool calls a tool of the server, reconnecting once if the connection
+// was lost.
+func (c *mcpConn) callTool(ctx context.Context, name string, args map[string]any) (*mcp.CallToolResult, error) {
+	request := mcp.CallToolRequest{}
+	request.Params.Name = name
+	request.Params.Arguments = args
+
+	var err error
+	for attempt := 0; attempt < 2; attempt++ {
+		c.mu.Lock()
+		err = c.connect(ctx)
+		client := c.client
+		c.mu.Unlock()
+		if err != nil {
+			return nil, err
+		}
+
+		callCtx, cancel := context.WithTimeout(ctx, mcpCallTimeout)
+		var result *mcp.CallToolResult
+		result, err = client.CallTool(callCtx, request)
+		cancel()
+		if err == nil {
+			return result, nil
+		}
+		if ctx.Err() != nil {
+			return nil, err
+		}
+
+		c.mu.Lock()
+		if c.client == client {
+			c.reset()
+		}
+		c.mu.Unlock()
+	}
+	return nil, err
+}
+
+// mcpToolAdapter exposes a tool of an MCP server to the chat agent.
+type mcpToolAdapter struct {
+	conn *mcpConn
+	// name is the name the model calls the tool by; tool is its name on the server.
+	name        string
+	tool        string
+	description string
+}
+
+func (t *mcpToolAdapter) Name() string        { return t.name }
+func (t *mcpToolAdapter) Description() string { return t.description }
+func (t *mcpToolAdapter) Call(ctx context.Context, input string) (string, error) {
+	var args map[string]any
+	if strings.TrimSpace(input) != "" {
+		if err := json.Unmarshal([]byte(input), &args); err != nil {
+			return "", errors.Wrap(err, "invalid tool arguments")
+		}
+	}
+	result, err := t.conn.callTool(ctx, t.tool, args)
+	if err != nil {
+		return "", err
+	}
+	text := mcpResultText(result)
+	if result.IsError {
+		return "Error: " + text, nil
+	}
+	return text, nil
+}
+
+// mcpResultText joins the text content of a tool result. Other content, such
+// as images, is only noted since the model cannot see it.
+func mcpResultText(result *mcp.CallToolResult) string {
+	var parts []string
+	for _, content := range result.Content {
+		if text, ok := mcp.AsTextContent(content); ok {
+			parts = append(parts, text.Text)
+			continue
+		}
+		parts = append(parts, "[non-text content omitted]")
+	}
+	if len(parts) == 0 && result.StructuredContent != nil {
+		if data, err := json.Marshal(result.StructuredContent); err == nil {
+			parts = append(parts, string(data))
+		}
+	}
+	return strings.Join(parts, "\n")
+}
+
+// mcpToolName returns the name the model calls a server's tool by. Providers
+// only accept up to 64 letters, digits, "_" and "-".
+func mcpToolName(server, tool string) string {
+	name := mcpToolNameInvalidChars.ReplaceAllString(server+"__"+tool, "_")
+	if len(name) > 64 {
+		name = name[:64]
+	}
+	return name
+}
+
+// mcpToolDef converts the schema of a server's tool into a tool definition.
+func mcpToolDef(name, server string, tool mcp.Tool) llm.Tool {
+	properties := tool.InputSchema.Properties
+	required := tool.InputSchema.Required
+	defs := tool.InputSchema.Defs
+	if tool.RawInputSchema != nil {
+		var schema mcp.ToolInputSchema
+		if err := json.Unmarshal(tool.RawInputSchema, &schema); err == nil {
+			properties, required, defs = schema.Properties, schema.Required, schema.Defs
+		}
+	}
+	if properties == nil {
+		properties = map[string]any{}
+	}
+	if required == nil {
+		required = []string{}
+	}
+	description := fmt.Sprintf("[%s] %s", server, tool.Description)
+	def := buildToolDef(name, description, properties, required)
+	if len(defs) > 0 {
+		def.Function.Parameters["$defs"] = defs
+	}
+	return def
+}
+
+// loadMCPTools connects to the instance's and the user's MCP servers and
+// returns their tools with the definitions offered to the model. A server
+// that fails is skipped so the chat keeps working without it.
+func (s *APIV1Service) loadMCPTools(ctx context.Context, userSetting *storepb.AIUserSetting) (map[string]tools.Tool, []llm.Tool) {
+	instanceSetting, err := s.Store.GetInstanceAISetting(ctx)
+	if err != nil {
+		slog.Warn("failed to get instance AI setting", "err", err)
+		return nil, nil
+	}
+	servers := append([]*storepb.MCPServer{}, instanceSetting.GetMcpServers()...)
+	instanceServers := len(servers)
+	for _, server := range userSetting.GetMcpServers() {
+		// Servers of users are re-checked as their host may since resolve elsewhere.
+		if server.Command != "" || httpgetter.ValidateURL(server.Url) != nil {
+			slog.Warn("skipping MCP server", "server", server.Name)
+			continue
+		}
+		servers = append(servers, server)
+	}
+
+	toolMap := make(map[string]tools.Tool)
+	var defs []llm.Tool
+	seen := make(map[string]bool, len(servers))
+	for i, server := range servers {
+		// An instance server shadows a user server of the same name.
+		if seen[server.Name] {
+			continue
+		}
+		seen[server.Name] = true
+
+		conn := s.mcpPool.get(server, i >= instanceServers)
+		serverTools, err := conn.listTools(ctx)
+		if err != nil {
+			slog.Warn("failed to connect to MCP server", "server", server.Name, "err", err)
+			continue
+		}
+		for _, tool := range serverTools {
+			name := mcpToolName(server.Name, tool.Name)
+			if _, ok := toolMap[name]; ok {
+				continue
+			}
+			toolMap[name] = &mcpToolAdapter{conn: conn, name: name, tool: tool.Name, description: tool.Description}
+			defs = append(defs, mcpToolDef(name, server.Name, tool))
+		}
+	}
+	return toolMap, defs
+}
+
+// validateMCPServers checks servers before they are saved. Only admins may
+// register stdio servers and servers on private addresses.
+func validateMCPServers(servers []*v1pb.MCPServer, admin bool) error {
+	names := make(map[string]bool, len(servers))
+	for _, server := range servers {
+		if !mcpServerNamePattern.MatchString(server.Name) {
+			return errors.Errorf("invalid MCP server name %q: use up to 32 letters, digits, \"_\" and \"-\"", server.Name)
+		}
+		if names[server.Name] {
+			return errors.Errorf("duplicate MCP server name %q", server.Name)
+		}
+		names[server.Name] = true
+
+		if (server.Url == "") == (server.Command == "") {
+			return errors.Errorf("MCP server %q needs either a URL or a command", server.Name)
+		}
+		if server.Command != "" {
+			if !admin {
+				return errors.Errorf("MCP server %q: only admins can register command servers", server.Name)
+			}
+			for _, env := range server.Env {
+				if !strings.Contains(env, "=") {
+					return errors.Errorf("MCP server %q: environment variable %q must be formatted as KEY=VALUE", server.Name, env)
+				}
+			}
+			continue
+		}
+		if len(server.Args) > 0 || len(server.Env) > 0 {
+			return errors.Errorf("MCP server %q: args and env only apply to command servers", server.Name)
+		}
+		u, err := url.Parse(server.Url)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return errors.Errorf("MCP server %q: invalid URL %q", server.Name, server.Url)
+		}
+		if !admin {
+			if err := httpgetter.ValidateURL(server.Url); err != nil {
+				return errors.Wrapf(err, "MCP server %q", server.Name)
+			}
+		}
+	}
+	return nil
+}
+
+func convertMCPServersFromStore(servers []*storepb.MCPServer) []*v1pb.MCPServer {
+	result := make([]*v1pb.MCPServer, 0, len(servers))
+	for _, server := range servers {
+		result = append(result, &v1pb.MCPServer{
+			Name:    server.Name,
+			Url:     server.Url,
+			Headers: server.Headers,
+			Command: server.Command,
+			Args:    server.Args,
+			Env:     server.Env,
+		})
+	}
+	return result
+}
+
+func convertMCPServersToStore(servers []*v1pb.MCPServer) []*storepb.MCPServer {
+	result := make([]*storepb.MCPServer, 0, len(servers))
+	for _, server := range servers {
+		result = append(result, &storepb.MCPServer{
+			Name:    server.Name,
+			Url:     server.Url,
+			Headers: server.Headers,
+			Command: server.Command,
+			Args:    server.Args,
+			Env:     server.Env,
+		})
+	}
+	return result
+}
@@ -0,0 +1,99 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/plugin/httpgetter"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestMCPToolName(t *testing.T) {
+	require.Equal(t, "tracker__create_issue", mcpToolName("tracker", "create_issue"))
+	require.Equal(t, "calendar__events_list", mcpToolName("calendar", "events.list"))
+	require.Len(t, mcpToolName("server", strings.Repeat("a", 100)), 64)
+}
+
+func TestMCPToolDef(t *testing.T) {
+	tool := mcp.NewTool("create_issue",
+		mcp.WithDescription("Create an issue."),
+		mcp.WithString("title", mcp.Required()),
+	)
+	def := mcpToolDef("tracker__create_issue", "tracker", tool)
+	require.Equal(t, "tracker__create_issue", def.Function.Name)
+	require.Equal(t, "[tracker] Create an issue.", def.Function.Description)
+	require.Contains(t, def.Function.Parameters["properties"], "title")
+	require.Equal(t, []string{"title"}, def.Function.Parameters["required"])
+
+	raw := mcp.NewToolWithRawSchema("list_events", "List events.", json.RawMessage(`{"type":"object","properties":{"day":{"type":"string"}}}`))
+	def = mcpToolDef("calendar__list_events", "calendar", raw)
+	require.Contains(t, def.Function.Parameters["properties"], "day")
+	require.Equal(t, []string{}, def.Function.Parameters["required"])
+}
+
+func TestValidateMCPServers(t *testing.T) {
+	stdio := &v1pb.MCPServer{Name: "files", Command: "mcp-files", Env: []string{"ROOT=/data"}}
+	require.NoError(t, validateMCPServers([]*v1pb.MCPServer{stdio}, true))
+	require.Error(t, validateMCPServers([]*v1pb.MCPServer{stdio}, false))
+
+	private := &v1pb.MCPServer{Name: "tracker", Url: "http://127.0.0.1:8080/mcp"}
+	require.NoError(t, validateMCPServers([]*v1pb.MCPServer{private}, true))
+	require.Error(t, validateMCPServers([]*v1pb.MCPServer{private}, false))
+
+	for _, servers := range [][]*v1pb.MCPServer{
+		{{Name: "bad name", Url: "https://example.com/mcp"}},
+		{{Name: "tracker"}},
+		{{Name: "tracker", Url: "https://example.com/mcp", Command: "mcp-tracker"}},
+		{{Name: "tracker", Url: "ftp://example.com/mcp"}},
+		{{Name: "tracker", Url: "https://example.com/mcp", Args: []string{"-v"}}},
+		{{Name: "files", Command: "mcp-files", Env: []string{"ROOT"}}},
+		{private, private},
+	} {
+		require.Error(t, validateMCPServers(servers, true))
+	}
+}
+
+func TestMCPToolAdapter(t *testing.T) {
+	mcpServer := server.NewMCPServer("tracker", "1.0.0")
+	mcpServer.AddTool(mcp.NewTool("create_issue", mcp.WithString("title", mcp.Required())),
+		func(_ context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			title, err := request.RequireString("title")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			return mcp.NewToolResultText("created " + title), nil
+		})
+	ts := server.NewTestStreamableHTTPServer(mcpServer)
+	defer ts.Close()
+
+	var pool mcpPool
+	conn := pool.get(&storepb.MCPServer{Name: "tracker", Url: ts.URL + "/mcp"}, false)
+	defer conn.close()
+	ctx := context.Background()
+	tools, err := conn.listTools(ctx)
+	require.NoError(t, err)
+	require.Len(t, tools, 1)
+
+	adapter := &mcpToolAdapter{conn: conn, name: "tracker__create_issue", tool: "create_issue"}
+	result, err := adapter.Call(ctx, `{"title":"Fix login"}`)
+	require.NoError(t, err)
+	require.Equal(t, "created Fix login", result)
+
+	result, err = adapter.Call(ctx, `{}`)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(result, "Error: "))
+
+	// Servers of users may not be on internal addresses, whatever their URL
+	// resolved to when they were registered.
+	restricted := pool.get(&storepb.MCPServer{Name: "tracker", Url: ts.URL + "/mcp"}, true)
+	defer restricted.close()
+	_, err = restricted.listTools(ctx)
+	require.ErrorIs(t, err, httpgetter.ErrInternalIP)
+}
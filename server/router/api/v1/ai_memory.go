@@ -0,0 +1,285 @@
+package v1
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	"github.com/tmc/langchaingo/tools"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/util"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// The assistant keeps durable facts about each user, such as their projects,
+// conventions and preferences, across chat sessions. It saves and forgets
+// them with the remember and forget tools; the memories most relevant to a
+// turn are part of its system prompt, and users review them through the API.
+
+const (
+	// maxAIMemoryLength caps the characters of a memory.
+	maxAIMemoryLength = 1000
+	// maxPromptAIMemories caps the memories in the system prompt of a turn.
+	maxPromptAIMemories = 20
+)
+
+// extractAIMemoryFromName returns the user ID and memory ID of a memory name.
+// Format: users/{user}/aiMemories/{ai_memory}.
+func extractAIMemoryFromName(name string) (int32, int32, error) {
+	tokens, err := GetNameParentTokens(name, UserNamePrefix, AIMemoryNamePrefix)
+	if err != nil {
+		return 0, 0, err
+	}
+	userID, err := util.ConvertStringToInt32(tokens[0])
+	if err != nil {
+		return 0, 0, errors.Errorf("invalid user ID %q", tokens[0])
+	}
+	id, err := util.ConvertStringToInt32(tokens[1])
+	if err != nil {
+		return 0, 0, errors.Errorf("invalid memory ID %q", tokens[1])
+	}
+	return userID, id, nil
+}
+
+func constructAIMemoryName(memory *store.AIMemory) string {
+	return fmt.Sprintf("%s%d/%s%d", UserNamePrefix, memory.UserID, AIMemoryNamePrefix, memory.ID)
+}
+
+func convertAIMemoryFromStore(memory *store.AIMemory) *v1pb.AIMemory {
+	return &v1pb.AIMemory{
+		Name:       constructAIMemoryName(memory),
+		Content:    memory.Content,
+		CreateTime: timestamppb.New(time.Unix(memory.CreatedTs, 0)),
+		UpdateTime: timestamppb.New(time.Unix(memory.UpdatedTs, 0)),
+	}
+}
+
+func (s *APIV1Service) ListAIMemories(ctx context.Context, request *v1pb.ListAIMemoriesRequest) (*v1pb.ListAIMemoriesResponse, error) {
+	userID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	currentUser, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil || currentUser.ID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	memories, err := s.Store.ListAIMemories(ctx, &store.FindAIMemory{UserID: &userID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memories: %v", err)
+	}
+	response := &v1pb.ListAIMemoriesResponse{}
+	for _, memory := range memories {
+		response.AiMemories = append(response.AiMemories, convertAIMemoryFromStore(memory))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) UpdateAIMemory(ctx context.Context, request *v1pb.UpdateAIMemoryRequest) (*v1pb.AIMemory, error) {
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask is required")
+	}
+	memory, err := s.getAIMemory(ctx, request.GetAiMemory().GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	update := &store.UpdateAIMemory{ID: memory.ID}
+	for _, field := range request.UpdateMask.Paths {
+		switch field {
+		case "content":
+			content, err := normalizeAIMemory(request.AiMemory.Content)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+			}
+			update.Content = &content
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported field in update mask: %s", field)
+		}
+	}
+	updated, err := s.Store.UpdateAIMemory(ctx, update)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update memory: %v", err)
+	}
+	return convertAIMemoryFromStore(updated), nil
+}
+
+func (s *APIV1Service) DeleteAIMemory(ctx context.Context, request *v1pb.DeleteAIMemoryRequest) (*emptypb.Empty, error) {
+	memory, err := s.getAIMemory(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Store.DeleteAIMemory(ctx, memory.ID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete memory: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// getAIMemory returns the current user's memory of the given name.
+func (s *APIV1Service) getAIMemory(ctx context.Context, name string) (*store.AIMemory, error) {
+	userID, id, err := extractAIMemoryFromName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memory name: %v", err)
+	}
+	currentUser, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if currentUser.ID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	memory, err := s.Store.GetAIMemory(ctx, &store.FindAIMemory{ID: &id})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memory: %v", err)
+	}
+	if memory == nil || memory.UserID != currentUser.ID {
+		return nil, status.Errorf(codes.NotFound, "memory not found")
+	}
+	return memory, nil
+}
+
+// normalizeAIMemory trims a memory and checks that it is neither empty nor
+// too long.
+func normalizeAIMemory(content string) (string, error) {
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return "", errors.New("content is required")
+	}
+	if utf8.RuneCountInString(content) > maxAIMemoryLength {
+		return "", errors.Errorf("content must be at most %d characters", maxAIMemoryLength)
+	}
+	return content, nil
+}
+
+// loadAIMemories returns the user's memories most relevant to query.
+func (s *APIV1Service) loadAIMemories(ctx context.Context, userID int32, query string) []*store.AIMemory {
+	memories, err := s.Store.ListAIMemories(ctx, &store.FindAIMemory{UserID: &userID})
+	if err != nil {
+		slog.Warn("failed to list AI memories", "user", userID, "err", err)
+		return nil
+	}
+	return relevantAIMemories(memories, query, maxPromptAIMemories)
+}
+
+// relevantAIMemories returns up to limit memories, preferring those sharing
+// the most terms with query. memories are most recently updated first, which
+// breaks ties.
+func relevantAIMemories(memories []*store.AIMemory, query string, limit int) []*store.AIMemory {
+	if len(memories) <= limit {
+		return memories
+	}
+	terms := searchTerms(query)
+	scores := make(map[int32]int, len(memories))
+	for _, memory := range memories {
+		content := strings.ToLower(memory.Content)
+		for _, term := range terms {
+			if strings.Contains(content, term) {
+				scores[memory.ID]++
+			}
+		}
+	}
+	relevant := slices.Clone(memories)
+	slices.SortStableFunc(relevant, func(a, b *store.AIMemory) int {
+		return cmp.Compare(scores[b.ID], scores[a.ID])
+	})
+	return relevant[:limit]
+}
+
+// ─────────────────────────────────────────────────────────────────────────────
+// Helper: Remember tool
+// ─────────────────────────────────────────────────────────────────────────────
+
+type rememberTool struct {
+	store  *store.Store
+	userID int32
+}
+
+func newRememberTool(s *store.Store, userID int32) tools.Tool {
+	return &rememberTool{store: s, userID: userID}
+}
+
+func (t *rememberTool) Name() string { return "remember" }
+func (t *rememberTool) Description() string {
+	return "Save a durable fact or preference about the user for future conversations. Input must be a JSON string with key `content` (string)."
+}
+func (t *rememberTool) Call(ctx context.Context, input string) (string, error) {
+	slog.Info("[AGENT TOOL CALL]", "tool", t.Name(), "input", input)
+	var payload struct {
+		Content string `json:"content"`
+	}
+	if err := json.Unmarshal([]byte(input), &payload); err != nil {
+		return "Error: failed to parse input JSON.", nil
+	}
+	content, err := normalizeAIMemory(payload.Content)
+	if err != nil {
+		return "Error: " + err.Error() + ".", nil
+	}
+
+	memories, err := t.store.ListAIMemories(ctx, &store.FindAIMemory{UserID: &t.userID})
+	if err != nil {
+		return "Error loading memories: " + err.Error(), nil
+	}
+	for _, memory := range memories {
+		if strings.EqualFold(memory.Content, content) {
+			return fmt.Sprintf("Already remembered as memory %d.", memory.ID), nil
+		}
+	}
+	memory, err := t.store.CreateAIMemory(ctx, &store.AIMemory{UserID: t.userID, Content: content})
+	if err != nil {
+		return "Error saving memory: " + err.Error(), nil
+	}
+	return fmt.Sprintf("Remembered as memory %d.", memory.ID), nil
+}
+
+// ─────────────────────────────────────────────────────────────────────────────
+// Helper: Forget tool
+// ─────────────────────────────────────────────────────────────────────────────
+
+type forgetTool struct {
+	store  *store.Store
+	userID int32
+}
+
+func newForgetTool(s *store.Store, userID int32) tools.Tool {
+	return &forgetTool{store: s, userID: userID}
+}
+
+func (t *forgetTool) Name() string { return "forget" }
+func (t *forgetTool) Description() string {
+	return "Delete a remembered fact that is wrong or outdated. Input must be a JSON string with key `id` (number)."
+}
+func (t *forgetTool) Call(ctx context.Context, input string) (string, error) {
+	slog.Info("[AGENT TOOL CALL]", "tool", t.Name(), "input", input)
+	var payload struct {
+		ID int32 `json:"id"`
+	}
+	if err := json.Unmarshal([]byte(input), &payload); err != nil {
+		return "Error: failed to parse input JSON.", nil
+	}
+	memory, err := t.store.GetAIMemory(ctx, &store.FindAIMemory{ID: &payload.ID})
+	if err != nil || memory == nil || memory.UserID != t.userID {
+		return "Error: memory not found.", nil
+	}
+	if err := t.store.DeleteAIMemory(ctx, memory.ID); err != nil {
+		return "Error deleting memory: " + err.Error(), nil
+	}
+	return fmt.Sprintf("Forgot memory %d.", memory.ID), nil
+}
@@ -0,0 +1,27 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestRelevantAIMemories(t *testing.T) {
+	// Most recently updated first, as the store lists them.
+	memories := []*store.AIMemory{
+		{ID: 4, Content: "Prefers short answers."},
+		{ID: 3, Content: "The garden project tracks basil and tomatoes."},
+		{ID: 2, Content: "Uses metric units."},
+		{ID: 1, Content: "Garden notes are tagged #garden."},
+	}
+	require.Equal(t, memories, relevantAIMemories(memories, "anything", 4))
+
+	relevant := relevantAIMemories(memories, "How is the garden doing?", 2)
+	require.Equal(t, []int32{3, 1}, []int32{relevant[0].ID, relevant[1].ID})
+
+	// Without matches the most recent memories are kept.
+	relevant = relevantAIMemories(memories, "hello", 2)
+	require.Equal(t, []int32{4, 3}, []int32{relevant[0].ID, relevant[1].ID})
+}
@@ -0,0 +1,200 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/plugin/llm"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// Every LLM call made for a user goes through chatLLM, which checks the user's
+// monthly token quota beforehand and records the tokens the call spent.
+
+// Kinds of AI usage.
+const (
+	aiUsageChat       = "chat"
+	aiUsageCompaction = "compaction"
+	aiUsageTitle      = "title"
+	aiUsageCompletion = "completion"
+	aiUsageTagging    = "tagging"
+)
+
+// quotaExceededError reports that a user has spent their monthly token quota.
+type quotaExceededError struct {
+	used, quota int64
+	resetsAt    time.Time
+}
+
+func (e *quotaExceededError) Error() string {
+	return fmt.Sprintf("monthly AI token quota exceeded: %d of %d tokens used, the quota resets on %s",
+		e.used, e.quota, e.resetsAt.Format(time.DateOnly))
+}
+
+// chatLLM sends a chat request on the user's behalf, streaming the reply to
+// onDelta when it is set.
+func (s *APIV1Service) chatLLM(ctx context.Context, user *store.User, kind string, req *llm.ChatRequest, onDelta func(delta string)) (*llm.ChatResponse, error) {
+	if err := s.checkAIQuota(ctx, user); err != nil {
+		return nil, err
+	}
+	var resp *llm.ChatResponse
+	var err error
+	if onDelta != nil {
+		resp, err = s.LLM.ChatStream(ctx, req, onDelta)
+	} else {
+		resp, err = s.LLM.Chat(ctx, req)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	model := req.Model
+	if model == "" {
+		model = s.LLM.Model()
+	}
+	// Record the usage even if the client has gone away in the meantime.
+	if _, err := s.Store.CreateAIUsage(context.WithoutCancel(ctx), &store.AIUsage{
+		UserID:           user.ID,
+		Kind:             kind,
+		Model:            model,
+		PromptTokens:     int32(resp.Usage.PromptTokens),
+		CompletionTokens: int32(resp.Usage.CompletionTokens),
+	}); err != nil {
+		slog.Warn("failed to record AI usage", "user", user.ID, "err", err)
+	}
+	return resp, nil
+}
+
+// checkAIQuota returns a *quotaExceededError once the user has spent their
+// monthly token quota.
+func (s *APIV1Service) checkAIQuota(ctx context.Context, user *store.User) error {
+	setting, err := s.Store.GetInstanceAISetting(ctx)
+	if err != nil {
+		return err
+	}
+	quota := monthlyTokenQuota(setting, user)
+	if quota <= 0 {
+		return nil
+	}
+	start, end := usageMonth(time.Now())
+	startTs := start.Unix()
+	sums, err := s.Store.SumAIUsage(ctx, &store.FindAIUsage{UserID: &user.ID, CreatedTsAfter: &startTs})
+	if err != nil {
+		return errors.Wrap(err, "failed to sum AI usage")
+	}
+	var used int64
+	if len(sums) > 0 {
+		used = sums[0].TotalTokens()
+	}
+	if used >= quota {
+		return &quotaExceededError{used: used, quota: quota, resetsAt: end}
+	}
+	return nil
+}
+
+// checkAIQuotaStatus is checkAIQuota for the RPCs, which check before they
+// start streaming so that an exhausted quota fails the call itself.
+func (s *APIV1Service) checkAIQuotaStatus(ctx context.Context, user *store.User) error {
+	err := s.checkAIQuota(ctx, user)
+	var quotaErr *quotaExceededError
+	if errors.As(err, &quotaErr) {
+		return status.Errorf(codes.ResourceExhausted, "%s", quotaErr.Error())
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check AI quota: %v", err)
+	}
+	return nil
+}
+
+// estimateTokens counts the tokens of text that no call has measured,
+// such as the user's own messages and tool results: providers only report
+// usage per call.
+func estimateTokens(text string) int32 {
+	return int32(llm.CountTokens(text))
+}
+
+// monthlyTokenQuota returns the user's monthly token quota; 0 means unlimited.
+// A quota set for the user wins over one set for their role, which wins over
+// the default.
+func monthlyTokenQuota(setting *storepb.InstanceAISetting, user *store.User) int64 {
+	if quota, ok := setting.GetUserMonthlyTokenQuotas()[user.ID]; ok {
+		return quota
+	}
+	if quota, ok := setting.GetRoleMonthlyTokenQuotas()[user.Role.String()]; ok {
+		return quota
+	}
+	return setting.GetMonthlyTokenQuota()
+}
+
+// usageMonth returns the start of the UTC calendar month containing t and the
+// start of the next one.
+func usageMonth(t time.Time) (time.Time, time.Time) {
+	t = t.UTC()
+	start := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	return start, start.AddDate(0, 1, 0)
+}
+
+// GetAIUsage reports every user's token usage for a calendar month, given as
+// YYYY-MM and defaulting to the current one. Admins only.
+func (s *APIV1Service) GetAIUsage(ctx context.Context, request *v1pb.GetAIUsageRequest) (*v1pb.AIUsage, error) {
+	currentUser, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if currentUser.Role != store.RoleAdmin {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	month := time.Now()
+	if request.Month != "" {
+		month, err = time.Parse("2006-01", request.Month)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "month must be formatted as YYYY-MM")
+		}
+	}
+	start, end := usageMonth(month)
+	startTs, endTs := start.Unix(), end.Unix()
+
+	sums, err := s.Store.SumAIUsage(ctx, &store.FindAIUsage{CreatedTsAfter: &startTs, CreatedTsBefore: &endTs})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to sum AI usage: %v", err)
+	}
+	setting, err := s.Store.GetInstanceAISetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance AI setting: %v", err)
+	}
+
+	usage := &v1pb.AIUsage{
+		StartTime: timestamppb.New(start),
+		EndTime:   timestamppb.New(end),
+		Users:     make([]*v1pb.AIUsage_UserUsage, 0, len(sums)),
+	}
+	for _, sum := range sums {
+		userUsage := &v1pb.AIUsage_UserUsage{
+			User:             fmt.Sprintf("%s%d", UserNamePrefix, sum.UserID),
+			Calls:            sum.Calls,
+			PromptTokens:     sum.PromptTokens,
+			CompletionTokens: sum.CompletionTokens,
+			TotalTokens:      sum.TotalTokens(),
+			Quota:            setting.GetMonthlyTokenQuota(),
+		}
+		// Usage outlives deleted users, who are reported without a username.
+		if u, err := s.Store.GetUser(ctx, &store.FindUser{ID: &sum.UserID}); err == nil && u != nil {
+			userUsage.Username = u.Username
+			userUsage.Quota = monthlyTokenQuota(setting, u)
+		}
+		usage.Users = append(usage.Users, userUsage)
+	}
+	return usage, nil
+}
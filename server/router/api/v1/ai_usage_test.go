@@ -0,0 +1,30 @@
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestMonthlyTokenQuota(t *testing.T) {
+	setting := &storepb.InstanceAISetting{
+		MonthlyTokenQuota:      1000,
+		RoleMonthlyTokenQuotas: map[string]int64{"ADMIN": 0},
+		UserMonthlyTokenQuotas: map[int32]int64{3: 50},
+	}
+	require.Equal(t, int64(1000), monthlyTokenQuota(setting, &store.User{ID: 2, Role: store.RoleUser}))
+	require.Equal(t, int64(0), monthlyTokenQuota(setting, &store.User{ID: 1, Role: store.RoleAdmin}))
+	require.Equal(t, int64(50), monthlyTokenQuota(setting, &store.User{ID: 3, Role: store.RoleUser}))
+	// No setting means no quota.
+	require.Equal(t, int64(0), monthlyTokenQuota(nil, &store.User{ID: 2, Role: store.RoleUser}))
+}
+
+func TestUsageMonth(t *testing.T) {
+	start, end := usageMonth(time.Date(2026, time.December, 31, 23, 30, 0, 0, time.FixedZone("", -3600)))
+	require.Equal(t, time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC), start)
+	require.Equal(t, time.Date(2027, time.February, 1, 0, 0, 0, 0, time.UTC), end)
+}
@@ -141,6 +141,8 @@ func (s *APIV1Service) CreateAttachment(ctx context.Context, request *v1pb.Creat
 		}
 	}
 
+	// Saving moves the blob out of the attachment unless it is stored in the database.
+	blob := create.Blob
 	if err := SaveAttachmentBlob(ctx, s.Profile, s.Store, create); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to save attachment blob: %v", err)
 	}
@@ -163,6 +165,7 @@ func (s *APIV1Service) CreateAttachment(ctx context.Context, request *v1pb.Creat
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create attachment: %v", err)
 	}
+	s.extractAttachmentText(attachment, blob)
 
 	return convertAttachmentFromStore(attachment), nil
 }
@@ -330,6 +333,10 @@ func (s *APIV1Service) DeleteAttachment(ctx context.Context, request *v1pb.Delet
 	}); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete attachment: %v", err)
 	}
+	// Drop the attachment's text from the memo's index.
+	if attachment.MemoID != nil {
+		s.reindexMemo(ctx, *attachment.MemoID)
+	}
 	return &emptypb.Empty{}, nil
 }
 
@@ -0,0 +1,139 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+	"runtime/debug"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/textextract"
+	"github.com/usememos/memos/store"
+)
+
+// extractAttachmentTextBatchSize is how many attachments ExtractAttachmentTexts
+// looks at per query.
+const extractAttachmentTextBatchSize = 100
+
+// extractAttachmentText extracts the text of a new attachment in the background
+// so that it can be searched, then re-indexes the memo it belongs to.
+func (s *APIV1Service) extractAttachmentText(attachment *store.Attachment, blob []byte) {
+	if !textextract.Supported(attachment.Type, attachment.Filename) {
+		return
+	}
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				slog.Error("extracting attachment text panicked", "attachment", attachment.UID, "panic", r, "stack", string(debug.Stack()))
+			}
+		}()
+		ctx := context.Background()
+		if err := s.saveAttachmentText(ctx, attachment, blob); err != nil {
+			slog.Warn("failed to extract attachment text", "attachment", attachment.UID, "err", err)
+			return
+		}
+		// The attachment may have been linked to a memo while extracting.
+		attachment, err := s.Store.GetAttachment(ctx, &store.FindAttachment{ID: &attachment.ID})
+		if err != nil || attachment == nil || attachment.MemoID == nil {
+			return
+		}
+		s.reindexMemo(ctx, *attachment.MemoID)
+	}()
+}
+
+// saveAttachmentText extracts and stores the text of an attachment. blob may be
+// nil, in which case it is read from storage. Attachments without text are
+// stored as empty so they are not tried again.
+func (s *APIV1Service) saveAttachmentText(ctx context.Context, attachment *store.Attachment, blob []byte) error {
+	if blob == nil {
+		var err error
+		if blob, err = s.GetAttachmentBlob(attachment); err != nil {
+			return errors.Wrap(err, "failed to read attachment blob")
+		}
+	}
+	text, err := textextract.Extract(attachment.Type, attachment.Filename, blob)
+	if err != nil {
+		slog.Debug("no text extracted from attachment", "attachment", attachment.UID, "err", err)
+		text = ""
+	}
+	if _, err := s.Store.UpsertAttachmentText(ctx, &store.AttachmentText{
+		AttachmentID: attachment.ID,
+		Content:      text,
+	}); err != nil {
+		return errors.Wrap(err, "failed to save attachment text")
+	}
+	return nil
+}
+
+// reindexMemo re-indexes a memo after the text of its attachments changed.
+func (s *APIV1Service) reindexMemo(ctx context.Context, memoID int32) {
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &memoID})
+	if err != nil || memo == nil {
+		return
+	}
+	s.upsertMemoVector(ctx, memo)
+}
+
+// ExtractAttachmentTexts extracts the text of attachments uploaded before text
+// extraction existed, or whose extraction was interrupted, and re-indexes the
+// memos they belong to.
+func (s *APIV1Service) ExtractAttachmentTexts(ctx context.Context) {
+	extracted := 0
+	memoIDs := map[int32]bool{}
+	for offset := 0; ; offset += extractAttachmentTextBatchSize {
+		limit := extractAttachmentTextBatchSize
+		attachments, err := s.Store.ListAttachments(ctx, &store.FindAttachment{
+			Limit:  &limit,
+			Offset: &offset,
+		})
+		if err != nil {
+			slog.Warn("failed to list attachments for text extraction", "err", err)
+			return
+		}
+		ids := make([]int32, 0, len(attachments))
+		for _, attachment := range attachments {
+			ids = append(ids, attachment.ID)
+		}
+		texts, err := s.Store.ListAttachmentTexts(ctx, &store.FindAttachmentText{
+			AttachmentIDList: ids,
+			ExcludeContent:   true,
+			IncludeEmpty:     true,
+		})
+		if err != nil {
+			slog.Warn("failed to list attachment texts", "err", err)
+			return
+		}
+		done := make(map[int32]bool, len(texts))
+		for _, text := range texts {
+			done[text.AttachmentID] = true
+		}
+
+		for _, attachment := range attachments {
+			if done[attachment.ID] || !textextract.Supported(attachment.Type, attachment.Filename) {
+				continue
+			}
+			// Listing leaves out blobs stored in the database.
+			attachment, err := s.Store.GetAttachment(ctx, &store.FindAttachment{ID: &attachment.ID, GetBlob: true})
+			if err != nil || attachment == nil {
+				continue
+			}
+			if err := s.saveAttachmentText(ctx, attachment, nil); err != nil {
+				slog.Warn("failed to extract attachment text", "attachment", attachment.UID, "err", err)
+				continue
+			}
+			extracted++
+			if attachment.MemoID != nil {
+				memoIDs[*attachment.MemoID] = true
+			}
+		}
+		if len(attachments) < extractAttachmentTextBatchSize {
+			break
+		}
+	}
+	for memoID := range memoIDs {
+		s.reindexMemo(ctx, memoID)
+	}
+	if extracted > 0 {
+		slog.Info("extracted attachment texts", "count", extracted)
+	}
+}
@@ -42,6 +42,7 @@ func (s *ConnectServiceHandler) RegisterConnectHandlers(mux *http.ServeMux, opts
 		wrap(apiv1connect.NewShortcutServiceHandler(s, opts...)),
 		wrap(apiv1connect.NewActivityServiceHandler(s, opts...)),
 		wrap(apiv1connect.NewIdentityProviderServiceHandler(s, opts...)),
+		wrap(apiv1connect.NewAIServiceHandler(s, opts...)),
 	}
 
 	for _, h := range handlers {
@@ -39,6 +39,14 @@ func (s *ConnectServiceHandler) UpdateInstanceSetting(ctx context.Context, req *
 	return connect.NewResponse(resp), nil
 }
 
+func (s *ConnectServiceHandler) GetEmbeddingIndex(ctx context.Context, req *connect.Request[v1pb.GetEmbeddingIndexRequest]) (*connect.Response[v1pb.EmbeddingIndex], error) {
+	resp, err := s.APIV1Service.GetEmbeddingIndex(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
 // AuthService
 //
 // Auth service methods need special handling for response headers (cookies).
@@ -249,6 +257,14 @@ func (s *ConnectServiceHandler) ListMemos(ctx context.Context, req *connect.Requ
 	return connect.NewResponse(resp), nil
 }
 
+func (s *ConnectServiceHandler) SearchMemos(ctx context.Context, req *connect.Request[v1pb.SearchMemosRequest]) (*connect.Response[v1pb.SearchMemosResponse], error) {
+	resp, err := s.APIV1Service.SearchMemos(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
 func (s *ConnectServiceHandler) GetMemo(ctx context.Context, req *connect.Request[v1pb.GetMemoRequest]) (*connect.Response[v1pb.Memo], error) {
 	resp, err := s.APIV1Service.GetMemo(ctx, req.Msg)
 	if err != nil {
@@ -265,6 +281,14 @@ func (s *ConnectServiceHandler) UpdateMemo(ctx context.Context, req *connect.Req
 	return connect.NewResponse(resp), nil
 }
 
+func (s *ConnectServiceHandler) AcceptMemoTagSuggestions(ctx context.Context, req *connect.Request[v1pb.AcceptMemoTagSuggestionsRequest]) (*connect.Response[v1pb.Memo], error) {
+	resp, err := s.APIV1Service.AcceptMemoTagSuggestions(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
 func (s *ConnectServiceHandler) DeleteMemo(ctx context.Context, req *connect.Request[v1pb.DeleteMemoRequest]) (*connect.Response[emptypb.Empty], error) {
 	resp, err := s.APIV1Service.DeleteMemo(ctx, req.Msg)
 	if err != nil {
@@ -305,6 +329,14 @@ func (s *ConnectServiceHandler) ListMemoRelations(ctx context.Context, req *conn
 	return connect.NewResponse(resp), nil
 }
 
+func (s *ConnectServiceHandler) ListRelatedMemos(ctx context.Context, req *connect.Request[v1pb.ListRelatedMemosRequest]) (*connect.Response[v1pb.ListRelatedMemosResponse], error) {
+	resp, err := s.APIV1Service.ListRelatedMemos(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
 func (s *ConnectServiceHandler) CreateMemoComment(ctx context.Context, req *connect.Request[v1pb.CreateMemoCommentRequest]) (*connect.Response[v1pb.Memo], error) {
 	resp, err := s.APIV1Service.CreateMemoComment(ctx, req.Msg)
 	if err != nil {
@@ -488,3 +520,148 @@ func (s *ConnectServiceHandler) DeleteIdentityProvider(ctx context.Context, req
 	}
 	return connect.NewResponse(resp), nil
 }
+
+// AIService
+//
+// Streaming methods send on a connectServerStream, which adapts the Connect
+// stream to the gRPC stream the service methods expect.
+
+func (s *ConnectServiceHandler) ListAISessions(ctx context.Context, req *connect.Request[v1pb.ListAISessionsRequest]) (*connect.Response[v1pb.ListAISessionsResponse], error) {
+	resp, err := s.APIV1Service.ListAISessions(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) SearchAISessions(ctx context.Context, req *connect.Request[v1pb.SearchAISessionsRequest]) (*connect.Response[v1pb.SearchAISessionsResponse], error) {
+	resp, err := s.APIV1Service.SearchAISessions(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) GetAISession(ctx context.Context, req *connect.Request[v1pb.GetAISessionRequest]) (*connect.Response[v1pb.AISession], error) {
+	resp, err := s.APIV1Service.GetAISession(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) CreateAISession(ctx context.Context, req *connect.Request[v1pb.CreateAISessionRequest]) (*connect.Response[v1pb.AISession], error) {
+	resp, err := s.APIV1Service.CreateAISession(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) UpdateAISession(ctx context.Context, req *connect.Request[v1pb.UpdateAISessionRequest]) (*connect.Response[v1pb.AISession], error) {
+	resp, err := s.APIV1Service.UpdateAISession(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) DeleteAISession(ctx context.Context, req *connect.Request[v1pb.DeleteAISessionRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.DeleteAISession(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) ListAIMessages(ctx context.Context, req *connect.Request[v1pb.ListAIMessagesRequest]) (*connect.Response[v1pb.ListAIMessagesResponse], error) {
+	resp, err := s.APIV1Service.ListAIMessages(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) ListAIBranches(ctx context.Context, req *connect.Request[v1pb.ListAIBranchesRequest]) (*connect.Response[v1pb.ListAIBranchesResponse], error) {
+	resp, err := s.APIV1Service.ListAIBranches(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) SwitchAIBranch(ctx context.Context, req *connect.Request[v1pb.SwitchAIBranchRequest]) (*connect.Response[v1pb.AISession], error) {
+	resp, err := s.APIV1Service.SwitchAIBranch(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) Chat(ctx context.Context, req *connect.Request[v1pb.ChatRequest], stream *connect.ServerStream[v1pb.AIChatEvent]) error {
+	return convertGRPCError(s.APIV1Service.Chat(req.Msg, newConnectServerStream(ctx, stream)))
+}
+
+func (s *ConnectServiceHandler) RegenerateAIMessage(ctx context.Context, req *connect.Request[v1pb.RegenerateAIMessageRequest], stream *connect.ServerStream[v1pb.AIChatEvent]) error {
+	return convertGRPCError(s.APIV1Service.RegenerateAIMessage(req.Msg, newConnectServerStream(ctx, stream)))
+}
+
+func (s *ConnectServiceHandler) ConfirmAIAction(ctx context.Context, req *connect.Request[v1pb.ConfirmAIActionRequest], stream *connect.ServerStream[v1pb.AIChatEvent]) error {
+	return convertGRPCError(s.APIV1Service.ConfirmAIAction(req.Msg, newConnectServerStream(ctx, stream)))
+}
+
+func (s *ConnectServiceHandler) CancelAITurn(ctx context.Context, req *connect.Request[v1pb.CancelAITurnRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.CancelAITurn(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) ResumeAITurn(ctx context.Context, req *connect.Request[v1pb.ResumeAITurnRequest], stream *connect.ServerStream[v1pb.AIChatEvent]) error {
+	return convertGRPCError(s.APIV1Service.ResumeAITurn(req.Msg, newConnectServerStream(ctx, stream)))
+}
+
+func (s *ConnectServiceHandler) SaveAISessionAsMemo(ctx context.Context, req *connect.Request[v1pb.SaveAISessionAsMemoRequest]) (*connect.Response[v1pb.Memo], error) {
+	resp, err := s.APIV1Service.SaveAISessionAsMemo(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) ListAIMemories(ctx context.Context, req *connect.Request[v1pb.ListAIMemoriesRequest]) (*connect.Response[v1pb.ListAIMemoriesResponse], error) {
+	resp, err := s.APIV1Service.ListAIMemories(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) UpdateAIMemory(ctx context.Context, req *connect.Request[v1pb.UpdateAIMemoryRequest]) (*connect.Response[v1pb.AIMemory], error) {
+	resp, err := s.APIV1Service.UpdateAIMemory(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) DeleteAIMemory(ctx context.Context, req *connect.Request[v1pb.DeleteAIMemoryRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.DeleteAIMemory(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) GenerateCompletion(ctx context.Context, req *connect.Request[v1pb.GenerateCompletionRequest], stream *connect.ServerStream[v1pb.GenerateCompletionResponse]) error {
+	return convertGRPCError(s.APIV1Service.GenerateCompletion(req.Msg, newConnectServerStream(ctx, stream)))
+}
+
+func (s *ConnectServiceHandler) GetAIUsage(ctx context.Context, req *connect.Request[v1pb.GetAIUsageRequest]) (*connect.Response[v1pb.AIUsage], error) {
+	resp, err := s.APIV1Service.GetAIUsage(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
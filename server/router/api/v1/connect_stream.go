@@ -0,0 +1,68 @@
+package v1
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// connectServerStream adapts a Connect server stream to the gRPC server stream
+// that streaming service methods send on, so that they serve both protocols the
+// way unary methods do.
+type connectServerStream[T any] struct {
+	ctx    context.Context
+	stream *connect.ServerStream[T]
+}
+
+var _ grpc.ServerStreamingServer[struct{}] = (*connectServerStream[struct{}])(nil)
+
+func newConnectServerStream[T any](ctx context.Context, stream *connect.ServerStream[T]) *connectServerStream[T] {
+	return &connectServerStream[T]{ctx: ctx, stream: stream}
+}
+
+func (s *connectServerStream[T]) Send(msg *T) error {
+	return s.stream.Send(msg)
+}
+
+func (s *connectServerStream[T]) Context() context.Context {
+	return s.ctx
+}
+
+// SetHeader adds headers to the response; they are sent with the first message.
+func (s *connectServerStream[T]) SetHeader(md metadata.MD) error {
+	for key, values := range md {
+		for _, value := range values {
+			s.stream.ResponseHeader().Add(key, value)
+		}
+	}
+	return nil
+}
+
+func (s *connectServerStream[T]) SendHeader(md metadata.MD) error {
+	return s.SetHeader(md)
+}
+
+func (s *connectServerStream[T]) SetTrailer(md metadata.MD) {
+	for key, values := range md {
+		for _, value := range values {
+			s.stream.ResponseTrailer().Add(key, value)
+		}
+	}
+}
+
+func (s *connectServerStream[T]) SendMsg(m any) error {
+	msg, ok := m.(*T)
+	if !ok {
+		return errors.Errorf("unexpected message type %T", m)
+	}
+	return s.stream.Send(msg)
+}
+
+// RecvMsg fails, as server streams take a single request, which Connect has
+// already read.
+func (*connectServerStream[T]) RecvMsg(any) error {
+	return errors.New("server streams do not receive messages")
+}
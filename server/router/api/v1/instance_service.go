@@ -3,11 +3,16 @@ package v1
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"slices"
+	"strings"
 
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/usememos/memos/plugin/llm"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
@@ -46,6 +51,8 @@ func (s *APIV1Service) GetInstanceSetting(ctx context.Context, request *v1pb.Get
 		_, err = s.Store.GetInstanceMemoRelatedSetting(ctx)
 	case storepb.InstanceSettingKey_STORAGE:
 		_, err = s.Store.GetInstanceStorageSetting(ctx)
+	case storepb.InstanceSettingKey_AI:
+		_, err = s.Store.GetInstanceAISetting(ctx)
 	default:
 		return nil, status.Errorf(codes.InvalidArgument, "unsupported instance setting key: %v", instanceSettingKey)
 	}
@@ -63,8 +70,8 @@ func (s *APIV1Service) GetInstanceSetting(ctx context.Context, request *v1pb.Get
 		return nil, status.Errorf(codes.NotFound, "instance setting not found")
 	}
 
-	// For storage setting, only admin can get it.
-	if instanceSetting.Key == storepb.InstanceSettingKey_STORAGE {
+	// For storage and AI settings, only admin can get them.
+	if instanceSetting.Key == storepb.InstanceSettingKey_STORAGE || instanceSetting.Key == storepb.InstanceSettingKey_AI {
 		user, err := s.fetchCurrentUser(ctx)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
@@ -95,6 +102,12 @@ func (s *APIV1Service) UpdateInstanceSetting(ctx context.Context, request *v1pb.
 	// TODO: Apply update_mask if specified
 	_ = request.UpdateMask
 
+	if aiSetting := request.Setting.GetAiSetting(); aiSetting != nil {
+		if err := validateInstanceAISetting(aiSetting); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid AI setting: %v", err)
+		}
+	}
+
 	updateSetting := convertInstanceSettingToStore(request.Setting)
 	instanceSetting, err := s.Store.UpsertInstanceSetting(ctx, updateSetting)
 	if err != nil {
@@ -104,6 +117,39 @@ func (s *APIV1Service) UpdateInstanceSetting(ctx context.Context, request *v1pb.
 	return convertInstanceSettingFromStore(instanceSetting), nil
 }
 
+func (s *APIV1Service) GetEmbeddingIndex(ctx context.Context, _ *v1pb.GetEmbeddingIndexRequest) (*v1pb.EmbeddingIndex, error) {
+	user, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if user.Role != store.RoleAdmin {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	if s.VectorStore == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "semantic search is not configured")
+	}
+
+	indexStatus := s.VectorStore.Status()
+	embeddingIndex := &v1pb.EmbeddingIndex{
+		Model:     indexStatus.Model,
+		Dimension: int32(indexStatus.Dimension),
+	}
+	if reindex := indexStatus.Reindex; reindex != nil {
+		embeddingIndex.Reindex = &v1pb.EmbeddingIndex_Reindex{
+			Model:        reindex.Model,
+			Dimension:    int32(reindex.Dimension),
+			StartTime:    timestamppb.New(reindex.StartTime),
+			IndexedMemos: int32(reindex.Indexed),
+			FailedMemos:  int32(reindex.Failed),
+			TotalMemos:   int32(reindex.Total),
+		}
+	}
+	return embeddingIndex, nil
+}
+
 func convertInstanceSettingFromStore(setting *storepb.InstanceSetting) *v1pb.InstanceSetting {
 	instanceSetting := &v1pb.InstanceSetting{
 		Name: fmt.Sprintf("instance/settings/%s", setting.Key.String()),
@@ -121,6 +167,10 @@ func convertInstanceSettingFromStore(setting *storepb.InstanceSetting) *v1pb.Ins
 		instanceSetting.Value = &v1pb.InstanceSetting_MemoRelatedSetting_{
 			MemoRelatedSetting: convertInstanceMemoRelatedSettingFromStore(setting.GetMemoRelatedSetting()),
 		}
+	case *storepb.InstanceSetting_AiSetting:
+		instanceSetting.Value = &v1pb.InstanceSetting_AiSetting{
+			AiSetting: convertInstanceAISettingFromStore(setting.GetAiSetting()),
+		}
 	}
 	return instanceSetting
 }
@@ -146,6 +196,10 @@ func convertInstanceSettingToStore(setting *v1pb.InstanceSetting) *storepb.Insta
 		instanceSetting.Value = &storepb.InstanceSetting_MemoRelatedSetting{
 			MemoRelatedSetting: convertInstanceMemoRelatedSettingToStore(setting.GetMemoRelatedSetting()),
 		}
+	case storepb.InstanceSettingKey_AI:
+		instanceSetting.Value = &storepb.InstanceSetting_AiSetting{
+			AiSetting: convertInstanceAISettingToStore(setting.GetAiSetting()),
+		}
 	default:
 		// Keep the default GeneralSetting value
 	}
@@ -271,6 +325,97 @@ func convertInstanceMemoRelatedSettingToStore(setting *v1pb.InstanceSetting_Memo
 	}
 }
 
+func convertInstanceAISettingFromStore(setting *storepb.InstanceAISetting) *v1pb.InstanceSetting_AISetting {
+	if setting == nil {
+		return nil
+	}
+	userQuotas := make(map[string]int64, len(setting.UserMonthlyTokenQuotas))
+	for userID, quota := range setting.UserMonthlyTokenQuotas {
+		userQuotas[fmt.Sprintf("%s%d", UserNamePrefix, userID)] = quota
+	}
+	return &v1pb.InstanceSetting_AISetting{
+		MonthlyTokenQuota:      setting.MonthlyTokenQuota,
+		RoleMonthlyTokenQuotas: setting.RoleMonthlyTokenQuotas,
+		UserMonthlyTokenQuotas: userQuotas,
+		McpServers:             convertMCPServersFromStore(setting.McpServers),
+		WebAccess:              v1pb.InstanceSetting_AISetting_WebAccess(setting.WebAccess),
+		ReaderUrl:              setting.ReaderUrl,
+		DisabledTools:          setting.DisabledTools,
+		AllowedModels:          setting.AllowedModels,
+		SystemPromptPreamble:   setting.SystemPromptPreamble,
+		MaxAgentRounds:         setting.MaxAgentRounds,
+	}
+}
+
+// convertInstanceAISettingToStore expects a setting that passed validateInstanceAISetting.
+func convertInstanceAISettingToStore(setting *v1pb.InstanceSetting_AISetting) *storepb.InstanceAISetting {
+	if setting == nil {
+		return nil
+	}
+	userQuotas := make(map[int32]int64, len(setting.UserMonthlyTokenQuotas))
+	for name, quota := range setting.UserMonthlyTokenQuotas {
+		userID, err := ExtractUserIDFromName(name)
+		if err != nil {
+			continue
+		}
+		userQuotas[userID] = quota
+	}
+	return &storepb.InstanceAISetting{
+		MonthlyTokenQuota:      setting.MonthlyTokenQuota,
+		RoleMonthlyTokenQuotas: setting.RoleMonthlyTokenQuotas,
+		UserMonthlyTokenQuotas: userQuotas,
+		McpServers:             convertMCPServersToStore(setting.McpServers),
+		WebAccess:              storepb.InstanceAISetting_WebAccess(setting.WebAccess),
+		ReaderUrl:              setting.ReaderUrl,
+		DisabledTools:          setting.DisabledTools,
+		AllowedModels:          setting.AllowedModels,
+		SystemPromptPreamble:   setting.SystemPromptPreamble,
+		MaxAgentRounds:         setting.MaxAgentRounds,
+	}
+}
+
+func validateInstanceAISetting(setting *v1pb.InstanceSetting_AISetting) error {
+	if setting.MonthlyTokenQuota < 0 {
+		return errors.New("monthly token quota must not be negative")
+	}
+	for role, quota := range setting.RoleMonthlyTokenQuotas {
+		if _, ok := v1pb.User_Role_value[role]; !ok || role == v1pb.User_ROLE_UNSPECIFIED.String() {
+			return errors.Errorf("unknown role %q", role)
+		}
+		if quota < 0 {
+			return errors.Errorf("monthly token quota of role %q must not be negative", role)
+		}
+	}
+	for name, quota := range setting.UserMonthlyTokenQuotas {
+		if _, err := ExtractUserIDFromName(name); err != nil {
+			return errors.Errorf("invalid user name %q", name)
+		}
+		if quota < 0 {
+			return errors.Errorf("monthly token quota of %s must not be negative", name)
+		}
+	}
+	if setting.WebAccess == v1pb.InstanceSetting_AISetting_READER {
+		u, err := url.Parse(setting.ReaderUrl)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return errors.Errorf("invalid reader url %q", setting.ReaderUrl)
+		}
+	}
+	for _, name := range setting.DisabledTools {
+		if !slices.ContainsFunc(chatToolDefs(), func(def llm.Tool) bool { return def.Function.Name == name }) {
+			return errors.Errorf("unknown tool %q", name)
+		}
+	}
+	for _, model := range setting.AllowedModels {
+		if strings.TrimSpace(model) == "" {
+			return errors.New("allowed models must not be empty")
+		}
+	}
+	if setting.MaxAgentRounds < 0 || setting.MaxAgentRounds > maxAgentRoundsLimit {
+		return errors.Errorf("max agent rounds must be between 0 and %d", maxAgentRoundsLimit)
+	}
+	return validateMCPServers(setting.McpServers, true)
+}
+
 func (s *APIV1Service) GetInstanceAdmin(ctx context.Context) (*v1pb.User, error) {
 	adminUserType := store.RoleAdmin
 	user, err := s.Store.GetUser(ctx, &store.FindUser{
@@ -14,6 +14,18 @@ import (
 )
 
 func (s *APIV1Service) SetMemoAttachments(ctx context.Context, request *v1pb.SetMemoAttachmentsRequest) (*emptypb.Empty, error) {
+	memo, err := s.setMemoAttachments(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	// The memo is indexed together with the text of its attachments.
+	s.upsertMemoVector(ctx, memo)
+	return &emptypb.Empty{}, nil
+}
+
+// setMemoAttachments replaces the attachments of a memo without re-indexing it,
+// for callers that index the memo themselves.
+func (s *APIV1Service) setMemoAttachments(ctx context.Context, request *v1pb.SetMemoAttachmentsRequest) (*store.Memo, error) {
 	user, err := s.fetchCurrentUser(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
@@ -89,7 +101,7 @@ func (s *APIV1Service) SetMemoAttachments(ctx context.Context, request *v1pb.Set
 		}
 	}
 
-	return &emptypb.Empty{}, nil
+	return memo, nil
 }
 
 func (s *APIV1Service) ListMemoAttachments(ctx context.Context, request *v1pb.ListMemoAttachmentsRequest) (*v1pb.ListMemoAttachmentsResponse, error) {
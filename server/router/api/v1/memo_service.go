@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"slices"
 	"strings"
 	"time"
 
@@ -100,7 +101,7 @@ func (s *APIV1Service) CreateMemo(ctx context.Context, request *v1pb.CreateMemoR
 	attachments := []*store.Attachment{}
 
 	if len(request.Memo.Attachments) > 0 {
-		_, err := s.SetMemoAttachments(ctx, &v1pb.SetMemoAttachmentsRequest{
+		_, err := s.setMemoAttachments(ctx, &v1pb.SetMemoAttachmentsRequest{
 			Name:        fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
 			Attachments: request.Memo.Attachments,
 		})
@@ -138,14 +139,9 @@ func (s *APIV1Service) CreateMemo(ctx context.Context, request *v1pb.CreateMemoR
 	if err := s.DispatchMemoCreatedWebhook(ctx, memoMessage); err != nil {
 		slog.Warn("Failed to dispatch memo created webhook", slog.Any("err", err))
 	}
-	
-	if s.VectorStore != nil {
-		go func(creatorID int32, uid, content string) {
-			if err := s.VectorStore.UpsertMemo(context.Background(), creatorID, uid, content, ""); err != nil {
-				slog.Warn("Failed to upsert memo to vectorstore", slog.Any("err", err))
-			}
-		}(memo.CreatorID, memo.UID, memo.Content)
-	}
+
+	s.upsertMemoVector(ctx, memo)
+	s.suggestMemoTags(ctx, memo)
 
 	// Broadcast live refresh event.
 	s.SSEHub.Broadcast(&SSEEvent{
@@ -190,16 +186,11 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get user")
 	}
-	if currentUser == nil {
-		memoFind.VisibilityList = []store.Visibility{store.Public}
-	} else {
-		if memoFind.CreatorID == nil {
-			filter := fmt.Sprintf(`creator_id == %d || visibility in ["PUBLIC", "PROTECTED"]`, currentUser.ID)
-			memoFind.Filters = append(memoFind.Filters, filter)
-		} else if *memoFind.CreatorID != currentUser.ID {
-			memoFind.VisibilityList = []store.Visibility{store.Public, store.Protected}
-		}
+	var currentUserID int32
+	if currentUser != nil {
+		currentUserID = currentUser.ID
 	}
+	applyMemoVisibilityFilter(memoFind, currentUserID)
 
 	instanceMemoRelatedSetting, err := s.Store.GetInstanceMemoRelatedSetting(ctx)
 	if err != nil {
@@ -231,7 +222,6 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
 	}
 
-	memoMessages := []*v1pb.Memo{}
 	nextPageToken := ""
 	if len(memos) == limitPlusOne {
 		memos = memos[:limit]
@@ -241,12 +231,24 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 		}
 	}
 
+	memoMessages, err := s.convertMemosFromStore(ctx, memos)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &v1pb.ListMemosResponse{
+		Memos:         memoMessages,
+		NextPageToken: nextPageToken,
+	}
+	return response, nil
+}
+
+// convertMemosFromStore converts memos to API messages, batch loading their
+// reactions, attachments and relations.
+func (s *APIV1Service) convertMemosFromStore(ctx context.Context, memos []*store.Memo) ([]*v1pb.Memo, error) {
+	memoMessages := []*v1pb.Memo{}
 	if len(memos) == 0 {
-		response := &v1pb.ListMemosResponse{
-			Memos:         memoMessages,
-			NextPageToken: nextPageToken,
-		}
-		return response, nil
+		return memoMessages, nil
 	}
 
 	reactionMap := make(map[string][]*store.Reaction)
@@ -297,12 +299,22 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 
 		memoMessages = append(memoMessages, memoMessage)
 	}
+	return memoMessages, nil
+}
 
-	response := &v1pb.ListMemosResponse{
-		Memos:         memoMessages,
-		NextPageToken: nextPageToken,
+// applyMemoVisibilityFilter restricts find to the memos userID may see; 0 means
+// an anonymous caller, who only sees public memos.
+func applyMemoVisibilityFilter(find *store.FindMemo, userID int32) {
+	if userID == 0 {
+		find.VisibilityList = []store.Visibility{store.Public}
+		return
+	}
+	if find.CreatorID == nil {
+		filter := fmt.Sprintf(`creator_id == %d || visibility in ["PUBLIC", "PROTECTED"]`, userID)
+		find.Filters = append(find.Filters, filter)
+	} else if *find.CreatorID != userID {
+		find.VisibilityList = []store.Visibility{store.Public, store.Protected}
 	}
-	return response, nil
 }
 
 func (s *APIV1Service) GetMemo(ctx context.Context, request *v1pb.GetMemoRequest) (*v1pb.Memo, error) {
@@ -402,6 +414,8 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 			if err := memopayload.RebuildMemoPayload(memo, s.MarkdownService); err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to rebuild memo payload: %v", err)
 			}
+			// Suggestions for the old content no longer apply.
+			memo.Payload.SuggestedTags = nil
 			update.Content = &memo.Content
 			update.Payload = memo.Payload
 		} else if path == "visibility" {
@@ -444,7 +458,7 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 			payload.Location = convertLocationToStore(request.Memo.Location)
 			update.Payload = payload
 		} else if path == "attachments" {
-			_, err := s.SetMemoAttachments(ctx, &v1pb.SetMemoAttachmentsRequest{
+			_, err := s.setMemoAttachments(ctx, &v1pb.SetMemoAttachmentsRequest{
 				Name:        request.Memo.Name,
 				Attachments: request.Memo.Attachments,
 			})
@@ -503,12 +517,16 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 		Type: SSEEventMemoUpdated,
 		Name: memoMessage.Name,
 	})
-	if s.VectorStore != nil && update.Content != nil {
-		go func(creatorID int32, uid, content string) {
-			if err := s.VectorStore.UpsertMemo(context.Background(), creatorID, uid, content, ""); err != nil {
-				slog.Warn("Failed to upsert memo to vectorstore", slog.Any("err", err))
-			}
-		}(memo.CreatorID, memo.UID, *update.Content)
+	if update.Content != nil {
+		s.upsertMemoVector(ctx, memo)
+		s.suggestMemoTags(ctx, memo)
+	} else if slices.Contains(request.UpdateMask.Paths, "attachments") {
+		s.upsertMemoVector(ctx, memo)
+	} else if s.VectorStore != nil {
+		// Only tags, visibility, state or time can have changed, so refresh the metadata without re-embedding.
+		if err := s.VectorStore.UpdateMemoMetadata(ctx, memo.CreatorID, memo.UID, s.convertMemoToVector(ctx, memo).Metadata()); err != nil {
+			slog.Warn("Failed to update memo metadata in vectorstore", slog.Any("err", err))
+		}
 	}
 
 	return memoMessage, nil
@@ -570,15 +588,23 @@ func (s *APIV1Service) DeleteMemo(ctx context.Context, request *v1pb.DeleteMemoR
 		return nil, status.Errorf(codes.Internal, "failed to list memo comments")
 	}
 	for _, relation := range relations {
+		comment, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &relation.MemoID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo comment")
+		}
 		if err := s.Store.DeleteMemo(ctx, &store.DeleteMemo{ID: relation.MemoID}); err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to delete memo comment")
 		}
+		if comment != nil {
+			s.deleteMemoVector(ctx, comment)
+		}
 	}
 
 	// Delete the memo (store.DeleteMemo handles relation and attachment cleanup)
 	if err = s.Store.DeleteMemo(ctx, &store.DeleteMemo{ID: memo.ID}); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete memo")
 	}
+	s.deleteMemoVector(ctx, memo)
 
 	// Broadcast live refresh event.
 	s.SSEHub.Broadcast(&SSEEvent{
@@ -589,6 +615,29 @@ func (s *APIV1Service) DeleteMemo(ctx context.Context, request *v1pb.DeleteMemoR
 	return &emptypb.Empty{}, nil
 }
 
+// upsertMemoVector (re-)indexes a memo for semantic search in the background.
+func (s *APIV1Service) upsertMemoVector(ctx context.Context, memo *store.Memo) {
+	if s.VectorStore == nil {
+		return
+	}
+	vectorMemo := s.convertMemoToVector(ctx, memo)
+	go func() {
+		if err := s.VectorStore.UpsertMemo(context.Background(), vectorMemo); err != nil {
+			slog.Warn("Failed to upsert memo to vectorstore", slog.Any("err", err))
+		}
+	}()
+}
+
+// deleteMemoVector drops a deleted memo from the semantic search index.
+func (s *APIV1Service) deleteMemoVector(ctx context.Context, memo *store.Memo) {
+	if s.VectorStore == nil {
+		return
+	}
+	if err := s.VectorStore.DeleteMemo(ctx, memo.CreatorID, memo.UID); err != nil {
+		slog.Warn("Failed to delete memo from vectorstore", slog.Any("err", err))
+	}
+}
+
 func (s *APIV1Service) CreateMemoComment(ctx context.Context, request *v1pb.CreateMemoCommentRequest) (*v1pb.Memo, error) {
 	memoUID, err := ExtractMemoUIDFromName(request.Name)
 	if err != nil {
@@ -614,8 +663,8 @@ func (s *APIV1Service) CreateMemoComment(ctx context.Context, request *v1pb.Crea
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
-	// Create the memo comment first.
-	memoComment, err := s.CreateMemo(ctx, &v1pb.CreateMemoRequest{
+	// Create the memo comment first. Comments get no tag suggestions.
+	memoComment, err := s.CreateMemo(withoutTagSuggestion(ctx), &v1pb.CreateMemoRequest{
 		Memo:   request.Comment,
 		MemoId: request.CommentId,
 	})
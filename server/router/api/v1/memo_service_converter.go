@@ -3,16 +3,61 @@ package v1
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"time"
 
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/usememos/memos/plugin/vectorstore"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/auth"
 	"github.com/usememos/memos/store"
 )
 
+// convertMemoToVector converts a memo to its semantic search index form.
+func (s *APIV1Service) convertMemoToVector(ctx context.Context, memo *store.Memo) *vectorstore.Memo {
+	displayWithUpdateTime := false
+	if setting, err := s.Store.GetInstanceMemoRelatedSetting(ctx); err == nil {
+		displayWithUpdateTime = setting.DisplayWithUpdateTime
+	}
+	texts, err := s.Store.ListAttachmentTexts(ctx, &store.FindAttachmentText{MemoIDList: []int32{memo.ID}})
+	if err != nil {
+		slog.Warn("failed to list attachment texts", "memo", memo.UID, "err", err)
+	}
+	return ConvertMemoToVector(memo, texts, displayWithUpdateTime)
+}
+
+// ConvertMemoToVector converts a memo and the text of its attachments to its
+// semantic search index form. displayWithUpdateTime mirrors the instance memo
+// related setting.
+func ConvertMemoToVector(memo *store.Memo, texts []*store.AttachmentText, displayWithUpdateTime bool) *vectorstore.Memo {
+	displayTs := memo.CreatedTs
+	if displayWithUpdateTime {
+		displayTs = memo.UpdatedTs
+	}
+	vectorMemo := &vectorstore.Memo{
+		UID:         memo.UID,
+		CreatorID:   memo.CreatorID,
+		Content:     memo.Content,
+		Visibility:  string(memo.Visibility),
+		RowStatus:   string(memo.RowStatus),
+		DisplayTime: displayTs,
+	}
+	if memo.Payload != nil {
+		vectorMemo.Tags = memo.Payload.Tags
+	}
+	for _, text := range texts {
+		vectorMemo.Attachments = append(vectorMemo.Attachments, vectorstore.Attachment{
+			UID:      text.AttachmentUID,
+			Filename: text.Filename,
+			Text:     text.Content,
+		})
+	}
+	return vectorMemo
+}
+
 func (s *APIV1Service) convertMemoFromStore(ctx context.Context, memo *store.Memo, reactions []*store.Reaction, attachments []*store.Attachment, relations []*v1pb.MemoRelation) (*v1pb.Memo, error) {
 	displayTs := memo.CreatedTs
 	instanceMemoRelatedSetting, err := s.Store.GetInstanceMemoRelatedSetting(ctx)
@@ -39,6 +84,10 @@ func (s *APIV1Service) convertMemoFromStore(ctx context.Context, memo *store.Mem
 		memoMessage.Tags = memo.Payload.Tags
 		memoMessage.Property = convertMemoPropertyFromStore(memo.Payload.Property)
 		memoMessage.Location = convertLocationFromStore(memo.Payload.Location)
+		// Pending tag suggestions are only for the creator to review.
+		if auth.GetUserID(ctx) == memo.CreatorID {
+			memoMessage.SuggestedTags = memo.Payload.SuggestedTags
+		}
 	}
 
 	if memo.ParentUID != nil {
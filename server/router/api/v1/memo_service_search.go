@@ -0,0 +1,544 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/vectorstore"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// maxSearchPageSize caps the number of results SearchMemos returns.
+	maxSearchPageSize = 100
+	// searchCandidateLimit caps the candidates each ranking contributes before fusion.
+	searchCandidateLimit = 200
+	// rrfK dampens the weight of top ranks in reciprocal rank fusion; 60 is the
+	// value from the original paper and works well without tuning.
+	rrfK = 60
+	// searchSnippetLength is the maximum length in bytes of a result snippet.
+	searchSnippetLength = 200
+	// maxSearchTerms caps the keyword terms taken from a query.
+	maxSearchTerms = 10
+	// defaultRelatedPageSize and maxRelatedPageSize bound the number of
+	// results ListRelatedMemos returns.
+	defaultRelatedPageSize = 5
+	maxRelatedPageSize     = 20
+)
+
+// MemoSearchResult is a memo matched by HybridSearchMemos.
+type MemoSearchResult struct {
+	Memo *store.Memo
+	// Score is the reciprocal rank fusion score; higher is more relevant.
+	Score float64
+	// Snippet is the passage of the memo that best matches the query.
+	Snippet string
+	// AttachmentUID and Filename name the attachment the snippet is from, if
+	// it is not from the memo itself.
+	AttachmentUID string
+	Filename      string
+}
+
+// searchPassage is the text of a memo or one of its attachments that matched a
+// search.
+type searchPassage struct {
+	Text string
+	// AttachmentUID and Filename are set when the text is from an attachment.
+	AttachmentUID string
+	Filename      string
+}
+
+func (s *APIV1Service) SearchMemos(ctx context.Context, request *v1pb.SearchMemosRequest) (*v1pb.SearchMemosResponse, error) {
+	if strings.TrimSpace(request.Query) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "query is required")
+	}
+	if request.Filter != "" {
+		if err := s.validateFilter(ctx, request.Filter); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+		}
+	}
+	currentUser, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	var userID int32
+	if currentUser != nil {
+		userID = currentUser.ID
+	}
+
+	pageSize := int(request.PageSize)
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	pageSize = min(pageSize, maxSearchPageSize)
+
+	results, err := s.HybridSearchMemos(ctx, userID, request.Query, request.Filter, pageSize)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to search memos: %v", err)
+	}
+	memos := make([]*store.Memo, 0, len(results))
+	for _, r := range results {
+		memos = append(memos, r.Memo)
+	}
+	memoMessages, err := s.convertMemosFromStore(ctx, memos)
+	if err != nil {
+		return nil, err
+	}
+	response := &v1pb.SearchMemosResponse{}
+	for i, r := range results {
+		result := &v1pb.SearchMemosResponse_Result{
+			Memo:    memoMessages[i],
+			Score:   r.Score,
+			Snippet: r.Snippet,
+		}
+		if r.AttachmentUID != "" {
+			result.Attachment = AttachmentNamePrefix + r.AttachmentUID
+		}
+		response.Results = append(response.Results, result)
+	}
+	return response, nil
+}
+
+// HybridSearchMemos ranks the normal, non-comment memos visible to userID (0 for
+// anonymous callers) against query, both by keyword and semantically, and merges
+// the two rankings with reciprocal rank fusion. The text extracted from a memo's
+// attachments is searched along with the memo. filter is an optional CEL
+// expression. Semantic matches come from the caller's own memos, since the
+// vector index is per user; keyword matches follow the usual visibility rules.
+func (s *APIV1Service) HybridSearchMemos(ctx context.Context, userID int32, query, filter string, limit int) ([]*MemoSearchResult, error) {
+	newFind := func() *store.FindMemo {
+		rowStatus := store.Normal
+		find := &store.FindMemo{
+			ExcludeComments: true,
+			RowStatus:       &rowStatus,
+		}
+		if filter != "" {
+			find.Filters = append(find.Filters, filter)
+		}
+		applyMemoVisibilityFilter(find, userID)
+		return find
+	}
+
+	keywordRanked, keywordPassages, err := s.searchMemosByKeyword(ctx, newFind(), query)
+	if err != nil {
+		return nil, err
+	}
+	semanticRanked, passages := s.searchMemosSemantically(ctx, newFind(), userID, query)
+
+	results := make(map[int32]*MemoSearchResult)
+	fuse := func(ranked []*store.Memo) {
+		for rank, memo := range ranked {
+			r, ok := results[memo.ID]
+			if !ok {
+				r = &MemoSearchResult{Memo: memo}
+				results[memo.ID] = r
+			}
+			r.Score += 1 / float64(rrfK+rank+1)
+		}
+	}
+	fuse(keywordRanked)
+	fuse(semanticRanked)
+
+	terms := searchTerms(query)
+	for _, r := range results {
+		if passage, ok := passages[r.Memo.UID]; ok {
+			r.Snippet = truncateSnippet(passage.Text, 0)
+			r.AttachmentUID, r.Filename = passage.AttachmentUID, passage.Filename
+		} else if passage, ok := keywordPassages[r.Memo.UID]; ok {
+			r.Snippet = keywordSnippet(passage.Text, terms)
+			r.AttachmentUID, r.Filename = passage.AttachmentUID, passage.Filename
+		} else {
+			r.Snippet = keywordSnippet(r.Memo.Content, terms)
+		}
+	}
+
+	fused := make([]*MemoSearchResult, 0, len(results))
+	for _, r := range results {
+		fused = append(fused, r)
+	}
+	sort.Slice(fused, func(i, j int) bool {
+		if fused[i].Score != fused[j].Score {
+			return fused[i].Score > fused[j].Score
+		}
+		return fused[i].Memo.ID > fused[j].Memo.ID
+	})
+	if len(fused) > limit {
+		fused = fused[:limit]
+	}
+	return fused, nil
+}
+
+// searchMemosByKeyword returns memos containing any query term, in their
+// content or in the text of their attachments, ranked by a TF-IDF score
+// computed over the candidates. Memos matched only through an attachment come
+// with the matching attachment text, keyed by memo UID.
+func (s *APIV1Service) searchMemosByKeyword(ctx context.Context, find *store.FindMemo, query string) ([]*store.Memo, map[string]searchPassage, error) {
+	terms := searchTerms(query)
+	if len(terms) == 0 {
+		return nil, nil, nil
+	}
+	// Keep a copy of the filters to look up the memos of matching attachments.
+	attachmentFind := *find
+	attachmentFind.Filters = slices.Clip(find.Filters)
+
+	conditions := make([]string, 0, len(terms))
+	for _, term := range terms {
+		conditions = append(conditions, fmt.Sprintf("content.contains(%q)", term))
+	}
+	find.Filters = append(find.Filters, strings.Join(conditions, " || "))
+	limit, offset := searchCandidateLimit, 0
+	find.Limit = &limit
+	find.Offset = &offset
+	memos, err := s.Store.ListMemos(ctx, find)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to list memos")
+	}
+	memos, texts, err := s.addAttachmentMatches(ctx, &attachmentFind, memos, terms)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	contents := make([]string, len(memos))
+	documentFrequency := make(map[string]int, len(terms))
+	for i, memo := range memos {
+		content := memo.Content
+		for _, text := range texts[memo.ID] {
+			content += "\n" + text.Content
+		}
+		contents[i] = strings.ToLower(content)
+		for _, term := range terms {
+			if strings.Contains(contents[i], term) {
+				documentFrequency[term]++
+			}
+		}
+	}
+	phrase := strings.ToLower(strings.Join(strings.Fields(query), " "))
+	scores := make(map[int32]float64, len(memos))
+	for i, memo := range memos {
+		var score float64
+		for _, term := range terms {
+			if tf := strings.Count(contents[i], term); tf > 0 {
+				idf := math.Log(1 + float64(len(memos))/float64(documentFrequency[term]))
+				score += (1 + math.Log(float64(tf))) * idf
+			}
+		}
+		if len(terms) > 1 && strings.Contains(contents[i], phrase) {
+			score *= 2
+		}
+		scores[memo.ID] = score
+	}
+	// Candidates come back newest first, so ties keep recent memos on top.
+	sort.SliceStable(memos, func(i, j int) bool {
+		return scores[memos[i].ID] > scores[memos[j].ID]
+	})
+
+	passages := make(map[string]searchPassage)
+	for _, memo := range memos {
+		if containsAnyTerm(strings.ToLower(memo.Content), terms) {
+			continue
+		}
+		for _, text := range texts[memo.ID] {
+			if containsAnyTerm(strings.ToLower(text.Content), terms) {
+				passages[memo.UID] = searchPassage{Text: text.Content, AttachmentUID: text.AttachmentUID, Filename: text.Filename}
+				break
+			}
+		}
+	}
+	return memos, passages, nil
+}
+
+// addAttachmentMatches adds the memos satisfying find whose attachments contain
+// any of terms to memos, and returns the attachment texts of all the memos by
+// memo ID.
+func (s *APIV1Service) addAttachmentMatches(ctx context.Context, find *store.FindMemo, memos []*store.Memo, terms []string) ([]*store.Memo, map[int32][]*store.AttachmentText, error) {
+	matches, err := s.Store.ListAttachmentTexts(ctx, &store.FindAttachmentText{Terms: terms, ExcludeContent: true})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to list attachment texts")
+	}
+	seen := make(map[int32]bool, len(memos))
+	for _, memo := range memos {
+		seen[memo.ID] = true
+	}
+	for _, match := range matches {
+		if match.MemoID != nil && !seen[*match.MemoID] {
+			find.IDList = append(find.IDList, *match.MemoID)
+			seen[*match.MemoID] = true
+		}
+	}
+	if len(find.IDList) > 0 {
+		limit, offset := searchCandidateLimit, 0
+		find.Limit = &limit
+		find.Offset = &offset
+		attached, err := s.Store.ListMemos(ctx, find)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "failed to list memos")
+		}
+		memos = append(memos, attached...)
+	}
+	if len(memos) == 0 {
+		return memos, nil, nil
+	}
+
+	memoIDs := make([]int32, 0, len(memos))
+	for _, memo := range memos {
+		memoIDs = append(memoIDs, memo.ID)
+	}
+	texts, err := s.Store.ListAttachmentTexts(ctx, &store.FindAttachmentText{MemoIDList: memoIDs, Terms: terms})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to list attachment texts")
+	}
+	textsByMemo := make(map[int32][]*store.AttachmentText)
+	for _, text := range texts {
+		textsByMemo[*text.MemoID] = append(textsByMemo[*text.MemoID], text)
+	}
+	return memos, textsByMemo, nil
+}
+
+// searchMemosSemantically returns the memos nearest to query in the caller's
+// vector index that also satisfy find, along with their best passages keyed by
+// memo UID. It returns nothing when semantic search is unavailable.
+func (s *APIV1Service) searchMemosSemantically(ctx context.Context, find *store.FindMemo, userID int32, query string) ([]*store.Memo, map[string]searchPassage) {
+	if s.VectorStore == nil || userID == 0 {
+		return nil, nil
+	}
+	hits, err := s.VectorStore.SearchSimilar(ctx, userID, query, searchCandidateLimit, nil)
+	if err != nil {
+		slog.Warn("Failed to search memos semantically", slog.Any("err", err))
+		return nil, nil
+	}
+	if len(hits) == 0 {
+		return nil, nil
+	}
+	passages := make(map[string]searchPassage, len(hits))
+	for _, hit := range hits {
+		find.UIDList = append(find.UIDList, hit.MemoUID)
+		passages[hit.MemoUID] = searchPassage{Text: hit.Content, AttachmentUID: hit.Attachment, Filename: hit.Filename}
+	}
+	memos, err := s.Store.ListMemos(ctx, find)
+	if err != nil {
+		slog.Warn("Failed to list memos for semantic search", slog.Any("err", err))
+		return nil, nil
+	}
+	memoByUID := make(map[string]*store.Memo, len(memos))
+	for _, memo := range memos {
+		memoByUID[memo.UID] = memo
+	}
+	ranked := make([]*store.Memo, 0, len(memos))
+	for _, hit := range hits {
+		if memo, ok := memoByUID[hit.MemoUID]; ok {
+			ranked = append(ranked, memo)
+		}
+	}
+	return ranked, passages
+}
+
+func (s *APIV1Service) ListRelatedMemos(ctx context.Context, request *v1pb.ListRelatedMemosRequest) (*v1pb.ListRelatedMemosResponse, error) {
+	memoUID, err := ExtractMemoUIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo")
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	currentUser, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	var userID int32
+	if currentUser != nil {
+		userID = currentUser.ID
+	}
+	if memo.Visibility != store.Public {
+		if currentUser == nil {
+			return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+		}
+		if memo.Visibility == store.Private && memo.CreatorID != userID {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		}
+	}
+
+	pageSize := int(request.PageSize)
+	if pageSize <= 0 {
+		pageSize = defaultRelatedPageSize
+	}
+	pageSize = min(pageSize, maxRelatedPageSize)
+
+	results, err := s.findRelatedMemos(ctx, memo, userID, pageSize)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to find related memos: %v", err)
+	}
+	memos := make([]*store.Memo, 0, len(results))
+	for _, r := range results {
+		memos = append(memos, r.Memo)
+	}
+	memoMessages, err := s.convertMemosFromStore(ctx, memos)
+	if err != nil {
+		return nil, err
+	}
+	response := &v1pb.ListRelatedMemosResponse{}
+	for i, r := range results {
+		response.Results = append(response.Results, &v1pb.ListRelatedMemosResponse_Result{
+			Memo:  memoMessages[i],
+			Score: r.Score,
+		})
+	}
+	return response, nil
+}
+
+// findRelatedMemos returns up to limit normal, non-comment memos visible to
+// userID (0 for anonymous callers) that are nearest to memo in the vector
+// index, leaving out memos already related to it in either direction. Since
+// the vector index is per user, related memos come from the memo's creator.
+func (s *APIV1Service) findRelatedMemos(ctx context.Context, memo *store.Memo, userID int32, limit int) ([]*MemoSearchResult, error) {
+	if s.VectorStore == nil {
+		return nil, nil
+	}
+	relations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{MemoIDList: []int32{memo.ID}})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list memo relations")
+	}
+	filter := &vectorstore.Filter{}
+	if len(relations) > 0 {
+		linkedIDs := make([]int32, 0, len(relations))
+		for _, relation := range relations {
+			if relation.MemoID == memo.ID {
+				linkedIDs = append(linkedIDs, relation.RelatedMemoID)
+			} else {
+				linkedIDs = append(linkedIDs, relation.MemoID)
+			}
+		}
+		linked, err := s.Store.ListMemos(ctx, &store.FindMemo{IDList: linkedIDs, ExcludeContent: true})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list related memos")
+		}
+		for _, m := range linked {
+			filter.ExcludeMemoUIDs = append(filter.ExcludeMemoUIDs, m.UID)
+		}
+	}
+	// Skip the creator's memos the caller cannot see, so that they do not
+	// take up candidates.
+	switch {
+	case userID == 0:
+		filter.Visibilities = []string{store.Public.String()}
+	case userID != memo.CreatorID:
+		filter.Visibilities = []string{store.Public.String(), store.Protected.String()}
+	}
+
+	// Some hits may be comments, so fetch extra candidates.
+	hits, err := s.VectorStore.SearchSimilarToMemo(ctx, memo.CreatorID, memo.UID, limit*4, filter)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to search memos semantically")
+	}
+	if len(hits) == 0 {
+		return nil, nil
+	}
+	rowStatus := store.Normal
+	find := &store.FindMemo{
+		ExcludeComments: true,
+		RowStatus:       &rowStatus,
+	}
+	for _, hit := range hits {
+		find.UIDList = append(find.UIDList, hit.MemoUID)
+	}
+	applyMemoVisibilityFilter(find, userID)
+	memos, err := s.Store.ListMemos(ctx, find)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list memos")
+	}
+	memoByUID := make(map[string]*store.Memo, len(memos))
+	for _, m := range memos {
+		memoByUID[m.UID] = m
+	}
+	results := make([]*MemoSearchResult, 0, limit)
+	for _, hit := range hits {
+		m, ok := memoByUID[hit.MemoUID]
+		if !ok {
+			continue
+		}
+		results = append(results, &MemoSearchResult{Memo: m, Score: float64(hit.Score), Snippet: hit.Content, AttachmentUID: hit.Attachment, Filename: hit.Filename})
+		if len(results) == limit {
+			break
+		}
+	}
+	return results, nil
+}
+
+// searchTerms splits a query into distinct lowercase terms, skipping single
+// characters that would match almost every memo.
+func searchTerms(query string) []string {
+	var terms []string
+	seen := make(map[string]bool)
+	for _, field := range strings.Fields(strings.ToLower(query)) {
+		if seen[field] || utf8.RuneCountInString(field) < 2 {
+			continue
+		}
+		if len(terms) == maxSearchTerms {
+			break
+		}
+		seen[field] = true
+		terms = append(terms, field)
+	}
+	return terms
+}
+
+// containsAnyTerm reports whether lowercased text contains any of terms.
+func containsAnyTerm(text string, terms []string) bool {
+	return slices.ContainsFunc(terms, func(term string) bool {
+		return strings.Contains(text, term)
+	})
+}
+
+// keywordSnippet returns the part of content around the first query term.
+func keywordSnippet(content string, terms []string) string {
+	lower := strings.ToLower(content)
+	at := -1
+	for _, term := range terms {
+		if i := strings.Index(lower, term); i >= 0 && (at < 0 || i < at) {
+			at = i
+		}
+	}
+	if at < 0 {
+		return truncateSnippet(content, 0)
+	}
+	// Lowercasing can change byte lengths, so only trust the offset when it is
+	// a valid position in the original content.
+	if len(lower) != len(content) {
+		at = 0
+	}
+	return truncateSnippet(content, max(0, at-searchSnippetLength/4))
+}
+
+// truncateSnippet returns up to searchSnippetLength bytes of text starting at
+// start, cut at rune boundaries and marked with ellipses where shortened.
+func truncateSnippet(text string, start int) string {
+	for start > 0 && !utf8.RuneStart(text[start]) {
+		start--
+	}
+	end := min(len(text), start+searchSnippetLength)
+	for end < len(text) && !utf8.RuneStart(text[end]) {
+		end--
+	}
+	snippet := strings.TrimSpace(text[start:end])
+	if start > 0 {
+		snippet = "..." + snippet
+	}
+	if end < len(text) {
+		snippet += "..."
+	}
+	return snippet
+}
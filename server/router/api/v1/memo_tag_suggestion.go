@@ -0,0 +1,277 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/usememos/memos/plugin/llm"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// Users who turn on tag suggestions get tags proposed for each memo they save,
+// drawn from the tags already in use so that the taxonomy stays consistent.
+// Suggestions are kept in the memo payload until the creator accepts or
+// dismisses them; only accepted tags are written into the content.
+
+const (
+	// maxSuggestedTags is the most tags suggested for a memo.
+	maxSuggestedTags = 3
+	// maxTagVocabulary is the most existing tags offered to the model, the
+	// most used first.
+	maxTagVocabulary = 200
+	// maxTagSuggestionTokens is the most of a memo's content sent to the model.
+	maxTagSuggestionTokens = 2000
+)
+
+type skipTagSuggestionKey struct{}
+
+// withoutTagSuggestion marks ctx so that memos saved with it get no tag
+// suggestions, as for comments or content changed by accepting suggestions.
+func withoutTagSuggestion(ctx context.Context) context.Context {
+	return context.WithValue(ctx, skipTagSuggestionKey{}, true)
+}
+
+// suggestMemoTags asks the model in the background for tags that fit memo, if
+// its creator has turned tag suggestions on, and stores them as pending.
+func (s *APIV1Service) suggestMemoTags(ctx context.Context, memo *store.Memo) {
+	if s.LLM == nil || ctx.Value(skipTagSuggestionKey{}) != nil {
+		return
+	}
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				slog.Error("suggesting memo tags panicked", "memo", memo.UID, "panic", r, "stack", string(debug.Stack()))
+			}
+		}()
+		if err := s.updateSuggestedTags(ctx, memo); err != nil {
+			slog.Warn("failed to suggest memo tags", "memo", memo.UID, "err", err)
+		}
+	}()
+}
+
+func (s *APIV1Service) updateSuggestedTags(ctx context.Context, memo *store.Memo) error {
+	setting, err := s.getUserAISetting(ctx, memo.CreatorID)
+	if err != nil {
+		return err
+	}
+	if !setting.SuggestTags {
+		return nil
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &memo.CreatorID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get user")
+	}
+	if user == nil {
+		return nil
+	}
+
+	vocabulary, err := s.listTagVocabulary(ctx, memo.CreatorID)
+	if err != nil {
+		return err
+	}
+	candidates := make([]string, 0, len(vocabulary))
+	for _, tag := range vocabulary {
+		if !slices.Contains(memo.Payload.GetTags(), tag) {
+			candidates = append(candidates, tag)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	content := truncateToTokens(memo.Content, llm.CountTokens(memo.Content), maxTagSuggestionTokens)
+	reply, err := s.callLLM(ctx, user, aiUsageTagging, buildTagSuggestionPrompt(candidates, content))
+	if err != nil {
+		return err
+	}
+	tags := parseSuggestedTags(reply, candidates)
+	if len(tags) == 0 {
+		return nil
+	}
+
+	// Drop the suggestions if the memo changed while the model was thinking;
+	// the new content gets suggestions of its own.
+	current, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get memo")
+	}
+	if current == nil || current.Content != memo.Content || current.ParentUID != nil {
+		return nil
+	}
+	// Only the suggestions are written, so edits to the rest of the payload
+	// made meanwhile are kept.
+	if err := s.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: current.ID, SuggestedTags: &tags}); err != nil {
+		return errors.Wrap(err, "failed to update memo")
+	}
+	s.SSEHub.Broadcast(&SSEEvent{
+		Type: SSEEventMemoUpdated,
+		Name: fmt.Sprintf("%s%s", MemoNamePrefix, current.UID),
+	})
+	return nil
+}
+
+// listTagVocabulary returns the tags of the memos userID can see, the most
+// used first, like the list_tags tool of the MCP server.
+func (s *APIV1Service) listTagVocabulary(ctx context.Context, userID int32) ([]string, error) {
+	rowStatus := store.Normal
+	find := &store.FindMemo{
+		ExcludeComments: true,
+		ExcludeContent:  true,
+		RowStatus:       &rowStatus,
+	}
+	applyMemoVisibilityFilter(find, userID)
+	memos, err := s.Store.ListMemos(ctx, find)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list memos")
+	}
+
+	counts := make(map[string]int)
+	for _, m := range memos {
+		for _, tag := range m.Payload.GetTags() {
+			counts[tag]++
+		}
+	}
+	tags := make([]string, 0, len(counts))
+	for tag := range counts {
+		tags = append(tags, tag)
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		if counts[tags[i]] != counts[tags[j]] {
+			return counts[tags[i]] > counts[tags[j]]
+		}
+		return tags[i] < tags[j]
+	})
+	if len(tags) > maxTagVocabulary {
+		tags = tags[:maxTagVocabulary]
+	}
+	return tags, nil
+}
+
+// buildTagSuggestionPrompt asks the model to pick tags for a memo from tags.
+func buildTagSuggestionPrompt(tags []string, content string) string {
+	return fmt.Sprintf(`Pick up to %d tags for the note below from the list of tags already in use. Only pick tags that clearly fit the note, and never make up new ones.
+Reply with the chosen tags separated by commas, or with NONE if no tag fits.
+
+Tags in use:
+%s
+
+Note:
+%s`, maxSuggestedTags, strings.Join(tags, ", "), content)
+}
+
+// parseSuggestedTags extracts the tags of the model's reply that are among
+// candidates, ignoring case and leading hashes.
+func parseSuggestedTags(reply string, candidates []string) []string {
+	known := make(map[string]string, len(candidates))
+	for _, tag := range candidates {
+		known[strings.ToLower(tag)] = tag
+	}
+	var tags []string
+	for _, field := range strings.FieldsFunc(reply, func(r rune) bool {
+		return r == ',' || r == '\n' || r == ' '
+	}) {
+		tag, ok := known[strings.ToLower(strings.TrimLeft(strings.TrimSpace(field), "#"))]
+		if !ok || slices.Contains(tags, tag) {
+			continue
+		}
+		tags = append(tags, tag)
+		if len(tags) == maxSuggestedTags {
+			break
+		}
+	}
+	return tags
+}
+
+func (s *APIV1Service) AcceptMemoTagSuggestions(ctx context.Context, request *v1pb.AcceptMemoTagSuggestionsRequest) (*v1pb.Memo, error) {
+	memoUID, err := ExtractMemoUIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	user, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	// Suggestions are for the creator to review.
+	if memo.CreatorID != user.ID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	for _, tag := range request.Tags {
+		if !slices.Contains(memo.Payload.GetSuggestedTags(), tag) {
+			return nil, status.Errorf(codes.InvalidArgument, "tag %q was not suggested", tag)
+		}
+	}
+
+	if len(request.Tags) == 0 {
+		// Only the suggestions are cleared, keeping changes to the rest of
+		// the payload saved since the memo was read.
+		if err := s.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, SuggestedTags: &[]string{}}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to update memo")
+		}
+		s.SSEHub.Broadcast(&SSEEvent{
+			Type: SSEEventMemoUpdated,
+			Name: request.Name,
+		})
+		return s.GetMemo(ctx, &v1pb.GetMemoRequest{Name: request.Name})
+	}
+
+	// Changing the content clears the remaining suggestions.
+	return s.UpdateMemo(withoutTagSuggestion(ctx), &v1pb.UpdateMemoRequest{
+		Memo: &v1pb.Memo{
+			Name:    request.Name,
+			Content: appendTags(memo.Content, request.Tags),
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+}
+
+// appendTags adds tags to the end of content, on the last line if it only
+// holds tags and on a line of their own otherwise.
+func appendTags(content string, tags []string) string {
+	hashed := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		hashed = append(hashed, "#"+tag)
+	}
+	content = strings.TrimRight(content, " \t\n")
+	lastLine := content[strings.LastIndex(content, "\n")+1:]
+	switch {
+	case content == "":
+		return strings.Join(hashed, " ")
+	case isTagLine(lastLine):
+		return content + " " + strings.Join(hashed, " ")
+	default:
+		return content + "\n\n" + strings.Join(hashed, " ")
+	}
+}
+
+// isTagLine reports whether line is made of tags only.
+func isTagLine(line string) bool {
+	fields := strings.Fields(line)
+	for _, field := range fields {
+		if len(field) < 2 || field[0] != '#' || field[1] == '#' {
+			return false
+		}
+	}
+	return len(fields) > 0
+}
@@ -0,0 +1,22 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSuggestedTags(t *testing.T) {
+	candidates := []string{"garden", "work/meetings", "Books"}
+	require.Equal(t, []string{"garden", "Books"}, parseSuggestedTags("#Garden, books, cooking", candidates))
+	require.Equal(t, []string{"work/meetings"}, parseSuggestedTags("work/meetings\nwork/meetings", candidates))
+	require.Empty(t, parseSuggestedTags("NONE", candidates))
+	require.Len(t, parseSuggestedTags("garden, work/meetings, books", []string{"garden", "work/meetings", "books", "x"}), maxSuggestedTags)
+}
+
+func TestAppendTags(t *testing.T) {
+	require.Equal(t, "Repotted the basil\n\n#garden #plants", appendTags("Repotted the basil\n", []string{"garden", "plants"}))
+	require.Equal(t, "Repotted the basil\n\n#home #garden", appendTags("Repotted the basil\n\n#home", []string{"garden"}))
+	require.Equal(t, "# Title\n\n#garden", appendTags("# Title", []string{"garden"}))
+	require.Equal(t, "#garden", appendTags("", []string{"garden"}))
+}
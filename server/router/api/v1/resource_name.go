@@ -23,6 +23,10 @@ const (
 	IdentityProviderNamePrefix = "identity-providers/"
 	ActivityNamePrefix         = "activities/"
 	WebhookNamePrefix          = "webhooks/"
+	AISessionNamePrefix        = "aiSessions/"
+	AIMessageNamePrefix        = "messages/"
+	AIMemoryNamePrefix         = "aiMemories/"
+	AITurnNamePrefix           = "turns/"
 )
 
 // GetNameParentTokens returns the tokens from a resource name.
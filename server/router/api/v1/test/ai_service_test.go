@@ -0,0 +1,326 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestAISessions(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("session lifecycle", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+
+		user, err := ts.CreateRegularUser(ctx, "testuser")
+		require.NoError(t, err)
+		userCtx := ts.CreateUserContext(ctx, user.ID)
+		parent := fmt.Sprintf("users/%d", user.ID)
+
+		created, err := ts.Service.CreateAISession(userCtx, &v1pb.CreateAISessionRequest{Parent: parent})
+		require.NoError(t, err)
+		require.Equal(t, "New Chat", created.Title)
+		require.Regexp(t, fmt.Sprintf("^users/%d/aiSessions/[^/]+$", user.ID), created.Name)
+
+		updated, err := ts.Service.UpdateAISession(userCtx, &v1pb.UpdateAISessionRequest{
+			AiSession:  &v1pb.AISession{Name: created.Name, Title: "Trip planning"},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title"}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, "Trip planning", updated.Title)
+
+		got, err := ts.Service.GetAISession(userCtx, &v1pb.GetAISessionRequest{Name: created.Name})
+		require.NoError(t, err)
+		require.Equal(t, "Trip planning", got.Title)
+
+		messages, err := ts.Service.ListAIMessages(userCtx, &v1pb.ListAIMessagesRequest{Parent: created.Name})
+		require.NoError(t, err)
+		require.Empty(t, messages.AiMessages)
+		require.Empty(t, messages.NextPageToken)
+
+		_, err = ts.Service.DeleteAISession(userCtx, &v1pb.DeleteAISessionRequest{Name: created.Name})
+		require.NoError(t, err)
+		_, err = ts.Service.GetAISession(userCtx, &v1pb.GetAISessionRequest{Name: created.Name})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("list sessions with page tokens", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+
+		user, err := ts.CreateRegularUser(ctx, "testuser")
+		require.NoError(t, err)
+		userCtx := ts.CreateUserContext(ctx, user.ID)
+		parent := fmt.Sprintf("users/%d", user.ID)
+
+		for i := 0; i < 3; i++ {
+			_, err := ts.Service.CreateAISession(userCtx, &v1pb.CreateAISessionRequest{
+				Parent:    parent,
+				AiSession: &v1pb.AISession{Title: fmt.Sprintf("Chat %d", i)},
+			})
+			require.NoError(t, err)
+		}
+
+		first, err := ts.Service.ListAISessions(userCtx, &v1pb.ListAISessionsRequest{Parent: parent, PageSize: 2})
+		require.NoError(t, err)
+		require.Len(t, first.AiSessions, 2)
+		require.NotEmpty(t, first.NextPageToken)
+
+		second, err := ts.Service.ListAISessions(userCtx, &v1pb.ListAISessionsRequest{Parent: parent, PageToken: first.NextPageToken})
+		require.NoError(t, err)
+		require.Len(t, second.AiSessions, 1)
+		require.Empty(t, second.NextPageToken)
+
+		names := map[string]bool{}
+		for _, sess := range append(first.AiSessions, second.AiSessions...) {
+			names[sess.Name] = true
+		}
+		require.Len(t, names, 3)
+	})
+
+	t.Run("search sessions and messages", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+
+		user, err := ts.CreateRegularUser(ctx, "testuser")
+		require.NoError(t, err)
+		userCtx := ts.CreateUserContext(ctx, user.ID)
+		parent := fmt.Sprintf("users/%d", user.ID)
+
+		created, err := ts.Service.CreateAISession(userCtx, &v1pb.CreateAISessionRequest{
+			Parent:    parent,
+			AiSession: &v1pb.AISession{Title: "Release checklist"},
+		})
+		require.NoError(t, err)
+		uid := created.Name[strings.LastIndex(created.Name, "/")+1:]
+		sess, err := ts.Store.GetAIChatSession(ctx, &store.FindAIChatSession{UID: &uid})
+		require.NoError(t, err)
+		message, err := ts.Store.CreateAIChatMessage(ctx, &store.CreateAIChatMessage{
+			SessionID: sess.ID,
+			Role:      "assistant",
+			Content:   "To deploy, tag the release and run Deploy.",
+		})
+		require.NoError(t, err)
+
+		response, err := ts.Service.SearchAISessions(userCtx, &v1pb.SearchAISessionsRequest{Parent: parent, Query: "deploy"})
+		require.NoError(t, err)
+		require.Len(t, response.Results, 1)
+		result := response.Results[0]
+		require.Equal(t, created.Name, result.AiSession)
+		require.Equal(t, "Release checklist", result.Title)
+		require.Equal(t, fmt.Sprintf("%s/messages/%d", created.Name, message.ID), result.Message)
+		require.Equal(t, v1pb.AIMessage_ASSISTANT, result.Role)
+		require.Equal(t, "To deploy, tag the release and run Deploy.", result.Snippet)
+		require.Len(t, result.Highlights, 2)
+		require.Equal(t, int32(3), result.Highlights[0].Start)
+		require.Equal(t, int32(9), result.Highlights[0].End)
+
+		// The session matches on its title as well as the message.
+		response, err = ts.Service.SearchAISessions(userCtx, &v1pb.SearchAISessionsRequest{Parent: parent, Query: "release", PageSize: 1})
+		require.NoError(t, err)
+		require.Len(t, response.Results, 1)
+		require.NotEmpty(t, response.NextPageToken)
+		next, err := ts.Service.SearchAISessions(userCtx, &v1pb.SearchAISessionsRequest{Parent: parent, Query: "release", PageToken: response.NextPageToken})
+		require.NoError(t, err)
+		require.Len(t, next.Results, 1)
+		require.Empty(t, next.NextPageToken)
+		require.ElementsMatch(t,
+			[]string{"", fmt.Sprintf("%s/messages/%d", created.Name, message.ID)},
+			[]string{response.Results[0].Message, next.Results[0].Message})
+
+		_, err = ts.Service.SearchAISessions(userCtx, &v1pb.SearchAISessionsRequest{Parent: parent, Query: " "})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("save answers as memos", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+
+		user, err := ts.CreateRegularUser(ctx, "testuser")
+		require.NoError(t, err)
+		userCtx := ts.CreateUserContext(ctx, user.ID)
+
+		cited, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+			Memo: &v1pb.Memo{Content: "Deploys run from the release branch.", Visibility: v1pb.Visibility_PRIVATE},
+		})
+		require.NoError(t, err)
+		created, err := ts.Service.CreateAISession(userCtx, &v1pb.CreateAISessionRequest{
+			Parent:    fmt.Sprintf("users/%d", user.ID),
+			AiSession: &v1pb.AISession{Title: "Deploys"},
+		})
+		require.NoError(t, err)
+		uid := created.Name[strings.LastIndex(created.Name, "/")+1:]
+		sess, err := ts.Store.GetAIChatSession(ctx, &store.FindAIChatSession{UID: &uid})
+		require.NoError(t, err)
+		var parentID int32
+		for _, create := range []*store.CreateAIChatMessage{
+			{Role: "user", Content: "Where do deploys run from?"},
+			{Role: "assistant", ToolCalls: `[{"id":"call_1","type":"function","function":{"name":"search_memos","arguments":"{}"}}]`},
+			{Role: "tool", ToolName: "search_memos", ToolCallID: "call_1", Content: fmt.Sprintf("[1] Note %s (score 0.033):\nDeploys run from the release branch.\n\n[2] Note gone (score 0.016):\nDeleted.", cited.Name[len("memos/"):])},
+			{Role: "assistant", Content: "From the release branch."},
+		} {
+			create.SessionID = sess.ID
+			create.ParentID = parentID
+			message, err := ts.Store.CreateAIChatMessage(ctx, create)
+			require.NoError(t, err)
+			parentID = message.ID
+		}
+
+		memo, err := ts.Service.SaveAISessionAsMemo(userCtx, &v1pb.SaveAISessionAsMemoRequest{
+			Name:    created.Name,
+			Message: fmt.Sprintf("%s/messages/%d", created.Name, parentID),
+			Tag:     "#ai",
+		})
+		require.NoError(t, err)
+		require.Equal(t, "From the release branch.\n\n#ai", memo.Content)
+		require.Equal(t, v1pb.Visibility_PRIVATE, memo.Visibility)
+		require.Len(t, memo.Relations, 1)
+		require.Equal(t, cited.Name, memo.Relations[0].RelatedMemo.Name)
+		require.Equal(t, v1pb.MemoRelation_REFERENCE, memo.Relations[0].Type)
+
+		memo, err = ts.Service.SaveAISessionAsMemo(userCtx, &v1pb.SaveAISessionAsMemoRequest{Name: created.Name})
+		require.NoError(t, err)
+		require.Equal(t, "# Deploys\n\n**You:** Where do deploys run from?\n\n**Assistant:** From the release branch.", memo.Content)
+		require.Len(t, memo.Relations, 1)
+
+		// Only assistant answers can be saved on their own.
+		_, err = ts.Service.SaveAISessionAsMemo(userCtx, &v1pb.SaveAISessionAsMemoRequest{
+			Name:    created.Name,
+			Message: fmt.Sprintf("%s/messages/%d", created.Name, parentID-3),
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = ts.Service.SaveAISessionAsMemo(userCtx, &v1pb.SaveAISessionAsMemoRequest{Name: created.Name, Tag: "two words"})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("sessions of other users are not accessible", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+
+		user1, err := ts.CreateRegularUser(ctx, "user1")
+		require.NoError(t, err)
+		user2, err := ts.CreateRegularUser(ctx, "user2")
+		require.NoError(t, err)
+		user1Ctx := ts.CreateUserContext(ctx, user1.ID)
+		user2Ctx := ts.CreateUserContext(ctx, user2.ID)
+
+		created, err := ts.Service.CreateAISession(user1Ctx, &v1pb.CreateAISessionRequest{Parent: fmt.Sprintf("users/%d", user1.ID)})
+		require.NoError(t, err)
+
+		_, err = ts.Service.GetAISession(user2Ctx, &v1pb.GetAISessionRequest{Name: created.Name})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = ts.Service.ListAISessions(user2Ctx, &v1pb.ListAISessionsRequest{Parent: fmt.Sprintf("users/%d", user1.ID)})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = ts.Service.CreateAISession(user2Ctx, &v1pb.CreateAISessionRequest{Parent: fmt.Sprintf("users/%d", user1.ID)})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("usage is for admins only", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+
+		host, err := ts.CreateHostUser(ctx, "admin")
+		require.NoError(t, err)
+		user, err := ts.CreateRegularUser(ctx, "testuser")
+		require.NoError(t, err)
+
+		_, err = ts.Service.GetAIUsage(ts.CreateUserContext(ctx, user.ID), &v1pb.GetAIUsageRequest{})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		usage, err := ts.Service.GetAIUsage(ts.CreateUserContext(ctx, host.ID), &v1pb.GetAIUsageRequest{Month: "2026-02"})
+		require.NoError(t, err)
+		require.Equal(t, int64(1769904000), usage.StartTime.Seconds)
+		require.Equal(t, int64(1772323200), usage.EndTime.Seconds)
+
+		_, err = ts.Service.GetAIUsage(ts.CreateUserContext(ctx, host.ID), &v1pb.GetAIUsageRequest{Month: "February"})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestAIMemories(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "testuser")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+	parent := fmt.Sprintf("users/%d", user.ID)
+
+	// The assistant saves memories through its remember tool.
+	created, err := ts.Store.CreateAIMemory(ctx, &store.AIMemory{UserID: user.ID, Content: "Prefers metric units."})
+	require.NoError(t, err)
+	name := fmt.Sprintf("%s/aiMemories/%d", parent, created.ID)
+
+	memories, err := ts.Service.ListAIMemories(userCtx, &v1pb.ListAIMemoriesRequest{Parent: parent})
+	require.NoError(t, err)
+	require.Len(t, memories.AiMemories, 1)
+	require.Equal(t, name, memories.AiMemories[0].Name)
+	require.Equal(t, "Prefers metric units.", memories.AiMemories[0].Content)
+
+	updated, err := ts.Service.UpdateAIMemory(userCtx, &v1pb.UpdateAIMemoryRequest{
+		AiMemory:   &v1pb.AIMemory{Name: name, Content: "  Prefers metric units and 24-hour time. "},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Prefers metric units and 24-hour time.", updated.Content)
+
+	_, err = ts.Service.UpdateAIMemory(userCtx, &v1pb.UpdateAIMemoryRequest{
+		AiMemory:   &v1pb.AIMemory{Name: name, Content: " "},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Memories of other users are not accessible.
+	_, err = ts.Service.ListAIMemories(otherCtx, &v1pb.ListAIMemoriesRequest{Parent: parent})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.DeleteAIMemory(otherCtx, &v1pb.DeleteAIMemoryRequest{Name: name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.DeleteAIMemory(otherCtx, &v1pb.DeleteAIMemoryRequest{Name: fmt.Sprintf("users/%d/aiMemories/%d", other.ID, created.ID)})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = ts.Service.DeleteAIMemory(userCtx, &v1pb.DeleteAIMemoryRequest{Name: name})
+	require.NoError(t, err)
+	memories, err = ts.Service.ListAIMemories(userCtx, &v1pb.ListAIMemoriesRequest{Parent: parent})
+	require.NoError(t, err)
+	require.Empty(t, memories.AiMemories)
+}
+
+func TestCancelAITurn(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "testuser")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	sess, err := ts.Service.CreateAISession(userCtx, &v1pb.CreateAISessionRequest{Parent: fmt.Sprintf("users/%d", user.ID)})
+	require.NoError(t, err)
+	require.Empty(t, sess.RunningTurn)
+
+	_, err = ts.Service.CancelAITurn(userCtx, &v1pb.CancelAITurnRequest{Name: sess.Name})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = ts.Service.CancelAITurn(userCtx, &v1pb.CancelAITurnRequest{Name: sess.Name + "/turns/missing"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// Turns of other users' sessions are not accessible.
+	_, err = ts.Service.CancelAITurn(otherCtx, &v1pb.CancelAITurnRequest{Name: sess.Name + "/turns/missing"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
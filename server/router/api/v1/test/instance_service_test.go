@@ -4,8 +4,10 @@ import (
 	"context"
 	"testing"
 
+	chromem "github.com/philippgille/chromem-go"
 	"github.com/stretchr/testify/require"
 
+	"github.com/usememos/memos/plugin/vectorstore"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 )
 
@@ -202,3 +204,74 @@ func TestGetInstanceSetting(t *testing.T) {
 		require.Contains(t, err.Error(), "invalid instance setting name")
 	})
 }
+
+func TestGetEmbeddingIndex(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	hostUser, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	adminCtx := ts.CreateUserContext(ctx, hostUser.ID)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	// Semantic search is not configured.
+	_, err = ts.Service.GetEmbeddingIndex(adminCtx, &v1pb.GetEmbeddingIndexRequest{})
+	require.Error(t, err)
+
+	backend, err := vectorstore.NewBackend(ctx, ts.Profile.Driver, ts.Store.GetDriver().GetDB(), t.TempDir())
+	require.NoError(t, err)
+	vectorStore, err := vectorstore.New(ctx, backend, "words", func(string) chromem.EmbeddingFunc {
+		return wordEmbed
+	})
+	require.NoError(t, err)
+	ts.Service.VectorStore = vectorStore
+
+	resp, err := ts.Service.GetEmbeddingIndex(adminCtx, &v1pb.GetEmbeddingIndexRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "words", resp.Model)
+	require.Nil(t, resp.Reindex)
+
+	_, err = ts.Service.GetEmbeddingIndex(userCtx, &v1pb.GetEmbeddingIndexRequest{})
+	require.Error(t, err)
+}
+
+func TestUpdateInstanceAISetting(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+	hostUser, err := ts.CreateHostUser(ctx, "testhost")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, hostUser.ID)
+
+	update := func(setting *v1pb.InstanceSetting_AISetting) (*v1pb.InstanceSetting, error) {
+		return ts.Service.UpdateInstanceSetting(userCtx, &v1pb.UpdateInstanceSettingRequest{
+			Setting: &v1pb.InstanceSetting{
+				Name:  "instance/settings/AI",
+				Value: &v1pb.InstanceSetting_AiSetting{AiSetting: setting},
+			},
+		})
+	}
+
+	resp, err := update(&v1pb.InstanceSetting_AISetting{
+		DisabledTools:        []string{"delete_memo", "search_internet"},
+		AllowedModels:        []string{"gpt-4o-mini", "gpt-4o"},
+		SystemPromptPreamble: "You are the ACME knowledge assistant.",
+		MaxAgentRounds:       5,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"delete_memo", "search_internet"}, resp.GetAiSetting().DisabledTools)
+	require.Equal(t, []string{"gpt-4o-mini", "gpt-4o"}, resp.GetAiSetting().AllowedModels)
+	require.Equal(t, "You are the ACME knowledge assistant.", resp.GetAiSetting().SystemPromptPreamble)
+	require.Equal(t, int32(5), resp.GetAiSetting().MaxAgentRounds)
+
+	_, err = update(&v1pb.InstanceSetting_AISetting{DisabledTools: []string{"format_disk"}})
+	require.ErrorContains(t, err, `unknown tool "format_disk"`)
+	_, err = update(&v1pb.InstanceSetting_AISetting{AllowedModels: []string{" "}})
+	require.ErrorContains(t, err, "allowed models must not be empty")
+	_, err = update(&v1pb.InstanceSetting_AISetting{MaxAgentRounds: 51})
+	require.ErrorContains(t, err, "max agent rounds must be between 0 and 50")
+}
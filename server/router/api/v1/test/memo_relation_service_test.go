@@ -2,10 +2,15 @@ package test
 
 import (
 	"context"
+	"hash/fnv"
+	"strings"
 	"testing"
+	"time"
 
+	chromem "github.com/philippgille/chromem-go"
 	"github.com/stretchr/testify/require"
 
+	"github.com/usememos/memos/plugin/vectorstore"
 	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
 )
 
@@ -167,3 +172,93 @@ func TestSetMemoRelations(t *testing.T) {
 		require.Contains(t, err.Error(), "not found")
 	})
 }
+
+// wordEmbed embeds text as a bag of hashed words, so memos sharing words are similar.
+func wordEmbed(_ context.Context, text string) ([]float32, error) {
+	vector := make([]float32, 32)
+	for _, word := range strings.Fields(strings.ToLower(text)) {
+		h := fnv.New32a()
+		_, _ = h.Write([]byte(word))
+		vector[h.Sum32()%32]++
+	}
+	return vector, nil
+}
+
+func TestListRelatedMemos(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+	backend, err := vectorstore.NewBackend(ctx, ts.Profile.Driver, ts.Store.GetDriver().GetDB(), t.TempDir())
+	require.NoError(t, err)
+	vectorStore, err := vectorstore.New(ctx, backend, "words", func(string) chromem.EmbeddingFunc {
+		return wordEmbed
+	})
+	require.NoError(t, err)
+	ts.Service.VectorStore = vectorStore
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	var memos []*apiv1.Memo
+	for _, memo := range []*apiv1.Memo{
+		{Content: "tomatoes basil garden plan", Visibility: apiv1.Visibility_PUBLIC},
+		{Content: "tomatoes basil garden harvest", Visibility: apiv1.Visibility_PRIVATE},
+		{Content: "tomatoes garden soil", Visibility: apiv1.Visibility_PUBLIC},
+		{Content: "quarterly budget review", Visibility: apiv1.Visibility_PUBLIC},
+	} {
+		created, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{Memo: memo})
+		require.NoError(t, err)
+		memos = append(memos, created)
+	}
+	// Memos are indexed in the background.
+	require.Eventually(t, func() bool {
+		for _, memo := range memos {
+			if !vectorStore.HasMemo(ctx, user.ID, strings.TrimPrefix(memo.Name, "memos/")) {
+				return false
+			}
+		}
+		return true
+	}, 5*time.Second, 10*time.Millisecond)
+
+	names := func(resp *apiv1.ListRelatedMemosResponse) []string {
+		var names []string
+		for _, r := range resp.Results {
+			names = append(names, r.Memo.Name)
+		}
+		return names
+	}
+
+	resp, err := ts.Service.ListRelatedMemos(userCtx, &apiv1.ListRelatedMemosRequest{Name: memos[0].Name, PageSize: 2})
+	require.NoError(t, err)
+	require.Equal(t, []string{memos[1].Name, memos[2].Name}, names(resp))
+	require.Greater(t, resp.Results[0].Score, resp.Results[1].Score)
+
+	// Other users only get the memos they can see.
+	resp, err = ts.Service.ListRelatedMemos(otherCtx, &apiv1.ListRelatedMemosRequest{Name: memos[0].Name, PageSize: 2})
+	require.NoError(t, err)
+	require.Equal(t, []string{memos[2].Name, memos[3].Name}, names(resp))
+	_, err = ts.Service.ListRelatedMemos(otherCtx, &apiv1.ListRelatedMemosRequest{Name: memos[1].Name})
+	require.Error(t, err)
+
+	// Accepting a suggestion as a reference removes it from the suggestions,
+	// for both memos.
+	_, err = ts.Service.SetMemoRelations(userCtx, &apiv1.SetMemoRelationsRequest{
+		Name: memos[0].Name,
+		Relations: []*apiv1.MemoRelation{{
+			RelatedMemo: &apiv1.MemoRelation_Memo{Name: memos[1].Name},
+			Type:        apiv1.MemoRelation_REFERENCE,
+		}},
+	})
+	require.NoError(t, err)
+	resp, err = ts.Service.ListRelatedMemos(userCtx, &apiv1.ListRelatedMemosRequest{Name: memos[0].Name, PageSize: 2})
+	require.NoError(t, err)
+	require.Equal(t, []string{memos[2].Name, memos[3].Name}, names(resp))
+	resp, err = ts.Service.ListRelatedMemos(userCtx, &apiv1.ListRelatedMemosRequest{Name: memos[1].Name, PageSize: 1})
+	require.NoError(t, err)
+	require.Equal(t, []string{memos[2].Name}, names(resp))
+}
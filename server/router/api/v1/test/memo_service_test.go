@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"slices"
+	"strings"
 	"testing"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
 )
 
 func TestListMemos(t *testing.T) {
@@ -367,3 +369,170 @@ func TestCreateMemoWithCustomTimestamps(t *testing.T) {
 	require.NotNil(t, memoWithoutTimestamps.UpdateTime, "update_time should be auto-generated")
 	require.True(t, time.Now().Unix()-memoWithoutTimestamps.CreateTime.AsTime().Unix() < 5, "create_time should be recent (within 5 seconds)")
 }
+
+func TestSearchMemos(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	userOne, err := ts.CreateRegularUser(ctx, "search-user-1")
+	require.NoError(t, err)
+	userOneCtx := ts.CreateUserContext(ctx, userOne.ID)
+	userTwo, err := ts.CreateRegularUser(ctx, "search-user-2")
+	require.NoError(t, err)
+	userTwoCtx := ts.CreateUserContext(ctx, userTwo.ID)
+
+	for _, memo := range []*apiv1.Memo{
+		{Content: "Garden plan: tomatoes and basil", Visibility: apiv1.Visibility_PRIVATE},
+		{Content: "Garden plan for the garden shed #home", Visibility: apiv1.Visibility_PUBLIC},
+		{Content: "Quarterly plan review", Visibility: apiv1.Visibility_PRIVATE},
+	} {
+		_, err := ts.Service.CreateMemo(userOneCtx, &apiv1.CreateMemoRequest{Memo: memo})
+		require.NoError(t, err)
+	}
+
+	// Both garden memos match; the one mentioning the term twice ranks first.
+	resp, err := ts.Service.SearchMemos(userOneCtx, &apiv1.SearchMemosRequest{Query: "garden"})
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 2)
+	require.Contains(t, resp.Results[0].Memo.Content, "garden shed")
+	require.Greater(t, resp.Results[0].Score, 0.0)
+	require.NotEmpty(t, resp.Results[1].Snippet)
+
+	// Other users and anonymous callers only see the public memo.
+	resp, err = ts.Service.SearchMemos(userTwoCtx, &apiv1.SearchMemosRequest{Query: "garden"})
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 1)
+	resp, err = ts.Service.SearchMemos(ctx, &apiv1.SearchMemosRequest{Query: "plan"})
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 1)
+
+	// Filters narrow the results like in ListMemos.
+	resp, err = ts.Service.SearchMemos(userOneCtx, &apiv1.SearchMemosRequest{Query: "plan", Filter: `tag in ["home"]`})
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 1)
+
+	resp, err = ts.Service.SearchMemos(userOneCtx, &apiv1.SearchMemosRequest{Query: "plan", PageSize: 2})
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 2)
+
+	_, err = ts.Service.SearchMemos(userOneCtx, &apiv1.SearchMemosRequest{Query: "  "})
+	require.Error(t, err)
+}
+
+func TestSearchMemosInAttachments(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "search-attachment-user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	attachment, err := ts.Service.CreateAttachment(userCtx, &apiv1.CreateAttachmentRequest{
+		Attachment: &apiv1.Attachment{
+			Filename: "invoice.csv",
+			Type:     "text/csv",
+			Content:  []byte("item,amount\nTelescope mount,420\n"),
+		},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{Memo: &apiv1.Memo{
+		Content:     "Receipts for the observatory",
+		Visibility:  apiv1.Visibility_PRIVATE,
+		Attachments: []*apiv1.Attachment{attachment},
+	}})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{Memo: &apiv1.Memo{
+		Content:    "Telescope wishlist",
+		Visibility: apiv1.Visibility_PRIVATE,
+	}})
+	require.NoError(t, err)
+
+	// Text is extracted in the background.
+	require.Eventually(t, func() bool {
+		texts, err := ts.Store.ListAttachmentTexts(ctx, &store.FindAttachmentText{})
+		return err == nil && len(texts) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	resp, err := ts.Service.SearchMemos(userCtx, &apiv1.SearchMemosRequest{Query: "telescope"})
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 2)
+	var fromAttachment *apiv1.SearchMemosResponse_Result
+	for _, r := range resp.Results {
+		if r.Attachment != "" {
+			fromAttachment = r
+		}
+	}
+	require.NotNil(t, fromAttachment)
+	require.Equal(t, "Receipts for the observatory", fromAttachment.Memo.Content)
+	require.Equal(t, attachment.Name, fromAttachment.Attachment)
+	require.Contains(t, fromAttachment.Snippet, "Telescope mount")
+
+	// Deleting the attachment drops its text.
+	_, err = ts.Service.DeleteAttachment(userCtx, &apiv1.DeleteAttachmentRequest{Name: attachment.Name})
+	require.NoError(t, err)
+	resp, err = ts.Service.SearchMemos(userCtx, &apiv1.SearchMemosRequest{Query: "telescope"})
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 1)
+	require.Empty(t, resp.Results[0].Attachment)
+}
+
+func TestAcceptMemoTagSuggestions(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	userOne, err := ts.CreateRegularUser(ctx, "tag-user-1")
+	require.NoError(t, err)
+	userOneCtx := ts.CreateUserContext(ctx, userOne.ID)
+	userTwo, err := ts.CreateRegularUser(ctx, "tag-user-2")
+	require.NoError(t, err)
+	userTwoCtx := ts.CreateUserContext(ctx, userTwo.ID)
+
+	memo, err := ts.Service.CreateMemo(userOneCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{Content: "Repotted the basil", Visibility: apiv1.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+	require.Empty(t, memo.SuggestedTags)
+
+	suggest := func(tags ...string) {
+		uid := strings.TrimPrefix(memo.Name, "memos/")
+		stored, err := ts.Store.GetMemo(ctx, &store.FindMemo{UID: &uid})
+		require.NoError(t, err)
+		stored.Payload.SuggestedTags = tags
+		require.NoError(t, ts.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: stored.ID, Payload: stored.Payload}))
+	}
+	suggest("garden", "plants", "home")
+
+	// Only the creator sees and reviews the suggestions.
+	got, err := ts.Service.GetMemo(userOneCtx, &apiv1.GetMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	require.Equal(t, []string{"garden", "plants", "home"}, got.SuggestedTags)
+	got, err = ts.Service.GetMemo(userTwoCtx, &apiv1.GetMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	require.Empty(t, got.SuggestedTags)
+	_, err = ts.Service.AcceptMemoTagSuggestions(userTwoCtx, &apiv1.AcceptMemoTagSuggestionsRequest{Name: memo.Name, Tags: []string{"garden"}})
+	require.Error(t, err)
+
+	// Tags that were not suggested cannot be accepted.
+	_, err = ts.Service.AcceptMemoTagSuggestions(userOneCtx, &apiv1.AcceptMemoTagSuggestionsRequest{Name: memo.Name, Tags: []string{"work"}})
+	require.Error(t, err)
+
+	// Accepted tags are added to the content and the rest are dismissed.
+	got, err = ts.Service.AcceptMemoTagSuggestions(userOneCtx, &apiv1.AcceptMemoTagSuggestionsRequest{Name: memo.Name, Tags: []string{"garden", "plants"}})
+	require.NoError(t, err)
+	require.Equal(t, "Repotted the basil\n\n#garden #plants", got.Content)
+	require.Equal(t, []string{"garden", "plants"}, got.Tags)
+	require.Empty(t, got.SuggestedTags)
+
+	// Accepting none dismisses all suggestions without touching the content.
+	suggest("home")
+	got, err = ts.Service.AcceptMemoTagSuggestions(userOneCtx, &apiv1.AcceptMemoTagSuggestionsRequest{Name: memo.Name})
+	require.NoError(t, err)
+	require.Equal(t, "Repotted the basil\n\n#garden #plants", got.Content)
+	require.Empty(t, got.SuggestedTags)
+}
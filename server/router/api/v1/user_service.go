@@ -399,7 +399,47 @@ func (s *APIV1Service) UpdateUserSetting(ctx context.Context, request *v1pb.Upda
 		return nil, status.Errorf(codes.InvalidArgument, "invalid setting key: %v", err)
 	}
 
-	// Only GENERAL settings are supported via UpdateUserSetting
+	if storeKey == storepb.UserSetting_AI {
+		incomingAI := request.Setting.GetAiSetting()
+		if incomingAI == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "ai setting is required")
+		}
+		existing, err := s.getUserAISetting(ctx, userID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user setting: %v", err)
+		}
+		updatedAI := &v1pb.UserSetting_AISetting{
+			ConfirmTools: existing.ConfirmTools,
+			McpServers:   convertMCPServersFromStore(existing.McpServers),
+			SuggestTags:  existing.SuggestTags,
+		}
+		for _, field := range request.UpdateMask.Paths {
+			switch field {
+			case "confirm_tools":
+				updatedAI.ConfirmTools = incomingAI.ConfirmTools
+			case "mcp_servers":
+				if err := validateMCPServers(incomingAI.McpServers, false); err != nil {
+					return nil, status.Errorf(codes.InvalidArgument, "invalid MCP servers: %v", err)
+				}
+				updatedAI.McpServers = incomingAI.McpServers
+			case "suggest_tags":
+				updatedAI.SuggestTags = incomingAI.SuggestTags
+			}
+		}
+		storeSetting, err := convertUserSettingToStore(&v1pb.UserSetting{
+			Name:  request.Setting.Name,
+			Value: &v1pb.UserSetting_AiSetting{AiSetting: updatedAI},
+		}, userID, storeKey)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to convert setting: %v", err)
+		}
+		if _, err := s.Store.UpsertUserSetting(ctx, storeSetting); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
+		}
+		return s.GetUserSetting(ctx, &v1pb.GetUserSettingRequest{Name: request.Setting.Name})
+	}
+
+	// Only GENERAL and AI settings are supported via UpdateUserSetting
 	// Other setting types have dedicated service methods
 	if storeKey != storepb.UserSetting_GENERAL {
 		return nil, status.Errorf(codes.InvalidArgument, "setting type %s should not be updated via UpdateUserSetting", storeKey.String())
@@ -999,6 +1039,8 @@ func convertSettingKeyToStore(key string) (storepb.UserSetting_Key, error) {
 		return storepb.UserSetting_GENERAL, nil
 	case v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_WEBHOOKS)]:
 		return storepb.UserSetting_WEBHOOKS, nil
+	case v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_AI)]:
+		return storepb.UserSetting_AI, nil
 	default:
 		return storepb.UserSetting_KEY_UNSPECIFIED, errors.Errorf("unknown setting key: %s", key)
 	}
@@ -1013,6 +1055,8 @@ func convertSettingKeyFromStore(key storepb.UserSetting_Key) string {
 		return "SHORTCUTS" // Not defined in API proto
 	case storepb.UserSetting_WEBHOOKS:
 		return v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_WEBHOOKS)]
+	case storepb.UserSetting_AI:
+		return v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_AI)]
 	default:
 		return "unknown"
 	}
@@ -1034,6 +1078,12 @@ func convertUserSettingFromStore(storeSetting *storepb.UserSetting, userID int32
 					Webhooks: []*v1pb.UserWebhook{},
 				},
 			}
+		case storepb.UserSetting_AI:
+			setting.Value = &v1pb.UserSetting_AiSetting{
+				AiSetting: &v1pb.UserSetting_AISetting{
+					ConfirmTools: defaultConfirmTools,
+				},
+			}
 		default:
 			// Default to general setting
 			setting.Value = &v1pb.UserSetting_GeneralSetting_{
@@ -1079,6 +1129,14 @@ func convertUserSettingFromStore(storeSetting *storepb.UserSetting, userID int32
 				Webhooks: apiWebhooks,
 			},
 		}
+	case storepb.UserSetting_AI:
+		setting.Value = &v1pb.UserSetting_AiSetting{
+			AiSetting: &v1pb.UserSetting_AISetting{
+				ConfirmTools: storeSetting.GetAi().GetConfirmTools(),
+				McpServers:   convertMCPServersFromStore(storeSetting.GetAi().GetMcpServers()),
+				SuggestTags:  storeSetting.GetAi().GetSuggestTags(),
+			},
+		}
 	default:
 		// Default to general setting if unknown key
 		setting.Value = &v1pb.UserSetting_GeneralSetting_{
@@ -1128,6 +1186,18 @@ func convertUserSettingToStore(apiSetting *v1pb.UserSetting, userID int32, key s
 		} else {
 			return nil, errors.Errorf("webhooks setting is required")
 		}
+	case storepb.UserSetting_AI:
+		if ai := apiSetting.GetAiSetting(); ai != nil {
+			storeSetting.Value = &storepb.UserSetting_Ai{
+				Ai: &storepb.AIUserSetting{
+					ConfirmTools: ai.ConfirmTools,
+					McpServers:   convertMCPServersToStore(ai.McpServers),
+					SuggestTags:  ai.SuggestTags,
+				},
+			}
+		} else {
+			return nil, errors.Errorf("ai setting is required")
+		}
 	default:
 		return nil, errors.Errorf("unsupported setting key: %v", key)
 	}
@@ -11,6 +11,7 @@ import (
 	"golang.org/x/sync/semaphore"
 
 	"github.com/usememos/memos/internal/profile"
+	"github.com/usememos/memos/plugin/llm"
 	"github.com/usememos/memos/plugin/markdown"
 	"github.com/usememos/memos/plugin/vectorstore"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
@@ -27,6 +28,7 @@ type APIV1Service struct {
 	v1pb.UnimplementedShortcutServiceServer
 	v1pb.UnimplementedActivityServiceServer
 	v1pb.UnimplementedIdentityProviderServiceServer
+	v1pb.UnimplementedAIServiceServer
 
 	Secret          string
 	Profile         *profile.Profile
@@ -34,12 +36,19 @@ type APIV1Service struct {
 	MarkdownService markdown.Service
 	SSEHub          *SSEHub
 	VectorStore     *vectorstore.Store
+	// LLM is the chat model backend. Nil when AI is not configured.
+	LLM llm.Provider
+
+	// mcpPool holds the connections to the external MCP servers of the AI chat.
+	mcpPool mcpPool
+	// chatJobs holds the AI chat turns, so they can be cancelled and resumed.
+	chatJobs chatJobRegistry
 
 	// thumbnailSemaphore limits concurrent thumbnail generation to prevent memory exhaustion
 	thumbnailSemaphore *semaphore.Weighted
 }
 
-func NewAPIV1Service(secret string, profile *profile.Profile, store *store.Store, vs *vectorstore.Store) *APIV1Service {
+func NewAPIV1Service(secret string, profile *profile.Profile, store *store.Store, vs *vectorstore.Store, llmProvider llm.Provider) *APIV1Service {
 	markdownService := markdown.NewService(
 		markdown.WithTagExtension(),
 	)
@@ -50,6 +59,7 @@ func NewAPIV1Service(secret string, profile *profile.Profile, store *store.Store
 		MarkdownService:    markdownService,
 		SSEHub:             NewSSEHub(),
 		VectorStore:        vs,
+		LLM:                llmProvider,
 		thumbnailSemaphore: semaphore.NewWeighted(3), // Limit to 3 concurrent thumbnail generations
 	}
 }
@@ -116,6 +126,10 @@ func (s *APIV1Service) RegisterGateway(ctx context.Context, echoServer *echo.Ech
 	if err := v1pb.RegisterIdentityProviderServiceHandlerServer(ctx, gwMux, s); err != nil {
 		return err
 	}
+	// The gateway does not stream, so AI chat replies are only served over Connect.
+	if err := v1pb.RegisterAIServiceHandlerServer(ctx, gwMux, s); err != nil {
+		return err
+	}
 	gwGroup := echoServer.Group("")
 	gwGroup.Use(middleware.CORSWithConfig(middleware.CORSConfig{
 		AllowOrigins: []string{"*"},
@@ -153,8 +167,5 @@ func (s *APIV1Service) RegisterGateway(ctx context.Context, echoServer *echo.Ech
 	connectGroup := echoServer.Group("", corsHandler)
 	connectGroup.Any("/memos.api.v1.*", echo.WrapHandler(connectMux))
 
-	// AI chat routes (plain Echo, not gRPC)
-	s.registerAIChatRoutes(echoServer)
-
 	return nil
 }
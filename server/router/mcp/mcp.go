@@ -8,18 +8,21 @@ import (
 	mcpserver "github.com/mark3labs/mcp-go/server"
 
 	"github.com/usememos/memos/server/auth"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
 	"github.com/usememos/memos/store"
 )
 
 type MCPService struct {
 	store         *store.Store
 	authenticator *auth.Authenticator
+	apiV1Service  *apiv1.APIV1Service
 }
 
-func NewMCPService(store *store.Store, secret string) *MCPService {
+func NewMCPService(store *store.Store, secret string, apiV1Service *apiv1.APIV1Service) *MCPService {
 	return &MCPService{
 		store:         store,
 		authenticator: auth.NewAuthenticator(store, secret),
+		apiV1Service:  apiV1Service,
 	}
 }
 
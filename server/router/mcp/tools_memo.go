@@ -4,50 +4,19 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"regexp"
 	"strings"
 
-	"github.com/lithammer/shortuuid/v4"
 	"github.com/mark3labs/mcp-go/mcp"
 	mcpserver "github.com/mark3labs/mcp-go/server"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
-	storepb "github.com/usememos/memos/proto/gen/store"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 	"github.com/usememos/memos/server/auth"
 	"github.com/usememos/memos/store"
 )
 
-// tagRegexp matches #tag patterns in memo content.
-// A tag must start with a letter and contain no whitespace or # characters.
-var tagRegexp = regexp.MustCompile(`(?:^|\s)#([A-Za-z][^\s#]*)`)
-
-// extractTags does a best-effort extraction of #tags from raw markdown content.
-// It is used when creating or updating memos via MCP to pre-populate Payload.Tags.
-// The full markdown service may later rebuild a more accurate payload.
-func extractTags(content string) []string {
-	matches := tagRegexp.FindAllStringSubmatch(content, -1)
-	seen := make(map[string]struct{}, len(matches))
-	tags := make([]string, 0, len(matches))
-	for _, m := range matches {
-		tag := m[1]
-		if _, ok := seen[tag]; !ok {
-			seen[tag] = struct{}{}
-			tags = append(tags, tag)
-		}
-	}
-	return tags
-}
-
-// buildPayload constructs a MemoPayload with tags extracted from content.
-// Returns nil when no tags are found so the store omits the payload entirely.
-func buildPayload(content string) *storepb.MemoPayload {
-	tags := extractTags(content)
-	if len(tags) == 0 {
-		return nil
-	}
-	return &storepb.MemoPayload{Tags: tags}
-}
-
 // propertyJSON is the serialisable form of MemoPayload.Property.
 type propertyJSON struct {
 	HasLink            bool `json:"has_link"`
@@ -149,6 +118,11 @@ func parseVisibility(s string) (store.Visibility, error) {
 	}
 }
 
+// convertVisibility converts a store visibility to its API enum; the names match.
+func convertVisibility(v store.Visibility) v1pb.Visibility {
+	return v1pb.Visibility(v1pb.Visibility_value[string(v)])
+}
+
 // parseRowStatus validates a state string and returns the store constant.
 func parseRowStatus(s string) (store.RowStatus, error) {
 	switch rs := store.RowStatus(s); rs {
@@ -223,8 +197,10 @@ func (s *MCPService) registerMemoTools(mcpSrv *mcpserver.MCPServer) {
 	), s.handleDeleteMemo)
 
 	mcpSrv.AddTool(mcp.NewTool("search_memos",
-		mcp.WithDescription("Search memo content. Authenticated users search their own and visible memos; unauthenticated callers search public memos only."),
-		mcp.WithString("query", mcp.Required(), mcp.Description("Text to search for in memo content")),
+		mcp.WithDescription("Search memos by keyword and meaning, most relevant first. Authenticated users search their own and visible memos; unauthenticated callers search public memos only. Semantic matches are limited to the caller's own memos."),
+		mcp.WithString("query", mcp.Required(), mcp.Description("Words or a question to search for")),
+		mcp.WithNumber("page_size", mcp.Description("Maximum results to return (1–100, default 10)")),
+		mcp.WithString("filter", mcp.Description(`Optional CEL filter, e.g. tag in ["work"]`)),
 	), s.handleSearchMemos)
 
 	mcpSrv.AddTool(mcp.NewTool("list_memo_comments",
@@ -330,8 +306,7 @@ func (s *MCPService) handleGetMemo(ctx context.Context, req mcp.CallToolRequest)
 }
 
 func (s *MCPService) handleCreateMemo(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	userID, err := extractUserID(ctx)
-	if err != nil {
+	if _, err := extractUserID(ctx); err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 
@@ -344,22 +319,16 @@ func (s *MCPService) handleCreateMemo(ctx context.Context, req mcp.CallToolReque
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 
-	memo, err := s.store.CreateMemo(ctx, &store.Memo{
-		UID:        shortuuid.New(),
-		CreatorID:  userID,
-		Content:    content,
-		Visibility: visibility,
-		Payload:    buildPayload(content),
+	created, err := s.apiV1Service.CreateMemo(ctx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{
+			Content:    content,
+			Visibility: convertVisibility(visibility),
+		},
 	})
 	if err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("failed to create memo: %v", err)), nil
-	}
-
-	out, err := marshalJSON(storeMemoToJSON(memo))
-	if err != nil {
-		return nil, err
+		return mcp.NewToolResultError("failed to create memo: " + status.Convert(err).Message()), nil
 	}
-	return mcp.NewToolResultText(out), nil
+	return s.memoResult(ctx, created.Name)
 }
 
 func (s *MCPService) handleUpdateMemo(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -384,46 +353,45 @@ func (s *MCPService) handleUpdateMemo(ctx context.Context, req mcp.CallToolReque
 		return mcp.NewToolResultError("permission denied"), nil
 	}
 
-	update := &store.UpdateMemo{ID: memo.ID}
+	update := &v1pb.Memo{Name: "memos/" + memo.UID}
+	var paths []string
 	args := req.GetArguments()
 
 	if v := req.GetString("content", ""); v != "" {
-		update.Content = &v
-		update.Payload = buildPayload(v)
+		update.Content = v
+		paths = append(paths, "content")
 	}
 	if v := req.GetString("visibility", ""); v != "" {
 		vis, err := parseVisibility(v)
 		if err != nil {
 			return mcp.NewToolResultError(err.Error()), nil
 		}
-		update.Visibility = &vis
+		update.Visibility = convertVisibility(vis)
+		paths = append(paths, "visibility")
 	}
 	if v := req.GetString("state", ""); v != "" {
 		rs, err := parseRowStatus(v)
 		if err != nil {
 			return mcp.NewToolResultError(err.Error()), nil
 		}
-		update.RowStatus = &rs
+		update.State = v1pb.State(v1pb.State_value[string(rs)])
+		paths = append(paths, "state")
 	}
 	if _, ok := args["pinned"]; ok {
-		pinned := req.GetBool("pinned", false)
-		update.Pinned = &pinned
-	}
-
-	if err := s.store.UpdateMemo(ctx, update); err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("failed to update memo: %v", err)), nil
+		update.Pinned = req.GetBool("pinned", false)
+		paths = append(paths, "pinned")
 	}
-
-	updated, err := s.store.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
-	if err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("failed to fetch updated memo: %v", err)), nil
+	if len(paths) == 0 {
+		return s.memoResult(ctx, update.Name)
 	}
 
-	out, err := marshalJSON(storeMemoToJSON(updated))
-	if err != nil {
-		return nil, err
+	if _, err := s.apiV1Service.UpdateMemo(ctx, &v1pb.UpdateMemoRequest{
+		Memo:       update,
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: paths},
+	}); err != nil {
+		return mcp.NewToolResultError("failed to update memo: " + status.Convert(err).Message()), nil
 	}
-	return mcp.NewToolResultText(out), nil
+	return s.memoResult(ctx, update.Name)
 }
 
 func (s *MCPService) handleDeleteMemo(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -448,12 +416,32 @@ func (s *MCPService) handleDeleteMemo(ctx context.Context, req mcp.CallToolReque
 		return mcp.NewToolResultError("permission denied"), nil
 	}
 
-	if err := s.store.DeleteMemo(ctx, &store.DeleteMemo{ID: memo.ID}); err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("failed to delete memo: %v", err)), nil
+	if _, err := s.apiV1Service.DeleteMemo(ctx, &v1pb.DeleteMemoRequest{Name: "memos/" + memo.UID}); err != nil {
+		return mcp.NewToolResultError("failed to delete memo: " + status.Convert(err).Message()), nil
 	}
 	return mcp.NewToolResultText(`{"deleted":true}`), nil
 }
 
+// memoResult returns the memo with the given resource name as a tool result.
+func (s *MCPService) memoResult(ctx context.Context, name string) (*mcp.CallToolResult, error) {
+	uid, err := parseMemoUID(name)
+	if err != nil {
+		return nil, err
+	}
+	memo, err := s.store.GetMemo(ctx, &store.FindMemo{UID: &uid})
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to fetch memo: %v", err)), nil
+	}
+	if memo == nil {
+		return mcp.NewToolResultError("memo not found"), nil
+	}
+	out, err := marshalJSON(storeMemoToJSON(memo))
+	if err != nil {
+		return nil, err
+	}
+	return mcp.NewToolResultText(out), nil
+}
+
 func (s *MCPService) handleSearchMemos(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	userID := auth.GetUserID(ctx)
 
@@ -462,26 +450,27 @@ func (s *MCPService) handleSearchMemos(ctx context.Context, req mcp.CallToolRequ
 		return mcp.NewToolResultError("query is required"), nil
 	}
 
-	limit := 50
-	zero := 0
-	rowStatus := store.Normal
-	find := &store.FindMemo{
-		ExcludeComments: true,
-		RowStatus:       &rowStatus,
-		Limit:           &limit,
-		Offset:          &zero,
-		Filters:         []string{fmt.Sprintf(`content.contains(%q)`, query)},
+	pageSize := req.GetInt("page_size", 10)
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+	if pageSize > 100 {
+		pageSize = 100
 	}
-	applyVisibilityFilter(find, userID)
 
-	memos, err := s.store.ListMemos(ctx, find)
+	searchResults, err := s.apiV1Service.HybridSearchMemos(ctx, userID, query, req.GetString("filter", ""), pageSize)
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to search memos: %v", err)), nil
 	}
 
-	results := make([]memoJSON, len(memos))
-	for i, m := range memos {
-		results[i] = storeMemoToJSON(m)
+	type searchResult struct {
+		memoJSON
+		Score   float64 `json:"score"`
+		Snippet string  `json:"snippet"`
+	}
+	results := make([]searchResult, len(searchResults))
+	for i, r := range searchResults {
+		results[i] = searchResult{memoJSON: storeMemoToJSON(r.Memo), Score: r.Score, Snippet: r.Snippet}
 	}
 	out, err := marshalJSON(results)
 	if err != nil {
@@ -571,29 +560,15 @@ func (s *MCPService) handleCreateMemoComment(ctx context.Context, req mcp.CallTo
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 
-	comment, err := s.store.CreateMemo(ctx, &store.Memo{
-		UID:        shortuuid.New(),
-		CreatorID:  userID,
-		Content:    content,
-		Visibility: parent.Visibility,
-		Payload:    buildPayload(content),
-		ParentUID:  &parent.UID,
+	comment, err := s.apiV1Service.CreateMemoComment(ctx, &v1pb.CreateMemoCommentRequest{
+		Name: "memos/" + parent.UID,
+		Comment: &v1pb.Memo{
+			Content:    content,
+			Visibility: convertVisibility(parent.Visibility),
+		},
 	})
 	if err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("failed to create comment: %v", err)), nil
+		return mcp.NewToolResultError("failed to create comment: " + status.Convert(err).Message()), nil
 	}
-
-	if _, err = s.store.UpsertMemoRelation(ctx, &store.MemoRelation{
-		MemoID:        comment.ID,
-		RelatedMemoID: parent.ID,
-		Type:          store.MemoRelationComment,
-	}); err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("failed to link comment: %v", err)), nil
-	}
-
-	out, err := marshalJSON(storeMemoToJSON(comment))
-	if err != nil {
-		return nil, err
-	}
-	return mcp.NewToolResultText(out), nil
+	return s.memoResult(ctx, comment.Name)
 }
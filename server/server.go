@@ -9,13 +9,14 @@ import (
 	"runtime"
 	"time"
 
-	chromem "github.com/philippgille/chromem-go"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v5"
 	"github.com/labstack/echo/v5/middleware"
+	chromem "github.com/philippgille/chromem-go"
 	"github.com/pkg/errors"
 
 	"github.com/usememos/memos/internal/profile"
+	"github.com/usememos/memos/plugin/llm"
 	"github.com/usememos/memos/plugin/vectorstore"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	apiv1 "github.com/usememos/memos/server/router/api/v1"
@@ -68,38 +69,59 @@ func NewServer(ctx context.Context, profile *profile.Profile, dbStore *store.Sto
 
 	rootGroup := echoServer.Group("")
 
-	// Initialise vector store (nil if API key not set — AI chat will be disabled).
+	// Initialise the LLM provider (nil if AI is not configured — AI chat will be disabled).
+	var llmProvider llm.Provider
+	if profile.AIProvider != "" {
+		llmProvider, err = llm.NewProvider(&llm.Config{
+			Backend:       profile.AIProvider,
+			BaseURL:       profile.AIBaseURL,
+			APIKey:        profile.AIAPIKey,
+			Model:         profile.AIModel,
+			ContextWindow: profile.AIContextWindow,
+		})
+		if err != nil {
+			slog.Warn("failed to init LLM provider, AI chat disabled", "err", err)
+			llmProvider = nil
+		}
+	}
+
+	// Initialise vector store (nil if no embedding model is configured — AI memo search will be disabled).
 	var vs *vectorstore.Store
-	if profile.OpenRouterAPIKey != "" {
-		embedFn := chromem.NewEmbeddingFuncOpenAICompat(
-			"https://openrouter.ai/api/v1",
-			profile.OpenRouterAPIKey,
-			"qwen/qwen3-embedding-8b", // small, cheap, widely supported on OpenRouter
-			nil,
-		)
-		var err error
-		vs, err = vectorstore.New(profile.Data, embedFn)
+	if profile.AIEmbeddingProvider != "" && profile.AIEmbeddingModel != "" {
+		embeddingConfig := llm.Config{
+			Backend:        profile.AIEmbeddingProvider,
+			BaseURL:        profile.AIEmbeddingBaseURL,
+			APIKey:         profile.AIEmbeddingAPIKey,
+			EmbeddingModel: profile.AIEmbeddingModel,
+		}
+		_, err := llm.NewProvider(&embeddingConfig)
+		var backend vectorstore.Backend
+		if err == nil {
+			backend, err = vectorstore.NewBackend(ctx, profile.Driver, dbStore.GetDriver().GetDB(), profile.Data)
+		}
+		if err == nil {
+			// Indexes built with an earlier model are searched with that model
+			// until memos are re-indexed.
+			vs, err = vectorstore.New(ctx, backend, profile.AIEmbeddingModel, func(model string) chromem.EmbeddingFunc {
+				config := embeddingConfig
+				config.EmbeddingModel = model
+				embedder, err := llm.NewProvider(&config)
+				if err != nil {
+					return func(context.Context, string) ([]float32, error) { return nil, err }
+				}
+				return llm.EmbeddingFunc(embedder)
+			})
+		}
 		if err != nil {
 			slog.Warn("failed to init vector store, AI memo search disabled", "err", err)
 			vs = nil
 		} else {
-			// Seed vector DB from existing memos in the background.
-			go func() {
-				memos, err := dbStore.ListMemos(context.Background(), &store.FindMemo{})
-				if err != nil {
-					slog.Warn("failed to list memos for vectorstore seeding", "err", err)
-					return
-				}
-				for _, m := range memos {
-					// UpsertMemo ignores if the document ID already exists.
-					_ = vs.UpsertMemo(context.Background(), m.CreatorID, m.UID, m.Content, "")
-				}
-				slog.Info("vectorstore seeding checked for existing memos")
-			}()
+			go syncVectorStore(context.Background(), dbStore, vs)
 		}
 	}
 
-	apiV1Service := apiv1.NewAPIV1Service(s.Secret, profile, dbStore, vs)
+	apiV1Service := apiv1.NewAPIV1Service(s.Secret, profile, dbStore, vs, llmProvider)
+	go apiV1Service.ExtractAttachmentTexts(context.Background())
 
 	// Register HTTP file server routes BEFORE gRPC-Gateway to ensure proper range request handling for Safari.
 	// This uses native HTTP serving (http.ServeContent) instead of gRPC for video/audio files.
@@ -115,12 +137,91 @@ func NewServer(ctx context.Context, profile *profile.Profile, dbStore *store.Sto
 	}
 
 	// Register MCP server.
-	mcpService := mcprouter.NewMCPService(s.Store, s.Secret)
+	mcpService := mcprouter.NewMCPService(s.Store, s.Secret, apiV1Service)
 	mcpService.RegisterRoutes(echoServer)
 
 	return s, nil
 }
 
+// syncVectorStore checks that the vector store matches the embedding model and
+// reconciles it with the memo table — dropping vectors of memos deleted while
+// the server was down and fixing stale metadata — then indexes any memos that
+// are missing. If the embedding model changed, all memos are re-indexed first.
+func syncVectorStore(ctx context.Context, dbStore *store.Store, vs *vectorstore.Store) {
+	if err := vs.CheckEmbedding(ctx); err != nil {
+		slog.Warn("failed to check embedding model", "err", err)
+	}
+	if vs.Status().Reindex != nil {
+		memos, err := listVectorMemos(ctx, dbStore)
+		if err != nil {
+			slog.Warn("failed to list memos for re-indexing", "err", err)
+			return
+		}
+		var all []*vectorstore.Memo
+		for _, userMemos := range memos {
+			all = append(all, userMemos...)
+		}
+		// Memos that failed are retried on the next start.
+		if err := vs.Reindex(ctx, all); err != nil {
+			slog.Warn("failed to re-index memos", "err", err)
+			return
+		}
+	}
+
+	memos, err := listVectorMemos(ctx, dbStore)
+	if err != nil {
+		slog.Warn("failed to list memos for vectorstore sync", "err", err)
+		return
+	}
+	removed, err := vs.Reconcile(ctx, memos)
+	if err != nil {
+		slog.Warn("failed to reconcile vectorstore", "err", err)
+	}
+
+	indexed := 0
+	for _, userMemos := range memos {
+		for _, m := range userMemos {
+			if vs.HasMemo(ctx, m.CreatorID, m.UID) {
+				continue
+			}
+			if err := vs.UpsertMemo(ctx, m); err != nil {
+				slog.Warn("failed to index memo", "memo", m.UID, "err", err)
+				continue
+			}
+			indexed++
+		}
+	}
+	slog.Info("vectorstore synced", "removed", removed, "indexed", indexed)
+}
+
+// listVectorMemos returns all memos as seen by the vector store, keyed by
+// creator.
+func listVectorMemos(ctx context.Context, dbStore *store.Store) (map[int32][]*vectorstore.Memo, error) {
+	memos, err := dbStore.ListMemos(ctx, &store.FindMemo{})
+	if err != nil {
+		return nil, err
+	}
+	displayWithUpdateTime := false
+	if setting, err := dbStore.GetInstanceMemoRelatedSetting(ctx); err == nil {
+		displayWithUpdateTime = setting.DisplayWithUpdateTime
+	}
+	texts, err := dbStore.ListAttachmentTexts(ctx, &store.FindAttachmentText{})
+	if err != nil {
+		return nil, err
+	}
+	memoTexts := make(map[int32][]*store.AttachmentText)
+	for _, text := range texts {
+		if text.MemoID != nil {
+			memoTexts[*text.MemoID] = append(memoTexts[*text.MemoID], text)
+		}
+	}
+	vectorMemos := make(map[int32][]*vectorstore.Memo)
+	for _, m := range memos {
+		vectorMemos[m.CreatorID] = append(vectorMemos[m.CreatorID], apiv1.ConvertMemoToVector(m, memoTexts[m.ID], displayWithUpdateTime))
+	}
+	return vectorMemos, nil
+}
+
 func (s *Server) Start(ctx context.Context) error {
 	var address, network string
 	if len(s.Profile.UNIXSock) == 0 {
@@ -7,32 +7,79 @@ type AIChatSession struct {
 	CreatorID int32
 	Title     string
 	Summary   string // compacted/summarized older history
-	CreatedTs int64
-	UpdatedTs int64
+	// SummaryMessageID is the last message Summary covers; the summary only
+	// applies to branches through it. 0 when there is no summary.
+	SummaryMessageID int32
+	// ActiveMessageID is the last message of the branch being shown and
+	// continued; 0 means the most recent message.
+	ActiveMessageID int32
+	// PendingActions holds the JSON-encoded tool calls awaiting the user's
+	// approval; empty when there are none.
+	PendingActions string
+	CreatedTs      int64
+	UpdatedTs      int64
 }
 
 // AIChatMessage is a single message within a session.
 type AIChatMessage struct {
 	ID         int32
 	SessionID  int32
+	ParentID   int32  // the preceding message on its branch; 0 for the first message
 	Role       string // "user" | "assistant" | "tool"
 	Content    string
 	ToolName   string // non-empty when Role == "tool"
+	ToolCallID string // the tool call a "tool" message answers
+	ToolCalls  string // JSON-encoded tool calls requested by an "assistant" message
 	TokenCount int32
-	CreatedTs  int64
+	// Compacted is set once the message is covered by a session summary and
+	// no longer sent to the model.
+	Compacted bool
+	CreatedTs int64
 }
 
 // FindAIChatSession filters for ListAIChatSessions.
 type FindAIChatSession struct {
 	UID       *string
 	CreatorID *int32
+
+	// Pagination
+	Limit  *int
+	Offset *int
 }
 
 // UpdateAIChatSession carries fields accepted by UpdateAIChatSession.
 type UpdateAIChatSession struct {
-	UID     string
-	Title   *string
-	Summary *string
+	UID             string
+	Title           *string
+	Summary         *string
+	ActiveMessageID *int32
+	PendingActions  *string
+}
+
+// SearchAIChat finds a user's sessions and messages containing any of Terms.
+type SearchAIChat struct {
+	CreatorID int32
+	// Terms are matched case-insensitively against session titles and
+	// summaries and the content of user and assistant messages.
+	Terms []string
+
+	// Pagination
+	Limit  *int
+	Offset *int
+}
+
+// AIChatMatch is a session or message found by SearchAIChats.
+type AIChatMatch struct {
+	SessionUID   string
+	SessionTitle string
+	// MessageID is 0 when the session title or summary matched.
+	MessageID int32
+	Role      string // empty for session matches
+	// Content is the matched message, or the session summary.
+	Content string
+	// CreatedTs is when the message was sent, or when the session was last
+	// updated.
+	CreatedTs int64
 }
 
 // FindAIChatMessage filters for ListAIChatMessages.
@@ -43,8 +90,20 @@ type FindAIChatMessage struct {
 // CreateAIChatTemplate is the payload for CreateAIChatMessage.
 type CreateAIChatMessage struct {
 	SessionID  int32
+	ParentID   int32
 	Role       string
 	Content    string
 	ToolName   string
+	ToolCallID string
+	ToolCalls  string
 	TokenCount int32
 }
+
+// CompactAIChatSession replaces a session's summary and marks the messages it
+// now covers as compacted, in one transaction.
+type CompactAIChatSession struct {
+	SessionID        int32
+	Summary          string
+	SummaryMessageID int32
+	MessageIDs       []int32
+}
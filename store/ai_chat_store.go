@@ -27,6 +27,12 @@ func (s *Store) DeleteAIChatSession(ctx context.Context, uid string) error {
 	return s.driver.DeleteAIChatSession(ctx, uid)
 }
 
+// SearchAIChats returns the sessions and messages matching the search, newest
+// first.
+func (s *Store) SearchAIChats(ctx context.Context, search *SearchAIChat) ([]*AIChatMatch, error) {
+	return s.driver.SearchAIChats(ctx, search)
+}
+
 // CreateAIChatMessage persists a new message to a session.
 func (s *Store) CreateAIChatMessage(ctx context.Context, create *CreateAIChatMessage) (*AIChatMessage, error) {
 	return s.driver.CreateAIChatMessage(ctx, create)
@@ -37,7 +43,8 @@ func (s *Store) ListAIChatMessages(ctx context.Context, find *FindAIChatMessage)
 	return s.driver.ListAIChatMessages(ctx, find)
 }
 
-// DeleteAIChatMessages deletes all messages for the given session (used during compaction).
-func (s *Store) DeleteAIChatMessages(ctx context.Context, sessionID int32) error {
-	return s.driver.DeleteAIChatMessages(ctx, sessionID)
+// CompactAIChatSession saves a new session summary and marks the messages it
+// covers as compacted. The messages themselves are kept.
+func (s *Store) CompactAIChatSession(ctx context.Context, compact *CompactAIChatSession) error {
+	return s.driver.CompactAIChatSession(ctx, compact)
 }
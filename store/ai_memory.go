@@ -0,0 +1,57 @@
+package store
+
+import "context"
+
+// AIMemory is a durable fact or preference the AI assistant keeps about a
+// user across chat sessions.
+type AIMemory struct {
+	ID        int32
+	UserID    int32
+	Content   string
+	CreatedTs int64
+	UpdatedTs int64
+}
+
+// FindAIMemory filters for ListAIMemories.
+type FindAIMemory struct {
+	ID     *int32
+	UserID *int32
+}
+
+// UpdateAIMemory carries fields accepted by UpdateAIMemory.
+type UpdateAIMemory struct {
+	ID      int32
+	Content *string
+}
+
+// CreateAIMemory saves a new memory.
+func (s *Store) CreateAIMemory(ctx context.Context, create *AIMemory) (*AIMemory, error) {
+	return s.driver.CreateAIMemory(ctx, create)
+}
+
+// ListAIMemories returns the matching memories, most recently updated first.
+func (s *Store) ListAIMemories(ctx context.Context, find *FindAIMemory) ([]*AIMemory, error) {
+	return s.driver.ListAIMemories(ctx, find)
+}
+
+// GetAIMemory returns the first memory matching the given filter.
+func (s *Store) GetAIMemory(ctx context.Context, find *FindAIMemory) (*AIMemory, error) {
+	list, err := s.driver.ListAIMemories(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+// UpdateAIMemory updates a memory's content.
+func (s *Store) UpdateAIMemory(ctx context.Context, update *UpdateAIMemory) (*AIMemory, error) {
+	return s.driver.UpdateAIMemory(ctx, update)
+}
+
+// DeleteAIMemory deletes a memory.
+func (s *Store) DeleteAIMemory(ctx context.Context, id int32) error {
+	return s.driver.DeleteAIMemory(ctx, id)
+}
@@ -0,0 +1,48 @@
+package store
+
+import "context"
+
+// AIUsage records the tokens one LLM call spent on behalf of a user.
+type AIUsage struct {
+	ID     int32
+	UserID int32
+	// Kind is what the call was for: "chat", "compaction", "title", "completion"
+	// or "tagging".
+	Kind             string
+	Model            string
+	PromptTokens     int32
+	CompletionTokens int32
+	CreatedTs        int64
+}
+
+// FindAIUsage filters for SumAIUsage.
+type FindAIUsage struct {
+	UserID *int32
+	// CreatedTsAfter and CreatedTsBefore bound the period, inclusive and
+	// exclusive respectively.
+	CreatedTsAfter  *int64
+	CreatedTsBefore *int64
+}
+
+// AIUsageSum totals the usage of one user.
+type AIUsageSum struct {
+	UserID           int32
+	Calls            int64
+	PromptTokens     int64
+	CompletionTokens int64
+}
+
+// TotalTokens returns the prompt and completion tokens combined.
+func (s *AIUsageSum) TotalTokens() int64 {
+	return s.PromptTokens + s.CompletionTokens
+}
+
+// CreateAIUsage records the usage of an LLM call.
+func (s *Store) CreateAIUsage(ctx context.Context, create *AIUsage) (*AIUsage, error) {
+	return s.driver.CreateAIUsage(ctx, create)
+}
+
+// SumAIUsage totals the matching usage per user, ordered by user ID.
+func (s *Store) SumAIUsage(ctx context.Context, find *FindAIUsage) ([]*AIUsageSum, error) {
+	return s.driver.SumAIUsage(ctx, find)
+}
@@ -168,5 +168,8 @@ func (s *Store) DeleteAttachment(ctx context.Context, delete *DeleteAttachment)
 		}
 	}
 
-	return s.driver.DeleteAttachment(ctx, delete)
+	if err := s.driver.DeleteAttachment(ctx, delete); err != nil {
+		return err
+	}
+	return s.driver.DeleteAttachmentText(ctx, delete.ID)
 }
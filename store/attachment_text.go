@@ -0,0 +1,46 @@
+package store
+
+import "context"
+
+// AttachmentText is the plain text extracted from an attachment for search.
+type AttachmentText struct {
+	AttachmentID int32
+	// Content is empty when nothing could be extracted, so that the
+	// attachment is not tried again.
+	Content   string
+	UpdatedTs int64
+
+	// Composed fields from the attachment.
+	AttachmentUID string
+	Filename      string
+	MemoID        *int32
+}
+
+// FindAttachmentText filters for ListAttachmentTexts. Only texts with
+// content are listed.
+type FindAttachmentText struct {
+	AttachmentIDList []int32
+	MemoIDList       []int32
+	// Terms keeps texts containing any of the terms, ignoring case.
+	Terms []string
+	// ExcludeContent leaves out the content, e.g. to find which attachments
+	// already have text.
+	ExcludeContent bool
+	// IncludeEmpty also lists the attachments nothing could be extracted from.
+	IncludeEmpty bool
+}
+
+// UpsertAttachmentText stores the text of an attachment, replacing any it had.
+func (s *Store) UpsertAttachmentText(ctx context.Context, upsert *AttachmentText) (*AttachmentText, error) {
+	return s.driver.UpsertAttachmentText(ctx, upsert)
+}
+
+// ListAttachmentTexts returns the matching texts, ordered by attachment ID.
+func (s *Store) ListAttachmentTexts(ctx context.Context, find *FindAttachmentText) ([]*AttachmentText, error) {
+	return s.driver.ListAttachmentTexts(ctx, find)
+}
+
+// DeleteAttachmentText drops the text of an attachment.
+func (s *Store) DeleteAttachmentText(ctx context.Context, attachmentID int32) error {
+	return s.driver.DeleteAttachmentText(ctx, attachmentID)
+}
@@ -30,17 +30,78 @@ func (d *DB) EnsureAIChatTables(ctx context.Context) error {
 			CONSTRAINT fk_ai_chat_message_session FOREIGN KEY (session_id) REFERENCES ai_chat_session(id) ON DELETE CASCADE
 		)`,
 		`CREATE INDEX IF NOT EXISTS idx_ai_chat_message_session ON ai_chat_message(session_id)`,
+		`CREATE TABLE IF NOT EXISTS ai_usage (
+			id                INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+			user_id           INT NOT NULL,
+			kind              VARCHAR(256) NOT NULL,
+			model             VARCHAR(256) NOT NULL DEFAULT '',
+			prompt_tokens     INT NOT NULL DEFAULT 0,
+			completion_tokens INT NOT NULL DEFAULT 0,
+			created_ts        TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			INDEX idx_ai_usage_user_created (user_id, created_ts)
+		)`,
+		// Text extracted from attachments, searched alongside the memos.
+		`CREATE TABLE IF NOT EXISTS attachment_text (
+			attachment_id INT NOT NULL PRIMARY KEY,
+			content       MEDIUMTEXT NOT NULL,
+			updated_ts    TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+		)`,
+		// Durable facts the AI assistant keeps about a user across sessions.
+		`CREATE TABLE IF NOT EXISTS ai_memory (
+			id         INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+			user_id    INT NOT NULL,
+			content    TEXT NOT NULL,
+			created_ts TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			updated_ts TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			INDEX idx_ai_memory_user (user_id)
+		)`,
 	}
 	for _, s := range stmts {
 		if _, err := d.db.ExecContext(ctx, s); err != nil {
 			return err
 		}
 	}
+	// Columns added after the tables were first released.
+	// backfill, if set, runs once when the column is added.
+	columns := []struct{ table, name, definition, backfill string }{
+		{"ai_chat_message", "tool_call_id", "VARCHAR(256) NOT NULL DEFAULT ''", ""},
+		{"ai_chat_message", "tool_calls", "TEXT NOT NULL", ""},
+		// Existing sessions were linear, so each message follows the one before it.
+		{"ai_chat_message", "parent_id", "INT NOT NULL DEFAULT 0", `UPDATE ai_chat_message m JOIN (
+			SELECT c.id, MAX(p.id) AS parent_id FROM ai_chat_message c
+			JOIN ai_chat_message p ON p.session_id = c.session_id AND p.id < c.id
+			GROUP BY c.id
+		) x ON x.id = m.id SET m.parent_id = x.parent_id`},
+		{"ai_chat_session", "active_message_id", "INT NOT NULL DEFAULT 0", ""},
+		{"ai_chat_session", "pending_actions", "TEXT NOT NULL", ""},
+		{"ai_chat_session", "summary_message_id", "INT NOT NULL DEFAULT 0", ""},
+		{"ai_chat_message", "compacted", "BOOLEAN NOT NULL DEFAULT FALSE", ""},
+	}
+	for _, c := range columns {
+		var count int
+		if err := d.db.QueryRowContext(ctx,
+			"SELECT COUNT(*) FROM information_schema.COLUMNS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ? AND COLUMN_NAME = ?",
+			c.table, c.name,
+		).Scan(&count); err != nil {
+			return err
+		}
+		if count > 0 {
+			continue
+		}
+		if _, err := d.db.ExecContext(ctx, fmt.Sprintf("ALTER TABLE `%s` ADD COLUMN `%s` %s", c.table, c.name, c.definition)); err != nil {
+			return err
+		}
+		if c.backfill != "" {
+			if _, err := d.db.ExecContext(ctx, c.backfill); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
 func (d *DB) CreateAIChatSession(ctx context.Context, create *store.AIChatSession) (*store.AIChatSession, error) {
-	stmt := "INSERT INTO `ai_chat_session` (`uid`, `creator_id`, `title`) VALUES (?, ?, ?)"
+	stmt := "INSERT INTO `ai_chat_session` (`uid`, `creator_id`, `title`, `pending_actions`) VALUES (?, ?, ?, '')"
 	result, err := d.db.ExecContext(ctx, stmt, create.UID, create.CreatorID, create.Title)
 	if err != nil {
 		return nil, err
@@ -63,10 +124,16 @@ func (d *DB) ListAIChatSessions(ctx context.Context, find *store.FindAIChatSessi
 		where, args = append(where, "`uid` = ?"), append(args, *v)
 	}
 	query := fmt.Sprintf(
-		`SELECT id, uid, creator_id, title, summary, UNIX_TIMESTAMP(created_ts), UNIX_TIMESTAMP(updated_ts)
-		 FROM ai_chat_session WHERE %s ORDER BY updated_ts DESC`,
+		`SELECT id, uid, creator_id, title, summary, summary_message_id, active_message_id, pending_actions, UNIX_TIMESTAMP(created_ts), UNIX_TIMESTAMP(updated_ts)
+		 FROM ai_chat_session WHERE %s ORDER BY updated_ts DESC, id DESC`,
 		strings.Join(where, " AND "),
 	)
+	if find.Limit != nil {
+		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
+		if find.Offset != nil {
+			query = fmt.Sprintf("%s OFFSET %d", query, *find.Offset)
+		}
+	}
 	rows, err := d.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
@@ -76,7 +143,7 @@ func (d *DB) ListAIChatSessions(ctx context.Context, find *store.FindAIChatSessi
 	var list []*store.AIChatSession
 	for rows.Next() {
 		s := &store.AIChatSession{}
-		if err := rows.Scan(&s.ID, &s.UID, &s.CreatorID, &s.Title, &s.Summary, &s.CreatedTs, &s.UpdatedTs); err != nil {
+		if err := rows.Scan(&s.ID, &s.UID, &s.CreatorID, &s.Title, &s.Summary, &s.SummaryMessageID, &s.ActiveMessageID, &s.PendingActions, &s.CreatedTs, &s.UpdatedTs); err != nil {
 			return nil, err
 		}
 		list = append(list, s)
@@ -103,13 +170,19 @@ func (d *DB) UpdateAIChatSession(ctx context.Context, update *store.UpdateAIChat
 	if v := update.Summary; v != nil {
 		set, args = append(set, "`summary` = ?"), append(args, *v)
 	}
+	if v := update.ActiveMessageID; v != nil {
+		set, args = append(set, "`active_message_id` = ?"), append(args, *v)
+	}
+	if v := update.PendingActions; v != nil {
+		set, args = append(set, "`pending_actions` = ?"), append(args, *v)
+	}
 	if len(set) == 0 {
 		return d.GetAIChatSession(ctx, &store.FindAIChatSession{UID: &update.UID})
 	}
 	set = append(set, "`updated_ts` = CURRENT_TIMESTAMP")
 	args = append(args, update.UID)
 	stmt := fmt.Sprintf("UPDATE `ai_chat_session` SET %s WHERE `uid` = ?", strings.Join(set, ", "))
-	
+
 	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
 		return nil, err
 	}
@@ -121,9 +194,66 @@ func (d *DB) DeleteAIChatSession(ctx context.Context, uid string) error {
 	return err
 }
 
+// likeEscaper escapes the wildcards of LIKE patterns, so that search terms
+// match literally.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// SearchAIChats matches sessions by title or summary and user and assistant
+// messages by content, newest first.
+func (d *DB) SearchAIChats(ctx context.Context, search *store.SearchAIChat) ([]*store.AIChatMatch, error) {
+	if len(search.Terms) == 0 {
+		return nil, nil
+	}
+	sessionConds, messageConds, patterns := []string{}, []string{}, []any{}
+	for _, term := range search.Terms {
+		// The backslash escapes itself in MySQL string literals.
+		sessionConds = append(sessionConds, `s.title LIKE ? ESCAPE '\\'`, `s.summary LIKE ? ESCAPE '\\'`)
+		messageConds = append(messageConds, `m.content LIKE ? ESCAPE '\\'`)
+		patterns = append(patterns, "%"+likeEscaper.Replace(term)+"%")
+	}
+	args := []any{search.CreatorID}
+	for _, p := range patterns {
+		args = append(args, p, p)
+	}
+	args = append(args, search.CreatorID)
+	args = append(args, patterns...)
+	query := fmt.Sprintf(
+		`SELECT uid, title, message_id, role, content, ts FROM (
+			SELECT s.uid, s.title, 0 AS message_id, '' AS role, s.summary AS content, UNIX_TIMESTAMP(s.updated_ts) AS ts
+			FROM ai_chat_session s WHERE s.creator_id = ? AND (%s)
+			UNION ALL
+			SELECT s.uid, s.title, m.id, m.role, m.content, UNIX_TIMESTAMP(m.created_ts)
+			FROM ai_chat_message m JOIN ai_chat_session s ON s.id = m.session_id
+			WHERE s.creator_id = ? AND m.role IN ('user', 'assistant') AND (%s)
+		) AS matches ORDER BY ts DESC, message_id DESC`,
+		strings.Join(sessionConds, " OR "), strings.Join(messageConds, " OR "),
+	)
+	if search.Limit != nil {
+		query = fmt.Sprintf("%s LIMIT %d", query, *search.Limit)
+		if search.Offset != nil {
+			query = fmt.Sprintf("%s OFFSET %d", query, *search.Offset)
+		}
+	}
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*store.AIChatMatch
+	for rows.Next() {
+		m := &store.AIChatMatch{}
+		if err := rows.Scan(&m.SessionUID, &m.SessionTitle, &m.MessageID, &m.Role, &m.Content, &m.CreatedTs); err != nil {
+			return nil, err
+		}
+		list = append(list, m)
+	}
+	return list, rows.Err()
+}
+
 func (d *DB) CreateAIChatMessage(ctx context.Context, create *store.CreateAIChatMessage) (*store.AIChatMessage, error) {
-	stmt := "INSERT INTO `ai_chat_message` (`session_id`, `role`, `content`, `tool_name`, `token_count`) VALUES (?, ?, ?, ?, ?)"
-	result, err := d.db.ExecContext(ctx, stmt, create.SessionID, create.Role, create.Content, create.ToolName, create.TokenCount)
+	stmt := "INSERT INTO `ai_chat_message` (`session_id`, `parent_id`, `role`, `content`, `tool_name`, `tool_call_id`, `tool_calls`, `token_count`) VALUES (?, ?, ?, ?, ?, ?, ?, ?)"
+	result, err := d.db.ExecContext(ctx, stmt, create.SessionID, create.ParentID, create.Role, create.Content, create.ToolName, create.ToolCallID, create.ToolCalls, create.TokenCount)
 	if err != nil {
 		return nil, err
 	}
@@ -131,23 +261,26 @@ func (d *DB) CreateAIChatMessage(ctx context.Context, create *store.CreateAIChat
 	if err != nil {
 		return nil, err
 	}
-	
+
 	m := &store.AIChatMessage{
 		ID:         int32(rawID),
 		SessionID:  create.SessionID,
+		ParentID:   create.ParentID,
 		Role:       create.Role,
 		Content:    create.Content,
 		ToolName:   create.ToolName,
+		ToolCallID: create.ToolCallID,
+		ToolCalls:  create.ToolCalls,
 		TokenCount: create.TokenCount,
 	}
 	// Fetch created_ts
 	_ = d.db.QueryRowContext(ctx, "SELECT UNIX_TIMESTAMP(created_ts) FROM ai_chat_message WHERE id = ?", m.ID).Scan(&m.CreatedTs)
-	
+
 	return m, nil
 }
 
 func (d *DB) ListAIChatMessages(ctx context.Context, find *store.FindAIChatMessage) ([]*store.AIChatMessage, error) {
-	query := `SELECT id, session_id, role, content, tool_name, token_count, UNIX_TIMESTAMP(created_ts)
+	query := `SELECT id, session_id, parent_id, role, content, tool_name, tool_call_id, tool_calls, token_count, compacted, UNIX_TIMESTAMP(created_ts)
 	          FROM ai_chat_message WHERE session_id = ? ORDER BY id ASC`
 	rows, err := d.db.QueryContext(ctx, query, find.SessionID)
 	if err != nil {
@@ -158,7 +291,7 @@ func (d *DB) ListAIChatMessages(ctx context.Context, find *store.FindAIChatMessa
 	var list []*store.AIChatMessage
 	for rows.Next() {
 		m := &store.AIChatMessage{}
-		if err := rows.Scan(&m.ID, &m.SessionID, &m.Role, &m.Content, &m.ToolName, &m.TokenCount, &m.CreatedTs); err != nil {
+		if err := rows.Scan(&m.ID, &m.SessionID, &m.ParentID, &m.Role, &m.Content, &m.ToolName, &m.ToolCallID, &m.ToolCalls, &m.TokenCount, &m.Compacted, &m.CreatedTs); err != nil {
 			return nil, err
 		}
 		list = append(list, m)
@@ -166,7 +299,27 @@ func (d *DB) ListAIChatMessages(ctx context.Context, find *store.FindAIChatMessa
 	return list, rows.Err()
 }
 
-func (d *DB) DeleteAIChatMessages(ctx context.Context, sessionID int32) error {
-	_, err := d.db.ExecContext(ctx, "DELETE FROM `ai_chat_message` WHERE `session_id` = ?", sessionID)
-	return err
+func (d *DB) CompactAIChatSession(ctx context.Context, compact *store.CompactAIChatSession) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "UPDATE `ai_chat_session` SET `summary` = ?, `summary_message_id` = ? WHERE `id` = ?",
+		compact.Summary, compact.SummaryMessageID, compact.SessionID,
+	); err != nil {
+		return err
+	}
+	if len(compact.MessageIDs) > 0 {
+		placeholders, args := make([]string, 0, len(compact.MessageIDs)), []any{compact.SessionID}
+		for _, id := range compact.MessageIDs {
+			placeholders, args = append(placeholders, "?"), append(args, id)
+		}
+		stmt := fmt.Sprintf("UPDATE `ai_chat_message` SET `compacted` = TRUE WHERE `session_id` = ? AND `id` IN (%s)", strings.Join(placeholders, ", "))
+		if _, err := tx.ExecContext(ctx, stmt, args...); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
 }
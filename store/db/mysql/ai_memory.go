@@ -0,0 +1,84 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateAIMemory(ctx context.Context, create *store.AIMemory) (*store.AIMemory, error) {
+	stmt := "INSERT INTO `ai_memory` (`user_id`, `content`) VALUES (?, ?)"
+	result, err := d.db.ExecContext(ctx, stmt, create.UserID, create.Content)
+	if err != nil {
+		return nil, err
+	}
+	rawID, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+	id := int32(rawID)
+	list, err := d.ListAIMemories(ctx, &store.FindAIMemory{ID: &id})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, fmt.Errorf("failed to find created AI memory %d", id)
+	}
+	return list[0], nil
+}
+
+func (d *DB) ListAIMemories(ctx context.Context, find *store.FindAIMemory) ([]*store.AIMemory, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, "`id` = ?"), append(args, *v)
+	}
+	if v := find.UserID; v != nil {
+		where, args = append(where, "`user_id` = ?"), append(args, *v)
+	}
+	query := fmt.Sprintf(
+		"SELECT `id`, `user_id`, `content`, UNIX_TIMESTAMP(`created_ts`), UNIX_TIMESTAMP(`updated_ts`) FROM `ai_memory` WHERE %s ORDER BY `updated_ts` DESC, `id` DESC",
+		strings.Join(where, " AND "),
+	)
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*store.AIMemory
+	for rows.Next() {
+		m := &store.AIMemory{}
+		if err := rows.Scan(&m.ID, &m.UserID, &m.Content, &m.CreatedTs, &m.UpdatedTs); err != nil {
+			return nil, err
+		}
+		list = append(list, m)
+	}
+	return list, rows.Err()
+}
+
+func (d *DB) UpdateAIMemory(ctx context.Context, update *store.UpdateAIMemory) (*store.AIMemory, error) {
+	set, args := []string{"`updated_ts` = CURRENT_TIMESTAMP"}, []any{}
+	if v := update.Content; v != nil {
+		set, args = append(set, "`content` = ?"), append(args, *v)
+	}
+	args = append(args, update.ID)
+	stmt := fmt.Sprintf("UPDATE `ai_memory` SET %s WHERE `id` = ?", strings.Join(set, ", "))
+	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+		return nil, err
+	}
+	list, err := d.ListAIMemories(ctx, &store.FindAIMemory{ID: &update.ID})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, fmt.Errorf("failed to find updated AI memory %d", update.ID)
+	}
+	return list[0], nil
+}
+
+func (d *DB) DeleteAIMemory(ctx context.Context, id int32) error {
+	_, err := d.db.ExecContext(ctx, "DELETE FROM `ai_memory` WHERE `id` = ?", id)
+	return err
+}
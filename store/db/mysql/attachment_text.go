@@ -0,0 +1,83 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) UpsertAttachmentText(ctx context.Context, upsert *store.AttachmentText) (*store.AttachmentText, error) {
+	stmt := "INSERT INTO `attachment_text` (`attachment_id`, `content`) VALUES (?, ?) ON DUPLICATE KEY UPDATE `content` = VALUES(`content`), `updated_ts` = CURRENT_TIMESTAMP"
+	if _, err := d.db.ExecContext(ctx, stmt, upsert.AttachmentID, upsert.Content); err != nil {
+		return nil, err
+	}
+	if err := d.db.QueryRowContext(ctx, "SELECT UNIX_TIMESTAMP(`updated_ts`) FROM `attachment_text` WHERE `attachment_id` = ?", upsert.AttachmentID).Scan(&upsert.UpdatedTs); err != nil {
+		return nil, err
+	}
+	return upsert, nil
+}
+
+func (d *DB) ListAttachmentTexts(ctx context.Context, find *store.FindAttachmentText) ([]*store.AttachmentText, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if !find.IncludeEmpty {
+		where = append(where, "t.content <> ''")
+	}
+	if len(find.AttachmentIDList) > 0 {
+		placeholders := make([]string, 0, len(find.AttachmentIDList))
+		for _, id := range find.AttachmentIDList {
+			placeholders, args = append(placeholders, "?"), append(args, id)
+		}
+		where = append(where, "t.attachment_id IN ("+strings.Join(placeholders, ", ")+")")
+	}
+	if len(find.MemoIDList) > 0 {
+		placeholders := make([]string, 0, len(find.MemoIDList))
+		for _, id := range find.MemoIDList {
+			placeholders, args = append(placeholders, "?"), append(args, id)
+		}
+		where = append(where, "a.memo_id IN ("+strings.Join(placeholders, ", ")+")")
+	}
+	if len(find.Terms) > 0 {
+		conds := make([]string, 0, len(find.Terms))
+		for _, term := range find.Terms {
+			conds, args = append(conds, "t.content LIKE ?"), append(args, fmt.Sprintf("%%%s%%", term))
+		}
+		where = append(where, "("+strings.Join(conds, " OR ")+")")
+	}
+	content := "t.content"
+	if find.ExcludeContent {
+		content = "''"
+	}
+	query := fmt.Sprintf(
+		`SELECT t.attachment_id, a.uid, a.filename, a.memo_id, %s, UNIX_TIMESTAMP(t.updated_ts)
+		 FROM attachment_text t JOIN attachment a ON a.id = t.attachment_id
+		 WHERE %s ORDER BY t.attachment_id`,
+		content, strings.Join(where, " AND "),
+	)
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*store.AttachmentText
+	for rows.Next() {
+		text := &store.AttachmentText{}
+		var memoID sql.NullInt32
+		if err := rows.Scan(&text.AttachmentID, &text.AttachmentUID, &text.Filename, &memoID, &text.Content, &text.UpdatedTs); err != nil {
+			return nil, err
+		}
+		if memoID.Valid {
+			text.MemoID = &memoID.Int32
+		}
+		list = append(list, text)
+	}
+	return list, rows.Err()
+}
+
+func (d *DB) DeleteAttachmentText(ctx context.Context, attachmentID int32) error {
+	_, err := d.db.ExecContext(ctx, `DELETE FROM attachment_text WHERE attachment_id = ?`, attachmentID)
+	return err
+}
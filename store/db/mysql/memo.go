@@ -2,6 +2,7 @@ package mysql
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -243,6 +244,13 @@ func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
 		}
 		set, args = append(set, "`payload` = ?"), append(args, string(payloadBytes))
 	}
+	if v := update.SuggestedTags; v != nil {
+		tagsBytes, err := json.Marshal(*v)
+		if err != nil {
+			return err
+		}
+		set, args = append(set, "`payload` = JSON_SET(`payload`, '$.suggestedTags', CAST(? AS JSON))"), append(args, string(tagsBytes))
+	}
 	if len(set) == 0 {
 		return nil
 	}
@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -228,6 +229,13 @@ func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
 		}
 		set, args = append(set, "payload = "+placeholder(len(args)+1)), append(args, string(payloadBytes))
 	}
+	if v := update.SuggestedTags; v != nil {
+		tagsBytes, err := json.Marshal(*v)
+		if err != nil {
+			return err
+		}
+		set, args = append(set, "payload = jsonb_set(payload, '{suggestedTags}', "+placeholder(len(args)+1)+"::jsonb)"), append(args, string(tagsBytes))
+	}
 	if len(set) == 0 {
 		return nil
 	}
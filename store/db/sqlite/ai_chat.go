@@ -31,12 +31,67 @@ func (d *DB) EnsureAIChatTables(ctx context.Context) error {
 			created_ts  INTEGER NOT NULL DEFAULT (strftime('%s','now'))
 		)`,
 		`CREATE INDEX IF NOT EXISTS idx_ai_chat_message_session ON ai_chat_message(session_id)`,
+		`CREATE TABLE IF NOT EXISTS ai_usage (
+			id                INTEGER PRIMARY KEY AUTOINCREMENT,
+			user_id           INTEGER NOT NULL,
+			kind              TEXT    NOT NULL,
+			model             TEXT    NOT NULL DEFAULT '',
+			prompt_tokens     INTEGER NOT NULL DEFAULT 0,
+			completion_tokens INTEGER NOT NULL DEFAULT 0,
+			created_ts        INTEGER NOT NULL DEFAULT (strftime('%s','now'))
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_ai_usage_user_created ON ai_usage(user_id, created_ts)`,
+		// Text extracted from attachments, searched alongside the memos.
+		`CREATE TABLE IF NOT EXISTS attachment_text (
+			attachment_id INTEGER PRIMARY KEY,
+			content       TEXT    NOT NULL DEFAULT '',
+			updated_ts    INTEGER NOT NULL DEFAULT (strftime('%s','now'))
+		)`,
+		// Durable facts the AI assistant keeps about a user across sessions.
+		`CREATE TABLE IF NOT EXISTS ai_memory (
+			id         INTEGER PRIMARY KEY AUTOINCREMENT,
+			user_id    INTEGER NOT NULL,
+			content    TEXT    NOT NULL,
+			created_ts INTEGER NOT NULL DEFAULT (strftime('%s','now')),
+			updated_ts INTEGER NOT NULL DEFAULT (strftime('%s','now'))
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_ai_memory_user ON ai_memory(user_id)`,
 	}
 	for _, s := range stmts {
 		if _, err := d.db.ExecContext(ctx, s); err != nil {
 			return err
 		}
 	}
+	// Columns added after the tables were first released.
+	// backfill, if set, runs once when the column is added.
+	columns := []struct{ table, name, definition, backfill string }{
+		{"ai_chat_message", "tool_call_id", "TEXT NOT NULL DEFAULT ''", ""},
+		{"ai_chat_message", "tool_calls", "TEXT NOT NULL DEFAULT ''", ""},
+		// Existing sessions were linear, so each message follows the one before it.
+		{"ai_chat_message", "parent_id", "INTEGER NOT NULL DEFAULT 0", `UPDATE ai_chat_message SET parent_id = COALESCE(
+			(SELECT MAX(p.id) FROM ai_chat_message p WHERE p.session_id = ai_chat_message.session_id AND p.id < ai_chat_message.id), 0)`},
+		{"ai_chat_session", "active_message_id", "INTEGER NOT NULL DEFAULT 0", ""},
+		{"ai_chat_session", "pending_actions", "TEXT NOT NULL DEFAULT ''", ""},
+		{"ai_chat_session", "summary_message_id", "INTEGER NOT NULL DEFAULT 0", ""},
+		{"ai_chat_message", "compacted", "INTEGER NOT NULL DEFAULT 0", ""},
+	}
+	for _, c := range columns {
+		var count int
+		if err := d.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM pragma_table_info(?) WHERE name = ?`, c.table, c.name).Scan(&count); err != nil {
+			return err
+		}
+		if count > 0 {
+			continue
+		}
+		if _, err := d.db.ExecContext(ctx, fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", c.table, c.name, c.definition)); err != nil {
+			return err
+		}
+		if c.backfill != "" {
+			if _, err := d.db.ExecContext(ctx, c.backfill); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
@@ -64,10 +119,16 @@ func (d *DB) ListAIChatSessions(ctx context.Context, find *store.FindAIChatSessi
 		where, args = append(where, "uid = ?"), append(args, *v)
 	}
 	query := fmt.Sprintf(
-		`SELECT id, uid, creator_id, title, summary, created_ts, updated_ts
-		 FROM ai_chat_session WHERE %s ORDER BY updated_ts DESC`,
+		`SELECT id, uid, creator_id, title, summary, summary_message_id, active_message_id, pending_actions, created_ts, updated_ts
+		 FROM ai_chat_session WHERE %s ORDER BY updated_ts DESC, id DESC`,
 		strings.Join(where, " AND "),
 	)
+	if find.Limit != nil {
+		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
+		if find.Offset != nil {
+			query = fmt.Sprintf("%s OFFSET %d", query, *find.Offset)
+		}
+	}
 	rows, err := d.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
@@ -77,7 +138,7 @@ func (d *DB) ListAIChatSessions(ctx context.Context, find *store.FindAIChatSessi
 	var list []*store.AIChatSession
 	for rows.Next() {
 		s := &store.AIChatSession{}
-		if err := rows.Scan(&s.ID, &s.UID, &s.CreatorID, &s.Title, &s.Summary, &s.CreatedTs, &s.UpdatedTs); err != nil {
+		if err := rows.Scan(&s.ID, &s.UID, &s.CreatorID, &s.Title, &s.Summary, &s.SummaryMessageID, &s.ActiveMessageID, &s.PendingActions, &s.CreatedTs, &s.UpdatedTs); err != nil {
 			return nil, err
 		}
 		list = append(list, s)
@@ -104,6 +165,12 @@ func (d *DB) UpdateAIChatSession(ctx context.Context, update *store.UpdateAIChat
 	if v := update.Summary; v != nil {
 		set, args = append(set, "summary = ?"), append(args, *v)
 	}
+	if v := update.ActiveMessageID; v != nil {
+		set, args = append(set, "active_message_id = ?"), append(args, *v)
+	}
+	if v := update.PendingActions; v != nil {
+		set, args = append(set, "pending_actions = ?"), append(args, *v)
+	}
 	if len(set) == 0 {
 		return d.GetAIChatSession(ctx, &store.FindAIChatSession{UID: &update.UID})
 	}
@@ -111,12 +178,12 @@ func (d *DB) UpdateAIChatSession(ctx context.Context, update *store.UpdateAIChat
 	args = append(args, update.UID)
 	stmt := fmt.Sprintf(
 		`UPDATE ai_chat_session SET %s WHERE uid = ?
-		 RETURNING id, uid, creator_id, title, summary, created_ts, updated_ts`,
+		 RETURNING id, uid, creator_id, title, summary, summary_message_id, active_message_id, pending_actions, created_ts, updated_ts`,
 		strings.Join(set, ", "),
 	)
 	s := &store.AIChatSession{}
 	if err := d.db.QueryRowContext(ctx, stmt, args...).
-		Scan(&s.ID, &s.UID, &s.CreatorID, &s.Title, &s.Summary, &s.CreatedTs, &s.UpdatedTs); err != nil {
+		Scan(&s.ID, &s.UID, &s.CreatorID, &s.Title, &s.Summary, &s.SummaryMessageID, &s.ActiveMessageID, &s.PendingActions, &s.CreatedTs, &s.UpdatedTs); err != nil {
 		return nil, err
 	}
 	return s, nil
@@ -127,23 +194,84 @@ func (d *DB) DeleteAIChatSession(ctx context.Context, uid string) error {
 	return err
 }
 
+// likeEscaper escapes the wildcards of LIKE patterns, so that search terms
+// match literally.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// SearchAIChats matches sessions by title or summary and user and assistant
+// messages by content, newest first.
+func (d *DB) SearchAIChats(ctx context.Context, search *store.SearchAIChat) ([]*store.AIChatMatch, error) {
+	if len(search.Terms) == 0 {
+		return nil, nil
+	}
+	sessionConds, messageConds, patterns := []string{}, []string{}, []any{}
+	for _, term := range search.Terms {
+		sessionConds = append(sessionConds,
+			`memos_unicode_lower(s.title) LIKE memos_unicode_lower(?) ESCAPE '\'`,
+			`memos_unicode_lower(s.summary) LIKE memos_unicode_lower(?) ESCAPE '\'`)
+		messageConds = append(messageConds, `memos_unicode_lower(m.content) LIKE memos_unicode_lower(?) ESCAPE '\'`)
+		patterns = append(patterns, "%"+likeEscaper.Replace(term)+"%")
+	}
+	args := []any{search.CreatorID}
+	for _, p := range patterns {
+		args = append(args, p, p)
+	}
+	args = append(args, search.CreatorID)
+	args = append(args, patterns...)
+	query := fmt.Sprintf(
+		`SELECT uid, title, message_id, role, content, ts FROM (
+			SELECT s.uid, s.title, 0 AS message_id, '' AS role, s.summary AS content, s.updated_ts AS ts
+			FROM ai_chat_session s WHERE s.creator_id = ? AND (%s)
+			UNION ALL
+			SELECT s.uid, s.title, m.id, m.role, m.content, m.created_ts
+			FROM ai_chat_message m JOIN ai_chat_session s ON s.id = m.session_id
+			WHERE s.creator_id = ? AND m.role IN ('user', 'assistant') AND (%s)
+		) AS matches ORDER BY ts DESC, message_id DESC`,
+		strings.Join(sessionConds, " OR "), strings.Join(messageConds, " OR "),
+	)
+	if search.Limit != nil {
+		query = fmt.Sprintf("%s LIMIT %d", query, *search.Limit)
+		if search.Offset != nil {
+			query = fmt.Sprintf("%s OFFSET %d", query, *search.Offset)
+		}
+	}
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*store.AIChatMatch
+	for rows.Next() {
+		m := &store.AIChatMatch{}
+		if err := rows.Scan(&m.SessionUID, &m.SessionTitle, &m.MessageID, &m.Role, &m.Content, &m.CreatedTs); err != nil {
+			return nil, err
+		}
+		list = append(list, m)
+	}
+	return list, rows.Err()
+}
+
 // ──────────────────────────────────────────────────────────────
 // AIChatMessage
 // ──────────────────────────────────────────────────────────────
 
 func (d *DB) CreateAIChatMessage(ctx context.Context, create *store.CreateAIChatMessage) (*store.AIChatMessage, error) {
-	stmt := `INSERT INTO ai_chat_message (session_id, role, content, tool_name, token_count)
-	         VALUES (?, ?, ?, ?, ?)
+	stmt := `INSERT INTO ai_chat_message (session_id, parent_id, role, content, tool_name, tool_call_id, tool_calls, token_count)
+	         VALUES (?, ?, ?, ?, ?, ?, ?, ?)
 	         RETURNING id, created_ts`
 	m := &store.AIChatMessage{
 		SessionID:  create.SessionID,
+		ParentID:   create.ParentID,
 		Role:       create.Role,
 		Content:    create.Content,
 		ToolName:   create.ToolName,
+		ToolCallID: create.ToolCallID,
+		ToolCalls:  create.ToolCalls,
 		TokenCount: create.TokenCount,
 	}
 	if err := d.db.QueryRowContext(ctx, stmt,
-		create.SessionID, create.Role, create.Content, create.ToolName, create.TokenCount,
+		create.SessionID, create.ParentID, create.Role, create.Content, create.ToolName, create.ToolCallID, create.ToolCalls, create.TokenCount,
 	).Scan(&m.ID, &m.CreatedTs); err != nil {
 		return nil, err
 	}
@@ -151,7 +279,7 @@ func (d *DB) CreateAIChatMessage(ctx context.Context, create *store.CreateAIChat
 }
 
 func (d *DB) ListAIChatMessages(ctx context.Context, find *store.FindAIChatMessage) ([]*store.AIChatMessage, error) {
-	query := `SELECT id, session_id, role, content, tool_name, token_count, created_ts
+	query := `SELECT id, session_id, parent_id, role, content, tool_name, tool_call_id, tool_calls, token_count, compacted, created_ts
 	          FROM ai_chat_message WHERE session_id = ? ORDER BY id ASC`
 	rows, err := d.db.QueryContext(ctx, query, find.SessionID)
 	if err != nil {
@@ -162,7 +290,7 @@ func (d *DB) ListAIChatMessages(ctx context.Context, find *store.FindAIChatMessa
 	var list []*store.AIChatMessage
 	for rows.Next() {
 		m := &store.AIChatMessage{}
-		if err := rows.Scan(&m.ID, &m.SessionID, &m.Role, &m.Content, &m.ToolName, &m.TokenCount, &m.CreatedTs); err != nil {
+		if err := rows.Scan(&m.ID, &m.SessionID, &m.ParentID, &m.Role, &m.Content, &m.ToolName, &m.ToolCallID, &m.ToolCalls, &m.TokenCount, &m.Compacted, &m.CreatedTs); err != nil {
 			return nil, err
 		}
 		list = append(list, m)
@@ -170,7 +298,27 @@ func (d *DB) ListAIChatMessages(ctx context.Context, find *store.FindAIChatMessa
 	return list, rows.Err()
 }
 
-func (d *DB) DeleteAIChatMessages(ctx context.Context, sessionID int32) error {
-	_, err := d.db.ExecContext(ctx, `DELETE FROM ai_chat_message WHERE session_id = ?`, sessionID)
-	return err
+func (d *DB) CompactAIChatSession(ctx context.Context, compact *store.CompactAIChatSession) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `UPDATE ai_chat_session SET summary = ?, summary_message_id = ? WHERE id = ?`,
+		compact.Summary, compact.SummaryMessageID, compact.SessionID,
+	); err != nil {
+		return err
+	}
+	if len(compact.MessageIDs) > 0 {
+		placeholders, args := make([]string, 0, len(compact.MessageIDs)), []any{compact.SessionID}
+		for _, id := range compact.MessageIDs {
+			placeholders, args = append(placeholders, "?"), append(args, id)
+		}
+		stmt := fmt.Sprintf(`UPDATE ai_chat_message SET compacted = 1 WHERE session_id = ? AND id IN (%s)`, strings.Join(placeholders, ", "))
+		if _, err := tx.ExecContext(ctx, stmt, args...); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
 }
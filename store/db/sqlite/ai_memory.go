@@ -0,0 +1,72 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateAIMemory(ctx context.Context, create *store.AIMemory) (*store.AIMemory, error) {
+	stmt := `INSERT INTO ai_memory (user_id, content) VALUES (?, ?)
+	         RETURNING id, created_ts, updated_ts`
+	if err := d.db.QueryRowContext(ctx, stmt, create.UserID, create.Content).
+		Scan(&create.ID, &create.CreatedTs, &create.UpdatedTs); err != nil {
+		return nil, err
+	}
+	return create, nil
+}
+
+func (d *DB) ListAIMemories(ctx context.Context, find *store.FindAIMemory) ([]*store.AIMemory, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, "id = ?"), append(args, *v)
+	}
+	if v := find.UserID; v != nil {
+		where, args = append(where, "user_id = ?"), append(args, *v)
+	}
+	query := fmt.Sprintf(
+		`SELECT id, user_id, content, created_ts, updated_ts
+		 FROM ai_memory WHERE %s ORDER BY updated_ts DESC, id DESC`,
+		strings.Join(where, " AND "),
+	)
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*store.AIMemory
+	for rows.Next() {
+		m := &store.AIMemory{}
+		if err := rows.Scan(&m.ID, &m.UserID, &m.Content, &m.CreatedTs, &m.UpdatedTs); err != nil {
+			return nil, err
+		}
+		list = append(list, m)
+	}
+	return list, rows.Err()
+}
+
+func (d *DB) UpdateAIMemory(ctx context.Context, update *store.UpdateAIMemory) (*store.AIMemory, error) {
+	set, args := []string{"updated_ts = strftime('%s','now')"}, []any{}
+	if v := update.Content; v != nil {
+		set, args = append(set, "content = ?"), append(args, *v)
+	}
+	args = append(args, update.ID)
+	stmt := fmt.Sprintf(
+		`UPDATE ai_memory SET %s WHERE id = ?
+		 RETURNING id, user_id, content, created_ts, updated_ts`,
+		strings.Join(set, ", "),
+	)
+	m := &store.AIMemory{}
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(&m.ID, &m.UserID, &m.Content, &m.CreatedTs, &m.UpdatedTs); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (d *DB) DeleteAIMemory(ctx context.Context, id int32) error {
+	_, err := d.db.ExecContext(ctx, `DELETE FROM ai_memory WHERE id = ?`, id)
+	return err
+}
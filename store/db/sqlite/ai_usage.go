@@ -0,0 +1,53 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateAIUsage(ctx context.Context, create *store.AIUsage) (*store.AIUsage, error) {
+	stmt := `INSERT INTO ai_usage (user_id, kind, model, prompt_tokens, completion_tokens)
+	         VALUES (?, ?, ?, ?, ?)
+	         RETURNING id, created_ts`
+	if err := d.db.QueryRowContext(ctx, stmt, create.UserID, create.Kind, create.Model, create.PromptTokens, create.CompletionTokens).
+		Scan(&create.ID, &create.CreatedTs); err != nil {
+		return nil, err
+	}
+	return create, nil
+}
+
+func (d *DB) SumAIUsage(ctx context.Context, find *store.FindAIUsage) ([]*store.AIUsageSum, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.UserID; v != nil {
+		where, args = append(where, "user_id = ?"), append(args, *v)
+	}
+	if v := find.CreatedTsAfter; v != nil {
+		where, args = append(where, "created_ts >= ?"), append(args, *v)
+	}
+	if v := find.CreatedTsBefore; v != nil {
+		where, args = append(where, "created_ts < ?"), append(args, *v)
+	}
+	query := fmt.Sprintf(
+		`SELECT user_id, COUNT(*), COALESCE(SUM(prompt_tokens), 0), COALESCE(SUM(completion_tokens), 0)
+		 FROM ai_usage WHERE %s GROUP BY user_id ORDER BY user_id`,
+		strings.Join(where, " AND "),
+	)
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*store.AIUsageSum
+	for rows.Next() {
+		s := &store.AIUsageSum{}
+		if err := rows.Scan(&s.UserID, &s.Calls, &s.PromptTokens, &s.CompletionTokens); err != nil {
+			return nil, err
+		}
+		list = append(list, s)
+	}
+	return list, rows.Err()
+}
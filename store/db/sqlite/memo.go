@@ -2,6 +2,7 @@ package sqlite
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -221,6 +222,13 @@ func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
 		}
 		set, args = append(set, "`payload` = ?"), append(args, string(payloadBytes))
 	}
+	if v := update.SuggestedTags; v != nil {
+		tagsBytes, err := json.Marshal(*v)
+		if err != nil {
+			return err
+		}
+		set, args = append(set, "`payload` = json_set(`payload`, '$.suggestedTags', json(?))"), append(args, string(tagsBytes))
+	}
 	if len(set) == 0 {
 		return nil
 	}
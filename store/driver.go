@@ -74,9 +74,25 @@ type Driver interface {
 	GetAIChatSession(ctx context.Context, find *FindAIChatSession) (*AIChatSession, error)
 	UpdateAIChatSession(ctx context.Context, update *UpdateAIChatSession) (*AIChatSession, error)
 	DeleteAIChatSession(ctx context.Context, uid string) error
+	SearchAIChats(ctx context.Context, search *SearchAIChat) ([]*AIChatMatch, error)
 
 	// AIChatMessage model related methods.
 	CreateAIChatMessage(ctx context.Context, create *CreateAIChatMessage) (*AIChatMessage, error)
 	ListAIChatMessages(ctx context.Context, find *FindAIChatMessage) ([]*AIChatMessage, error)
-	DeleteAIChatMessages(ctx context.Context, sessionID int32) error
+	CompactAIChatSession(ctx context.Context, compact *CompactAIChatSession) error
+
+	// AIUsage model related methods.
+	CreateAIUsage(ctx context.Context, create *AIUsage) (*AIUsage, error)
+	SumAIUsage(ctx context.Context, find *FindAIUsage) ([]*AIUsageSum, error)
+
+	// AttachmentText model related methods.
+	UpsertAttachmentText(ctx context.Context, upsert *AttachmentText) (*AttachmentText, error)
+	ListAttachmentTexts(ctx context.Context, find *FindAttachmentText) ([]*AttachmentText, error)
+	DeleteAttachmentText(ctx context.Context, attachmentID int32) error
+
+	// AIMemory model related methods.
+	CreateAIMemory(ctx context.Context, create *AIMemory) (*AIMemory, error)
+	ListAIMemories(ctx context.Context, find *FindAIMemory) ([]*AIMemory, error)
+	UpdateAIMemory(ctx context.Context, update *UpdateAIMemory) (*AIMemory, error)
+	DeleteAIMemory(ctx context.Context, id int32) error
 }
@@ -100,6 +100,10 @@ type UpdateMemo struct {
 	Visibility *Visibility
 	Pinned     *bool
 	Payload    *storepb.MemoPayload
+	// SuggestedTags replaces only the suggested tags of the stored payload, so
+	// that concurrent changes to the rest of it are kept. It cannot be combined
+	// with Payload.
+	SuggestedTags *[]string
 }
 
 type DeleteMemo struct {
@@ -0,0 +1,152 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestAIChatMessages(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	session, err := ts.CreateAIChatSession(ctx, &store.AIChatSession{
+		UID:       "test-session",
+		CreatorID: user.ID,
+		Title:     "New Chat",
+	})
+	require.NoError(t, err)
+
+	toolCalls := `[{"id":"call_1","type":"function","function":{"name":"search_memos","arguments":"{}"}}]`
+	creates := []*store.CreateAIChatMessage{
+		{SessionID: session.ID, Role: "user", Content: "find my notes"},
+		{SessionID: session.ID, Role: "assistant", ToolCalls: toolCalls},
+		{SessionID: session.ID, Role: "tool", Content: "result", ToolName: "search_memos", ToolCallID: "call_1"},
+		{SessionID: session.ID, Role: "assistant", Content: "Here they are."},
+	}
+	var parentID int32
+	for _, create := range creates {
+		create.ParentID = parentID
+		message, err := ts.CreateAIChatMessage(ctx, create)
+		require.NoError(t, err)
+		parentID = message.ID
+	}
+
+	messages, err := ts.ListAIChatMessages(ctx, &store.FindAIChatMessage{SessionID: session.ID})
+	require.NoError(t, err)
+	require.Len(t, messages, len(creates))
+	for i, create := range creates {
+		require.Equal(t, create.Role, messages[i].Role)
+		require.Equal(t, create.Content, messages[i].Content)
+		require.Equal(t, create.ToolName, messages[i].ToolName)
+		require.Equal(t, create.ToolCallID, messages[i].ToolCallID)
+		require.Equal(t, create.ToolCalls, messages[i].ToolCalls)
+		require.Equal(t, create.ParentID, messages[i].ParentID)
+	}
+
+	pendingActions := `[{"toolCallId":"call_2","toolName":"delete_memo"}]`
+	session, err = ts.UpdateAIChatSession(ctx, &store.UpdateAIChatSession{
+		UID:             session.UID,
+		ActiveMessageID: &parentID,
+		PendingActions:  &pendingActions,
+	})
+	require.NoError(t, err)
+	require.Equal(t, parentID, session.ActiveMessageID)
+	require.Equal(t, pendingActions, session.PendingActions)
+
+	// Compaction keeps the messages it summarises.
+	err = ts.CompactAIChatSession(ctx, &store.CompactAIChatSession{
+		SessionID:        session.ID,
+		Summary:          "The user looked for their notes.",
+		SummaryMessageID: messages[1].ID,
+		MessageIDs:       []int32{messages[0].ID, messages[1].ID},
+	})
+	require.NoError(t, err)
+	session, err = ts.GetAIChatSession(ctx, &store.FindAIChatSession{UID: &session.UID})
+	require.NoError(t, err)
+	require.Equal(t, "The user looked for their notes.", session.Summary)
+	require.Equal(t, messages[1].ID, session.SummaryMessageID)
+	messages, err = ts.ListAIChatMessages(ctx, &store.FindAIChatMessage{SessionID: session.ID})
+	require.NoError(t, err)
+	require.Len(t, messages, len(creates))
+	for i, message := range messages {
+		require.Equal(t, i < 2, message.Compacted)
+	}
+
+	ts.Close()
+}
+
+func TestSearchAIChats(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	other, err := createTestingUserWithRole(ctx, ts, "other", store.RoleUser)
+	require.NoError(t, err)
+
+	deploy, err := ts.CreateAIChatSession(ctx, &store.AIChatSession{UID: "deploy", CreatorID: user.ID, Title: "Deploy process"})
+	require.NoError(t, err)
+	recipes, err := ts.CreateAIChatSession(ctx, &store.AIChatSession{UID: "recipes", CreatorID: user.ID, Title: "Recipes"})
+	require.NoError(t, err)
+	foreign, err := ts.CreateAIChatSession(ctx, &store.AIChatSession{UID: "foreign", CreatorID: other.ID, Title: "How do we deploy?"})
+	require.NoError(t, err)
+	for _, create := range []*store.CreateAIChatMessage{
+		{SessionID: deploy.ID, Role: "user", Content: "How do we deploy?"},
+		{SessionID: deploy.ID, Role: "tool", Content: "deploy.md", ToolName: "search_memos"},
+		{SessionID: deploy.ID, Role: "assistant", Content: "Run make DEPLOY after tagging a release."},
+		{SessionID: recipes.ID, Role: "user", Content: "Any soup recipes?"},
+		{SessionID: recipes.ID, Role: "assistant", Content: "Lentil soup, 100% vegan."},
+		{SessionID: foreign.ID, Role: "user", Content: "deploy steps"},
+	} {
+		_, err := ts.CreateAIChatMessage(ctx, create)
+		require.NoError(t, err)
+	}
+
+	// The session title and both chat messages match, but not the tool result
+	// or another user's session.
+	matches, err := ts.SearchAIChats(ctx, &store.SearchAIChat{CreatorID: user.ID, Terms: []string{"deploy"}})
+	require.NoError(t, err)
+	require.Len(t, matches, 3)
+	var roles []string
+	for _, match := range matches {
+		require.Equal(t, "deploy", match.SessionUID)
+		require.Equal(t, "Deploy process", match.SessionTitle)
+		roles = append(roles, match.Role)
+	}
+	require.ElementsMatch(t, []string{"", "user", "assistant"}, roles)
+
+	// Any term matches.
+	matches, err = ts.SearchAIChats(ctx, &store.SearchAIChat{CreatorID: user.ID, Terms: []string{"release", "soup"}})
+	require.NoError(t, err)
+	require.Len(t, matches, 3)
+
+	// Wildcards in terms match literally.
+	matches, err = ts.SearchAIChats(ctx, &store.SearchAIChat{CreatorID: user.ID, Terms: []string{"100%"}})
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	matches, err = ts.SearchAIChats(ctx, &store.SearchAIChat{CreatorID: user.ID, Terms: []string{"%"}})
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	matches, err = ts.SearchAIChats(ctx, &store.SearchAIChat{CreatorID: user.ID, Terms: []string{"_"}})
+	require.NoError(t, err)
+	require.Empty(t, matches)
+
+	limit, offset := 2, 2
+	matches, err = ts.SearchAIChats(ctx, &store.SearchAIChat{CreatorID: user.ID, Terms: []string{"deploy"}, Limit: &limit, Offset: &offset})
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+
+	matches, err = ts.SearchAIChats(ctx, &store.SearchAIChat{CreatorID: user.ID})
+	require.NoError(t, err)
+	require.Empty(t, matches)
+
+	ts.Close()
+}
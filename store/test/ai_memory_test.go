@@ -0,0 +1,47 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestAIMemory(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	units, err := ts.CreateAIMemory(ctx, &store.AIMemory{UserID: user.ID, Content: "Prefers metric units."})
+	require.NoError(t, err)
+	require.NotZero(t, units.ID)
+	require.NotZero(t, units.CreatedTs)
+	project, err := ts.CreateAIMemory(ctx, &store.AIMemory{UserID: user.ID, Content: "Works on the garden project."})
+	require.NoError(t, err)
+	_, err = ts.CreateAIMemory(ctx, &store.AIMemory{UserID: user.ID + 1, Content: "Someone else."})
+	require.NoError(t, err)
+
+	memories, err := ts.ListAIMemories(ctx, &store.FindAIMemory{UserID: &user.ID})
+	require.NoError(t, err)
+	require.Len(t, memories, 2)
+
+	content := "Prefers metric units and 24-hour time."
+	updated, err := ts.UpdateAIMemory(ctx, &store.UpdateAIMemory{ID: units.ID, Content: &content})
+	require.NoError(t, err)
+	require.Equal(t, content, updated.Content)
+	require.Equal(t, user.ID, updated.UserID)
+
+	require.NoError(t, ts.DeleteAIMemory(ctx, project.ID))
+	memory, err := ts.GetAIMemory(ctx, &store.FindAIMemory{ID: &project.ID})
+	require.NoError(t, err)
+	require.Nil(t, memory)
+	memories, err = ts.ListAIMemories(ctx, &store.FindAIMemory{UserID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, []*store.AIMemory{updated}, memories)
+
+	ts.Close()
+}
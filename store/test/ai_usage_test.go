@@ -0,0 +1,48 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestAIUsage(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	for _, usage := range []*store.AIUsage{
+		{UserID: user.ID, Kind: "chat", Model: "m", PromptTokens: 100, CompletionTokens: 20},
+		{UserID: user.ID, Kind: "title", Model: "m", PromptTokens: 30, CompletionTokens: 5},
+		{UserID: user.ID + 1, Kind: "completion", Model: "m", PromptTokens: 7, CompletionTokens: 3},
+	} {
+		created, err := ts.CreateAIUsage(ctx, usage)
+		require.NoError(t, err)
+		require.NotZero(t, created.ID)
+		require.NotZero(t, created.CreatedTs)
+	}
+
+	sums, err := ts.SumAIUsage(ctx, &store.FindAIUsage{})
+	require.NoError(t, err)
+	require.Len(t, sums, 2)
+	require.Equal(t, &store.AIUsageSum{UserID: user.ID, Calls: 2, PromptTokens: 130, CompletionTokens: 25}, sums[0])
+	require.Equal(t, int64(10), sums[1].TotalTokens())
+
+	sums, err = ts.SumAIUsage(ctx, &store.FindAIUsage{UserID: &user.ID})
+	require.NoError(t, err)
+	require.Len(t, sums, 1)
+	require.Equal(t, int64(155), sums[0].TotalTokens())
+
+	// Nothing was recorded before the epoch.
+	before := int64(1)
+	sums, err = ts.SumAIUsage(ctx, &store.FindAIUsage{CreatedTsBefore: &before})
+	require.NoError(t, err)
+	require.Empty(t, sums)
+
+	ts.Close()
+}
@@ -0,0 +1,85 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lithammer/shortuuid/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestAttachmentText(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        shortuuid.New(),
+		CreatorID:  user.ID,
+		Content:    "quarterly numbers",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+	report, err := ts.CreateAttachment(ctx, &store.Attachment{
+		UID:       shortuuid.New(),
+		CreatorID: user.ID,
+		Filename:  "report.pdf",
+		Type:      "application/pdf",
+		MemoID:    &memo.ID,
+	})
+	require.NoError(t, err)
+	scan, err := ts.CreateAttachment(ctx, &store.Attachment{
+		UID:       shortuuid.New(),
+		CreatorID: user.ID,
+		Filename:  "scan.pdf",
+		Type:      "application/pdf",
+	})
+	require.NoError(t, err)
+
+	_, err = ts.UpsertAttachmentText(ctx, &store.AttachmentText{AttachmentID: report.ID, Content: "Revenue grew"})
+	require.NoError(t, err)
+	upserted, err := ts.UpsertAttachmentText(ctx, &store.AttachmentText{AttachmentID: report.ID, Content: "Revenue grew 12% in Q3"})
+	require.NoError(t, err)
+	require.NotZero(t, upserted.UpdatedTs)
+	// Nothing could be extracted from the scan.
+	_, err = ts.UpsertAttachmentText(ctx, &store.AttachmentText{AttachmentID: scan.ID})
+	require.NoError(t, err)
+
+	texts, err := ts.ListAttachmentTexts(ctx, &store.FindAttachmentText{MemoIDList: []int32{memo.ID}})
+	require.NoError(t, err)
+	require.Len(t, texts, 1)
+	require.Equal(t, "Revenue grew 12% in Q3", texts[0].Content)
+	require.Equal(t, report.UID, texts[0].AttachmentUID)
+	require.Equal(t, "report.pdf", texts[0].Filename)
+	require.Equal(t, memo.ID, *texts[0].MemoID)
+
+	texts, err = ts.ListAttachmentTexts(ctx, &store.FindAttachmentText{Terms: []string{"revenue", "missing"}})
+	require.NoError(t, err)
+	require.Len(t, texts, 1)
+	texts, err = ts.ListAttachmentTexts(ctx, &store.FindAttachmentText{Terms: []string{"missing"}})
+	require.NoError(t, err)
+	require.Empty(t, texts)
+
+	texts, err = ts.ListAttachmentTexts(ctx, &store.FindAttachmentText{
+		AttachmentIDList: []int32{report.ID, scan.ID},
+		ExcludeContent:   true,
+		IncludeEmpty:     true,
+	})
+	require.NoError(t, err)
+	require.Len(t, texts, 2)
+	require.Empty(t, texts[0].Content)
+	require.Nil(t, texts[1].MemoID)
+
+	// Deleting the attachment drops its text.
+	require.NoError(t, ts.DeleteAttachment(ctx, &store.DeleteAttachment{ID: report.ID}))
+	texts, err = ts.ListAttachmentTexts(ctx, &store.FindAttachmentText{IncludeEmpty: true})
+	require.NoError(t, err)
+	require.Len(t, texts, 1)
+	require.Equal(t, scan.ID, texts[0].AttachmentID)
+
+	ts.Close()
+}
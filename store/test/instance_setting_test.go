@@ -184,6 +184,40 @@ func TestInstanceSettingMemoRelatedSetting(t *testing.T) {
 	ts.Close()
 }
 
+func TestInstanceSettingAISetting(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+
+	// Get default AI setting (no quotas)
+	aiSetting, err := ts.GetInstanceAISetting(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, aiSetting)
+	require.Zero(t, aiSetting.MonthlyTokenQuota)
+
+	// Set quotas
+	_, err = ts.UpsertInstanceSetting(ctx, &storepb.InstanceSetting{
+		Key: storepb.InstanceSettingKey_AI,
+		Value: &storepb.InstanceSetting_AiSetting{
+			AiSetting: &storepb.InstanceAISetting{
+				MonthlyTokenQuota:      100000,
+				RoleMonthlyTokenQuotas: map[string]int64{"ADMIN": 0},
+				UserMonthlyTokenQuotas: map[int32]int64{2: 5000},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	// Verify
+	aiSetting, err = ts.GetInstanceAISetting(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(100000), aiSetting.MonthlyTokenQuota)
+	require.Equal(t, map[string]int64{"ADMIN": 0}, aiSetting.RoleMonthlyTokenQuotas)
+	require.Equal(t, map[int32]int64{2: 5000}, aiSetting.UserMonthlyTokenQuotas)
+
+	ts.Close()
+}
+
 func TestInstanceSettingStorageSetting(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
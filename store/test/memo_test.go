@@ -476,3 +476,41 @@ func TestMemoWithPayload(t *testing.T) {
 
 	ts.Close()
 }
+
+func TestMemoUpdateSuggestedTags(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "memo-suggested-tags",
+		CreatorID:  user.ID,
+		Content:    "content with #tag1",
+		Visibility: store.Public,
+		Payload:    &storepb.MemoPayload{Tags: []string{"tag1"}},
+	})
+	require.NoError(t, err)
+
+	// An edit of the payload saved meanwhile is kept.
+	location := &storepb.MemoPayload_Location{Placeholder: "Home"}
+	require.NoError(t, ts.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, Payload: &storepb.MemoPayload{Tags: []string{"tag1"}, Location: location}}))
+	suggested := []string{"tag2", "tag3"}
+	require.NoError(t, ts.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, SuggestedTags: &suggested}))
+
+	found, err := ts.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, []string{"tag1"}, found.Payload.Tags)
+	require.Equal(t, suggested, found.Payload.SuggestedTags)
+	require.Equal(t, "Home", found.Payload.Location.GetPlaceholder())
+
+	// Dismissing the suggestions clears them alone.
+	require.NoError(t, ts.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, SuggestedTags: &[]string{}}))
+	found, err = ts.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	require.NoError(t, err)
+	require.Empty(t, found.Payload.SuggestedTags)
+	require.Equal(t, "Home", found.Payload.Location.GetPlaceholder())
+
+	ts.Close()
+}
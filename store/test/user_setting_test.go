@@ -318,6 +318,46 @@ func TestUserSettingShortcuts(t *testing.T) {
 	ts.Close()
 }
 
+func TestUserSettingAI(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	_, err = ts.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSetting_AI,
+		Value:  &storepb.UserSetting_Ai{Ai: &storepb.AIUserSetting{ConfirmTools: []string{"delete_memo"}}},
+	})
+	require.NoError(t, err)
+
+	setting, err := ts.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &user.ID,
+		Key:    storepb.UserSetting_AI,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, setting)
+	require.Equal(t, []string{"delete_memo"}, setting.GetAi().ConfirmTools)
+
+	// An empty policy is kept, so it can be told apart from no setting at all.
+	_, err = ts.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSetting_AI,
+		Value:  &storepb.UserSetting_Ai{Ai: &storepb.AIUserSetting{}},
+	})
+	require.NoError(t, err)
+	setting, err = ts.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &user.ID,
+		Key:    storepb.UserSetting_AI,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, setting)
+	require.Empty(t, setting.GetAi().ConfirmTools)
+
+	ts.Close()
+}
+
 func TestUserSettingGetUserByPATHash(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
@@ -431,6 +431,12 @@ func convertUserSettingFromRaw(raw *UserSetting) (*storepb.UserSetting, error) {
 			return nil, err
 		}
 		userSetting.Value = &storepb.UserSetting_Webhooks{Webhooks: webhooksUserSetting}
+	case storepb.UserSetting_AI:
+		aiUserSetting := &storepb.AIUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), aiUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_Ai{Ai: aiUserSetting}
 	default:
 		return nil, nil
 	}
@@ -479,6 +485,13 @@ func convertUserSettingToRaw(userSetting *storepb.UserSetting) (*UserSetting, er
 			return nil, err
 		}
 		raw.Value = string(value)
+	case storepb.UserSetting_AI:
+		aiUserSetting := userSetting.GetAi()
+		value, err := protojson.Marshal(aiUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
 	default:
 		return nil, errors.Errorf("unsupported user setting key: %v", userSetting.Key)
 	}
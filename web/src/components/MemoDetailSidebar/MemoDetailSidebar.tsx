@@ -6,6 +6,7 @@ import { cn } from "@/lib/utils";
 import { Memo, Memo_PropertySchema, MemoRelation_Type } from "@/types/proto/api/v1/memo_service_pb";
 import { useTranslate } from "@/utils/i18n";
 import MemoRelationForceGraph from "../MemoRelationForceGraph";
+import RelatedMemos from "./RelatedMemos";
 
 interface Props {
   memo: Memo;
@@ -37,6 +38,8 @@ const MemoDetailSidebar = ({ memo, className, parentPage }: Props) => {
         </div>
       )}
 
+      <RelatedMemos memo={memo} parentPage={parentPage} label={<SectionLabel>{t("memo.related-memos")}</SectionLabel>} />
+
       <div className="w-full space-y-1">
         <SectionLabel>{t("common.created-at")}</SectionLabel>
         <p className="text-sm text-foreground/70">{memo.createTime ? timestampDate(memo.createTime).toLocaleString() : "—"}</p>
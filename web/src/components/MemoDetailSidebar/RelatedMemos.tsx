@@ -0,0 +1,71 @@
+import { LinkIcon } from "lucide-react";
+import { toast } from "react-hot-toast";
+import MemoSnippetLink from "@/components/MemoView/components/MemoSnippetLink";
+import { Button } from "@/components/ui/button";
+import useCurrentUser from "@/hooks/useCurrentUser";
+import { useLinkRelatedMemo, useRelatedMemos } from "@/hooks/useMemoQueries";
+import { handleError } from "@/lib/error";
+import { Memo } from "@/types/proto/api/v1/memo_service_pb";
+import { useTranslate } from "@/utils/i18n";
+
+interface Props {
+  memo: Memo;
+  parentPage?: string;
+  label: React.ReactNode;
+}
+
+const RelatedMemos = ({ memo, parentPage, label }: Props) => {
+  const t = useTranslate();
+  const currentUser = useCurrentUser();
+  const { data } = useRelatedMemos(memo.name, { enabled: !memo.parent });
+  const { mutateAsync: linkRelatedMemo, isPending } = useLinkRelatedMemo();
+  const results = data?.results.filter((r) => r.memo) ?? [];
+  const canLink = currentUser?.name === memo.creator;
+
+  if (results.length === 0) {
+    return null;
+  }
+
+  const handleLink = async (relatedMemoName: string) => {
+    try {
+      await linkRelatedMemo({ memo, relatedMemoName });
+    } catch (error: unknown) {
+      await handleError(error, toast.error, {
+        context: "Link related memo",
+      });
+    }
+  };
+
+  return (
+    <div className="w-full space-y-2">
+      {label}
+      <div className="flex flex-col">
+        {results.map(({ memo: related }) => (
+          <div key={related!.name} className="flex flex-row items-center gap-1">
+            <MemoSnippetLink
+              className="min-w-0 grow"
+              name={related!.name}
+              snippet={related!.snippet}
+              to={`/${related!.name}`}
+              state={{ from: parentPage }}
+            />
+            {canLink && (
+              <Button
+                variant="ghost"
+                size="sm"
+                className="h-6 px-1.5 shrink-0 text-muted-foreground"
+                title={t("memo.link-related-memo")}
+                disabled={isPending}
+                onClick={() => handleLink(related!.name)}
+              >
+                <LinkIcon className="w-3.5 h-3.5" />
+              </Button>
+            )}
+          </div>
+        ))}
+      </div>
+    </div>
+  );
+};
+
+export default RelatedMemos;
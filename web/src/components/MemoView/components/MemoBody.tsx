@@ -5,7 +5,7 @@ import MemoContent from "../../MemoContent";
 import { MemoReactionListView } from "../../MemoReactionListView";
 import { useMemoViewContext } from "../MemoViewContext";
 import type { MemoBodyProps } from "../types";
-import { AttachmentList, LocationDisplay, RelationList } from "./metadata";
+import { AttachmentList, LocationDisplay, RelationList, TagSuggestions } from "./metadata";
 
 const NsfwOverlay: React.FC<{ onClick?: () => void }> = ({ onClick }) => {
   const t = useTranslate();
@@ -44,6 +44,7 @@ const MemoBody: React.FC<MemoBodyProps> = ({ compact, onContentClick, onContentD
         <AttachmentList attachments={memo.attachments} />
         <RelationList relations={referencedMemos} currentMemoName={memo.name} parentPage={parentPage} />
         {memo.location && <LocationDisplay location={memo.location} />}
+        <TagSuggestions key={memo.suggestedTags.join(",")} memoName={memo.name} tags={memo.suggestedTags} />
         <MemoReactionListView memo={memo} reactions={memo.reactions} />
       </div>
 
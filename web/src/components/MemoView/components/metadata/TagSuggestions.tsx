@@ -0,0 +1,65 @@
+import { SparklesIcon } from "lucide-react";
+import { useState } from "react";
+import { toast } from "react-hot-toast";
+import { Button } from "@/components/ui/button";
+import { useAcceptMemoTagSuggestions } from "@/hooks/useMemoQueries";
+import { handleError } from "@/lib/error";
+import { cn } from "@/lib/utils";
+import { useTranslate } from "@/utils/i18n";
+
+interface TagSuggestionsProps {
+  memoName: string;
+  tags: string[];
+}
+
+const TagSuggestions = ({ memoName, tags }: TagSuggestionsProps) => {
+  const t = useTranslate();
+  const [selected, setSelected] = useState<string[]>(tags);
+  const { mutateAsync: acceptTagSuggestions, isPending } = useAcceptMemoTagSuggestions();
+
+  if (tags.length === 0) {
+    return null;
+  }
+
+  const toggle = (tag: string) => {
+    setSelected((prev) => (prev.includes(tag) ? prev.filter((item) => item !== tag) : [...prev, tag]));
+  };
+
+  const accept = async (accepted: string[]) => {
+    try {
+      await acceptTagSuggestions({ name: memoName, tags: accepted });
+    } catch (error: unknown) {
+      await handleError(error, toast.error, {
+        context: "Accept tag suggestions",
+      });
+    }
+  };
+
+  return (
+    <div className="w-full flex flex-row flex-wrap items-center gap-1.5 text-xs text-muted-foreground">
+      <SparklesIcon className="w-3.5 h-3.5 shrink-0" />
+      <span>{t("memo.suggested-tags")}</span>
+      {tags.map((tag) => (
+        <button
+          key={tag}
+          type="button"
+          className={cn(
+            "px-1.5 h-6 rounded-md border border-dashed border-border transition-colors hover:text-foreground",
+            selected.includes(tag) && "border-solid bg-accent/20 text-foreground",
+          )}
+          onClick={() => toggle(tag)}
+        >
+          #{tag}
+        </button>
+      ))}
+      <Button variant="ghost" size="sm" className="h-6 px-2" disabled={isPending || selected.length === 0} onClick={() => accept(selected)}>
+        {t("common.add")}
+      </Button>
+      <Button variant="ghost" size="sm" className="h-6 px-2" disabled={isPending} onClick={() => accept([])}>
+        {t("memo.dismiss")}
+      </Button>
+    </div>
+  );
+};
+
+export default TagSuggestions;
@@ -4,3 +4,4 @@ export { default as LocationDisplay } from "./LocationDisplay";
 
 export { default as RelationCard } from "./RelationCard";
 export { default as RelationList } from "./RelationList";
+export { default as TagSuggestions } from "./TagSuggestions";
@@ -0,0 +1,254 @@
+import { create } from "@bufbuild/protobuf";
+import { FieldMaskSchema } from "@bufbuild/protobuf/wkt";
+import { CheckIcon, PencilIcon, PlusIcon, TrashIcon, XIcon } from "lucide-react";
+import { useEffect, useState } from "react";
+import { toast } from "react-hot-toast";
+import { Button } from "@/components/ui/button";
+import { Input } from "@/components/ui/input";
+import { Switch } from "@/components/ui/switch";
+import { userServiceClient } from "@/connect";
+import { buildUserSettingName } from "@/helpers/resource-names";
+import useCurrentUser from "@/hooks/useCurrentUser";
+import { handleError } from "@/lib/error";
+import {
+  MCPServer,
+  MCPServerSchema,
+  UserSetting_AISetting,
+  UserSetting_AISettingSchema,
+  UserSetting_Key,
+  UserSettingSchema,
+} from "@/types/proto/api/v1/user_service_pb";
+import { type AIMemory, aiService } from "@/utils/aiService";
+import { useTranslate } from "@/utils/i18n";
+import SettingGroup from "./SettingGroup";
+import SettingRow from "./SettingRow";
+import SettingTable from "./SettingTable";
+
+// Tools the AI assistant can be asked to confirm before running.
+const CONFIRMABLE_TOOLS = [
+  "create_memo",
+  "append_to_memo",
+  "update_memo",
+  "update_memo_tags",
+  "delete_memo",
+  "search_internet",
+  "scrape_url",
+  "remember",
+  "forget",
+];
+
+const AISettingSection = () => {
+  const t = useTranslate();
+  const currentUser = useCurrentUser();
+  const [confirmTools, setConfirmTools] = useState<string[]>([]);
+  const [mcpServers, setMcpServers] = useState<MCPServer[]>([]);
+  const [suggestTags, setSuggestTags] = useState(false);
+  const [newServer, setNewServer] = useState({ name: "", url: "", authorization: "" });
+  const [memories, setMemories] = useState<AIMemory[]>([]);
+  const [editingMemory, setEditingMemory] = useState({ name: "", content: "" });
+
+  useEffect(() => {
+    if (!currentUser) return;
+    userServiceClient.getUserSetting({ name: buildUserSettingName(currentUser.name, UserSetting_Key.AI) }).then((setting) => {
+      if (setting.value.case === "aiSetting") {
+        setConfirmTools(setting.value.value.confirmTools);
+        setMcpServers(setting.value.value.mcpServers);
+        setSuggestTags(setting.value.value.suggestTags);
+      }
+    });
+    aiService.listMemories(currentUser.name).then(setMemories);
+  }, [currentUser]);
+
+  const updateSetting = async (value: Partial<UserSetting_AISetting>, path: string) => {
+    if (!currentUser) return;
+    await userServiceClient.updateUserSetting({
+      setting: create(UserSettingSchema, {
+        name: buildUserSettingName(currentUser.name, UserSetting_Key.AI),
+        value: {
+          case: "aiSetting",
+          value: create(UserSetting_AISettingSchema, value),
+        },
+      }),
+      updateMask: create(FieldMaskSchema, { paths: [path] }),
+    });
+  };
+
+  const handleToggle = async (tool: string, checked: boolean) => {
+    const tools = checked ? [...confirmTools, tool] : confirmTools.filter((item) => item !== tool);
+    setConfirmTools(tools);
+    await updateSetting({ confirmTools: tools }, "confirm_tools");
+  };
+
+  const handleSuggestTagsChange = async (checked: boolean) => {
+    setSuggestTags(checked);
+    await updateSetting({ suggestTags: checked }, "suggest_tags");
+  };
+
+  const saveMcpServers = async (servers: MCPServer[]) => {
+    try {
+      await updateSetting({ mcpServers: servers }, "mcp_servers");
+      setMcpServers(servers);
+      return true;
+    } catch (error: unknown) {
+      await handleError(error, toast.error, {
+        context: "Update MCP servers",
+      });
+      return false;
+    }
+  };
+
+  const handleAddMcpServer = async () => {
+    const server = create(MCPServerSchema, {
+      name: newServer.name.trim(),
+      url: newServer.url.trim(),
+      headers: newServer.authorization.trim() ? { Authorization: newServer.authorization.trim() } : {},
+    });
+    if (await saveMcpServers([...mcpServers, server])) {
+      setNewServer({ name: "", url: "", authorization: "" });
+    }
+  };
+
+  const handleSaveMemory = async () => {
+    try {
+      const updated = await aiService.updateMemory(editingMemory.name, editingMemory.content.trim());
+      setMemories(memories.map((memory) => (memory.name === updated.name ? updated : memory)));
+      setEditingMemory({ name: "", content: "" });
+    } catch (error: unknown) {
+      await handleError(error, toast.error, {
+        context: "Update memory",
+      });
+    }
+  };
+
+  const handleDeleteMemory = async (name: string) => {
+    try {
+      await aiService.deleteMemory(name);
+      setMemories(memories.filter((memory) => memory.name !== name));
+    } catch (error: unknown) {
+      await handleError(error, toast.error, {
+        context: "Delete memory",
+      });
+    }
+  };
+
+  return (
+    <>
+      <SettingGroup title={t("setting.ai-section.title")} showSeparator>
+        <SettingRow label={t("setting.ai-section.suggest-tags")} description={t("setting.ai-section.suggest-tags-description")}>
+          <Switch checked={suggestTags} onCheckedChange={handleSuggestTagsChange} />
+        </SettingRow>
+      </SettingGroup>
+
+      <SettingGroup title={t("setting.ai-section.confirm-tools")} description={t("setting.ai-section.confirm-tools-description")} showSeparator>
+        {CONFIRMABLE_TOOLS.map((tool) => (
+          <SettingRow key={tool} label={tool}>
+            <Switch checked={confirmTools.includes(tool)} onCheckedChange={(checked) => handleToggle(tool, checked)} />
+          </SettingRow>
+        ))}
+      </SettingGroup>
+
+      <SettingGroup title={t("setting.ai-section.memories")} description={t("setting.ai-section.memories-description")} showSeparator>
+        <SettingTable
+          columns={[
+            {
+              key: "content",
+              header: t("setting.ai-section.memory"),
+              render: (_, memory: AIMemory) =>
+                editingMemory.name === memory.name ? (
+                  <Input value={editingMemory.content} onChange={(e) => setEditingMemory({ ...editingMemory, content: e.target.value })} />
+                ) : (
+                  <span className="text-foreground whitespace-pre-wrap">{memory.content}</span>
+                ),
+            },
+            {
+              key: "actions",
+              header: "",
+              className: "text-right whitespace-nowrap",
+              render: (_, memory: AIMemory) =>
+                editingMemory.name === memory.name ? (
+                  <>
+                    <Button variant="ghost" size="sm" disabled={!editingMemory.content.trim()} onClick={handleSaveMemory}>
+                      <CheckIcon className="w-4 h-auto" />
+                    </Button>
+                    <Button variant="ghost" size="sm" onClick={() => setEditingMemory({ name: "", content: "" })}>
+                      <XIcon className="w-4 h-auto" />
+                    </Button>
+                  </>
+                ) : (
+                  <>
+                    <Button variant="ghost" size="sm" onClick={() => setEditingMemory({ name: memory.name, content: memory.content })}>
+                      <PencilIcon className="w-4 h-auto" />
+                    </Button>
+                    <Button variant="ghost" size="sm" onClick={() => handleDeleteMemory(memory.name)}>
+                      <TrashIcon className="text-destructive w-4 h-auto" />
+                    </Button>
+                  </>
+                ),
+            },
+          ]}
+          data={memories}
+          emptyMessage={t("setting.ai-section.no-memories")}
+          getRowKey={(memory) => memory.name}
+        />
+      </SettingGroup>
+
+      <SettingGroup title={t("setting.ai-section.mcp-servers")} description={t("setting.ai-section.mcp-servers-description")}>
+        <SettingTable
+          columns={[
+            {
+              key: "name",
+              header: t("common.name"),
+              render: (_, server: MCPServer) => <span className="text-foreground">{server.name}</span>,
+            },
+            {
+              key: "url",
+              header: t("setting.webhook-section.url"),
+              render: (_, server: MCPServer) => (
+                <span className="max-w-[300px] inline-block truncate text-foreground" title={server.url}>
+                  {server.url}
+                </span>
+              ),
+            },
+            {
+              key: "actions",
+              header: "",
+              className: "text-right",
+              render: (_, server: MCPServer) => (
+                <Button variant="ghost" size="sm" onClick={() => saveMcpServers(mcpServers.filter((item) => item.name !== server.name))}>
+                  <TrashIcon className="text-destructive w-4 h-auto" />
+                </Button>
+              ),
+            },
+          ]}
+          data={mcpServers}
+          emptyMessage={t("setting.ai-section.no-mcp-servers")}
+          getRowKey={(server) => server.name}
+        />
+        <div className="flex flex-col sm:flex-row gap-2">
+          <Input
+            className="sm:w-32"
+            placeholder={t("common.name")}
+            value={newServer.name}
+            onChange={(e) => setNewServer({ ...newServer, name: e.target.value })}
+          />
+          <Input
+            placeholder="https://example.com/mcp"
+            value={newServer.url}
+            onChange={(e) => setNewServer({ ...newServer, url: e.target.value })}
+          />
+          <Input
+            placeholder={t("setting.ai-section.authorization-header")}
+            value={newServer.authorization}
+            onChange={(e) => setNewServer({ ...newServer, authorization: e.target.value })}
+          />
+          <Button size="sm" disabled={!newServer.name.trim() || !newServer.url.trim()} onClick={handleAddMcpServer}>
+            <PlusIcon className="w-4 h-4 mr-1.5" />
+            {t("common.add")}
+          </Button>
+        </div>
+      </SettingGroup>
+    </>
+  );
+};
+
+export default AISettingSection;
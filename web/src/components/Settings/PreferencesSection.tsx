@@ -10,6 +10,7 @@ import { loadTheme } from "@/utils/theme";
 import LocaleSelect from "../LocaleSelect";
 import ThemeSelect from "../ThemeSelect";
 import VisibilityIcon from "../VisibilityIcon";
+import AISettingSection from "./AISettingSection";
 import SettingGroup from "./SettingGroup";
 import SettingRow from "./SettingRow";
 import SettingSection from "./SettingSection";
@@ -102,6 +103,8 @@ const PreferencesSection = () => {
         </SettingRow>
       </SettingGroup>
 
+      <AISettingSection />
+
       <SettingGroup showSeparator>
         <WebhookSection />
       </SettingGroup>
@@ -4,7 +4,7 @@ import { useInfiniteQuery, useMutation, useQuery, useQueryClient } from "@tansta
 import { memoServiceClient } from "@/connect";
 import { userKeys } from "@/hooks/useUserQueries";
 import type { ListMemosRequest, Memo } from "@/types/proto/api/v1/memo_service_pb";
-import { ListMemosRequestSchema, MemoSchema } from "@/types/proto/api/v1/memo_service_pb";
+import { ListMemosRequestSchema, MemoRelation_Type, MemoRelationSchema, MemoSchema } from "@/types/proto/api/v1/memo_service_pb";
 
 // Query keys factory for consistent cache management
 export const memoKeys = {
@@ -14,6 +14,7 @@ export const memoKeys = {
   details: () => [...memoKeys.all, "detail"] as const,
   detail: (name: string) => [...memoKeys.details(), name] as const,
   comments: (name: string) => [...memoKeys.all, "comments", name] as const,
+  related: (name: string) => [...memoKeys.all, "related", name] as const,
 };
 
 export function useMemos(request: Partial<ListMemosRequest> = {}) {
@@ -123,6 +124,22 @@ export function useUpdateMemo() {
   });
 }
 
+export function useAcceptMemoTagSuggestions() {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: async ({ name, tags }: { name: string; tags: string[] }) => {
+      const memo = await memoServiceClient.acceptMemoTagSuggestions({ name, tags });
+      return memo;
+    },
+    onSuccess: (updatedMemo) => {
+      queryClient.setQueryData(memoKeys.detail(updatedMemo.name), updatedMemo);
+      queryClient.invalidateQueries({ queryKey: memoKeys.lists() });
+      queryClient.invalidateQueries({ queryKey: userKeys.stats() });
+    },
+  });
+}
+
 export function useDeleteMemo() {
   const queryClient = useQueryClient();
 
@@ -153,3 +170,43 @@ export function useMemoComments(name: string, options?: { enabled?: boolean }) {
     staleTime: 1000 * 60, // 1 minute
   });
 }
+
+export function useRelatedMemos(name: string, options?: { enabled?: boolean }) {
+  return useQuery({
+    queryKey: memoKeys.related(name),
+    queryFn: async () => {
+      const response = await memoServiceClient.listRelatedMemos({ name });
+      return response;
+    },
+    enabled: options?.enabled ?? true,
+    staleTime: 1000 * 60, // 1 minute
+  });
+}
+
+// Links a related memo to a memo as a reference, keeping its existing references.
+export function useLinkRelatedMemo() {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: async ({ memo, relatedMemoName }: { memo: Memo; relatedMemoName: string }) => {
+      const references = memo.relations.filter((r) => r.type === MemoRelation_Type.REFERENCE && r.memo?.name === memo.name);
+      await memoServiceClient.setMemoRelations({
+        name: memo.name,
+        relations: [
+          ...references,
+          create(MemoRelationSchema, {
+            memo: { name: memo.name },
+            relatedMemo: { name: relatedMemoName },
+            type: MemoRelation_Type.REFERENCE,
+          }),
+        ],
+      });
+      return { name: memo.name, relatedMemoName };
+    },
+    onSuccess: ({ name, relatedMemoName }) => {
+      queryClient.invalidateQueries({ queryKey: memoKeys.detail(name) });
+      queryClient.invalidateQueries({ queryKey: memoKeys.detail(relatedMemoName) });
+      queryClient.invalidateQueries({ queryKey: memoKeys.related(name) });
+    },
+  });
+}
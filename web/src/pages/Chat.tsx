@@ -1,35 +1,46 @@
+import { create } from "@bufbuild/protobuf";
 import { useEffect, useRef, useState } from "react";
 import { useParams, useNavigate } from "react-router-dom";
-import { PlusIcon, SendIcon, MessageSquareIcon, TrashIcon, LinkIcon, BrainCircuitIcon, PanelLeftIcon, XIcon } from "lucide-react";
+import { PlusIcon, SendIcon, MessageSquareIcon, TrashIcon, LinkIcon, BrainCircuitIcon, PanelLeftIcon, XIcon, PencilIcon, RefreshCwIcon, ChevronLeftIcon, ChevronRightIcon, SearchIcon, BookmarkPlusIcon, SquareIcon } from "lucide-react";
 import toast from "react-hot-toast";
 
 import { Button } from "@/components/ui/button";
 import { Textarea } from "@/components/ui/textarea";
 import { Input } from "@/components/ui/input";
 import MemoContent from "@/components/MemoContent";
-import { aiService, AIChatSession, AIChatMessage } from "@/utils/aiService";
+import { aiService, aiSessionName, aiSessionUid, AISession, AIMessage, AIChatEvent, AIPendingAction, AISearchResult } from "@/utils/aiService";
 import { cn } from "@/lib/utils";
 import { MemoViewContext } from "@/components/MemoView/MemoViewContext";
 import useCurrentUser from "@/hooks/useCurrentUser";
 import type { Memo } from "@/types/proto/api/v1/memo_service_pb";
+import { AIMessage_Role, AIMessageSchema, type AIChatEvent_Source } from "@/types/proto/api/v1/ai_service_pb";
 
 const Chat = () => {
     const { uid } = useParams();
     const navigate = useNavigate();
 
-    const [sessions, setSessions] = useState<AIChatSession[]>([]);
-    const [messages, setMessages] = useState<AIChatMessage[]>([]);
+    const [sessions, setSessions] = useState<AISession[]>([]);
+    const [searchQuery, setSearchQuery] = useState("");
+    const [searchResults, setSearchResults] = useState<AISearchResult[]>([]);
+    const [messages, setMessages] = useState<AIMessage[]>([]);
     const [input, setInput] = useState("");
     const [tagFilter, setTagFilter] = useState("");
     const [isSidebarOpen, setSidebarOpen] = useState(false);
     const [isGenerating, setIsGenerating] = useState(false);
+    const [editingId, setEditingId] = useState<string | null>(null);
+    const [editText, setEditText] = useState("");
+    // Tool calls waiting for the user's approval before the agent carries on.
+    const [pendingActions, setPendingActions] = useState<AIPendingAction[]>([]);
 
     // Streaming state
     const [streamedResponse, setStreamedResponse] = useState("");
     const [activeTool, setActiveTool] = useState<{ name: string, input: string } | null>(null);
-    const [sources, setSources] = useState<{ memo_uid: string, snippet: string }[]>([]);
+    const [sources, setSources] = useState<AIChatEvent_Source[]>([]);
+    // The turn being streamed, which the user can stop.
+    const [activeTurn, setActiveTurn] = useState("");
 
     const currentUser = useCurrentUser();
+    const sessionName = uid && currentUser ? aiSessionName(currentUser.name, uid) : "";
 
     // Auth guard: redirect unauthenticated users to sign-in
     useEffect(() => {
@@ -54,7 +65,7 @@ const Chat = () => {
 
     const loadSessions = async () => {
         try {
-            const data = await aiService.listSessions();
+            const data = await aiService.listSessions(currentUser!.name);
             setSessions(data);
         } catch (e: any) {
             toast.error(e.message);
@@ -67,16 +78,42 @@ const Chat = () => {
     }, [currentUser]);
 
     useEffect(() => {
-        if (uid) {
+        if (sessionName) {
             // Load messages for session
-            aiService.loadMessages(uid).then(setMessages).catch((e: any) => toast.error(e.message));
+            aiService.loadMessages(sessionName).then(setMessages).catch((e: any) => toast.error(e.message));
+            aiService.getSession(sessionName)
+                .then(sess => {
+                    setPendingActions(sess.pendingActions);
+                    // Pick up a reply still running, e.g. after a page refresh.
+                    if (sess.runningTurn) {
+                        setIsGenerating(true);
+                        streamReply(sessionName, aiService.resumeTurn(sess.runningTurn));
+                    }
+                })
+                .catch(() => setPendingActions([]));
             setStreamedResponse("");
             setActiveTool(null);
             setSources([]);
         } else {
             setMessages([]);
+            setPendingActions([]);
         }
-    }, [uid]);
+    }, [sessionName]);
+
+    // Search as the user types, once they pause.
+    useEffect(() => {
+        const query = searchQuery.trim();
+        if (!currentUser || !query) {
+            setSearchResults([]);
+            return;
+        }
+        const timer = setTimeout(() => {
+            aiService.searchSessions(currentUser.name, query)
+                .then(response => setSearchResults(response.results))
+                .catch((e: any) => toast.error(e.message));
+        }, 300);
+        return () => clearTimeout(timer);
+    }, [searchQuery, currentUser]);
 
     useEffect(() => {
         messagesEndRef.current?.scrollIntoView({ behavior: "smooth" });
@@ -84,22 +121,22 @@ const Chat = () => {
 
     const handleCreateSession = async () => {
         try {
-            const sess = await aiService.createSession();
+            const sess = await aiService.createSession(currentUser!.name);
             setSessions([sess, ...sessions]);
-            navigate(`/chat/${sess.uid}`);
+            navigate(`/chat/${aiSessionUid(sess.name)}`);
             if (window.innerWidth < 768) setSidebarOpen(false);
         } catch (e: any) {
             toast.error(e.message);
         }
     };
 
-    const handleDeleteSession = async (e: React.MouseEvent, delUid: string) => {
+    const handleDeleteSession = async (e: React.MouseEvent, delName: string) => {
         e.stopPropagation();
         if (!confirm("Delete this chat?")) return;
         try {
-            await aiService.deleteSession(delUid);
-            setSessions(sessions.filter(s => s.uid !== delUid));
-            if (uid === delUid) {
+            await aiService.deleteSession(delName);
+            setSessions(sessions.filter(s => s.name !== delName));
+            if (sessionName === delName) {
                 navigate("/chat");
             }
         } catch (e: any) {
@@ -107,20 +144,83 @@ const Chat = () => {
         }
     };
 
+    // streamReply shows a streamed reply, then reloads the active branch so
+    // every message has its server name for editing and regenerating.
+    const streamReply = async (name: string, events: AsyncIterable<AIChatEvent>) => {
+        try {
+            setStreamedResponse("");
+            setSources([]);
+            setActiveTool(null);
+            setPendingActions([]);
+
+            let contentAcc = "";
+
+            for await (const { event } of events) {
+                switch (event.case) {
+                    case "turn":
+                        setActiveTurn(event.value);
+                        break;
+                    case "token":
+                        contentAcc += event.value;
+                        setStreamedResponse(contentAcc);
+                        break;
+                    case "toolCall":
+                        setActiveTool({ name: event.value.name, input: event.value.arguments });
+                        break;
+                    case "source": {
+                        const source = event.value;
+                        setSources(prev => [...prev, source]);
+                        break;
+                    }
+                    case "confirmationRequired": {
+                        const action = event.value;
+                        setPendingActions(prev => [...prev, action]);
+                        break;
+                    }
+                    case "error":
+                        toast.error("AI Error: " + event.value);
+                        break;
+                    case "cancelled":
+                        toast("Stopped");
+                        break;
+                }
+            }
+
+            setMessages(await aiService.loadMessages(name));
+            setStreamedResponse("");
+            setActiveTool(null);
+            loadSessions(); // Reload titles (might have auto-titled)
+        } catch (e: any) {
+            toast.error(e.message);
+        } finally {
+            setActiveTurn("");
+            setIsGenerating(false);
+        }
+    };
+
+    const handleStop = async () => {
+        if (!activeTurn) return;
+        try {
+            await aiService.cancelTurn(activeTurn);
+        } catch (e: any) {
+            toast.error(e.message);
+        }
+    };
+
     const handleSend = async () => {
         if (!input.trim() || isGenerating) return;
         const txt = input.trim();
         setInput("");
         setIsGenerating(true);
 
-        let currentSessionUid = uid;
-        if (!currentSessionUid) {
+        let currentSessionName = sessionName;
+        if (!currentSessionName) {
             try {
-                const sess = await aiService.createSession();
+                const sess = await aiService.createSession(currentUser!.name);
                 setSessions([sess, ...sessions]);
-                currentSessionUid = sess.uid;
+                currentSessionName = sess.name;
                 // Don't navigate yet, otherwise effect will clear things
-                window.history.pushState({}, "", `/chat/${currentSessionUid}`);
+                window.history.pushState({}, "", `/chat/${aiSessionUid(currentSessionName)}`);
             } catch (e: any) {
                 toast.error(e.message);
                 setIsGenerating(false);
@@ -129,53 +229,102 @@ const Chat = () => {
         }
 
         // Optimistically add user message
-        setMessages(prev => [...prev, {
-            id: Date.now(),
-            role: "user",
+        setMessages(prev => [...prev, create(AIMessageSchema, {
+            name: `pending-${Date.now()}`,
+            role: AIMessage_Role.USER,
             content: txt,
-            createdTs: Date.now() / 1000,
-        }]);
+        })]);
 
-        try {
-            setStreamedResponse("");
-            setSources([]);
-            setActiveTool(null);
+        await streamReply(currentSessionName, aiService.chat(currentSessionName, txt, tagFilter));
+    };
 
-            const gen = aiService.chat(currentSessionUid, txt, tagFilter);
-            let contentAcc = "";
+    // Editing a message sends the new text as a sibling, starting a new branch.
+    const handleEdit = async (m: AIMessage) => {
+        const txt = editText.trim();
+        if (!sessionName || !txt || isGenerating) return;
+        setEditingId(null);
+        setIsGenerating(true);
+        setMessages(prev => [...prev.slice(0, prev.findIndex(p => p.name === m.name)), { ...m, name: `pending-${Date.now()}`, content: txt }]);
+        await streamReply(sessionName, aiService.chat(sessionName, txt, tagFilter, m.parentMessage));
+    };
 
-            for await (const event of gen) {
-                if (event.type === "token" && event.content) {
-                    contentAcc += event.content;
-                    setStreamedResponse(contentAcc);
-                } else if (event.type === "tool_call" && event.payload) {
-                    setActiveTool({ name: event.payload.name, input: event.payload.input });
-                } else if (event.type === "source" && event.payload) {
-                    setSources(prev => [...prev, event.payload as any]);
-                } else if (event.type === "error" && event.content) {
-                    toast.error("AI Error: " + event.content);
-                } else if (event.type === "done") {
-                    // done
-                }
-            }
+    const handleRegenerate = async (m: AIMessage) => {
+        if (!sessionName || isGenerating) return;
+        setIsGenerating(true);
+        // Keep everything up to the user message being answered again.
+        setMessages(prev => {
+            const at = prev.findIndex(p => p.name === m.name);
+            let userAt = at;
+            while (userAt >= 0 && prev[userAt].role !== AIMessage_Role.USER) userAt--;
+            return prev.slice(0, userAt + 1);
+        });
+        await streamReply(sessionName, aiService.regenerate(m.name, tagFilter));
+    };
 
-            // Finalise message
-            setMessages(prev => [...prev, {
-                id: Date.now(),
-                role: "assistant",
-                content: contentAcc,
-                createdTs: Date.now() / 1000,
-            }]);
-            setStreamedResponse("");
-            setActiveTool(null);
-            loadSessions(); // Reload titles (might have auto-titled)
+    const handleConfirmAction = async (action: AIPendingAction, approved: boolean) => {
+        if (!sessionName || isGenerating) return;
+        setIsGenerating(true);
+        await streamReply(sessionName, aiService.confirmAction(sessionName, action.toolCallId, approved));
+    };
+
+    const handleSwitchBranch = async (message: string) => {
+        if (!sessionName || isGenerating) return;
+        try {
+            await aiService.switchBranch(sessionName, message);
+            setMessages(await aiService.loadMessages(sessionName));
         } catch (e: any) {
             toast.error(e.message);
-        } finally {
-            setIsGenerating(false);
         }
     };
 
+    // handleSaveAsMemo saves an answer, or the whole session when m is omitted.
+    const handleSaveAsMemo = async (m?: AIMessage) => {
+        if (!sessionName) return;
+        try {
+            const memo = await aiService.saveAsMemo(sessionName, m?.name);
+            toast.success(
+                <span>
+                    Saved as <a href={`/${memo.name}`} className="underline">memo</a>
+                </span>,
+            );
+        } catch (e: any) {
+            toast.error(e.message);
+        }
+    };
+
+    // branchSwitcher lets the user page through the alternatives of a message.
+    const branchSwitcher = (m: AIMessage) => {
+        const siblings = m.siblingMessages;
+        if (siblings.length < 2) return null;
+        const at = siblings.indexOf(m.name);
+        return (
+            <div className="flex items-center gap-1 text-xs text-muted-foreground">
+                <button className="disabled:opacity-40" disabled={at <= 0 || isGenerating} onClick={() => handleSwitchBranch(siblings[at - 1])} aria-label="Previous version">
+                    <ChevronLeftIcon className="w-3 h-3" />
+                </button>
+                {at + 1}/{siblings.length}
+                <button className="disabled:opacity-40" disabled={at >= siblings.length - 1 || isGenerating} onClick={() => handleSwitchBranch(siblings[at + 1])} aria-label="Next version">
+                    <ChevronRightIcon className="w-3 h-3" />
+                </button>
+            </div>
+        );
+    };
+
+    // highlightedSnippet marks the query words the server found in a result's
+    // snippet; highlights are in code points.
+    const highlightedSnippet = (result: AISearchResult) => {
+        const chars = Array.from(result.snippet);
+        const parts: React.ReactNode[] = [];
+        let at = 0;
+        result.highlights.forEach((h, i) => {
+            parts.push(chars.slice(at, h.start).join(""));
+            parts.push(<mark key={i} className="bg-yellow-200 dark:bg-yellow-800 text-inherit rounded-sm">{chars.slice(h.start, h.end).join("")}</mark>);
+            at = h.end;
+        });
+        parts.push(chars.slice(at).join(""));
+        return parts;
+    };
+
     // Don't render until user is confirmed (auth guard)
     if (!currentUser) {
         return null;
@@ -206,8 +355,13 @@ const Chat = () => {
                         <PanelLeftIcon className="w-5 h-5" />
                     </Button>
                     <h1 className="text-base font-medium opacity-80 flex-1 truncate">
-                        {uid ? (sessions.find(s => s.uid === uid)?.title || "New Chat") : "AI Chat"}
+                        {sessionName ? (sessions.find(s => s.name === sessionName)?.title || "New Chat") : "AI Chat"}
                     </h1>
+                    {sessionName && messages.length > 0 && (
+                        <Button variant="ghost" size="icon" disabled={isGenerating} onClick={() => handleSaveAsMemo()} title="Save chat as memo" aria-label="Save chat as memo">
+                            <BookmarkPlusIcon className="w-4 h-4" />
+                        </Button>
+                    )}
                     <Button variant="ghost" size="icon" className="md:hidden" onClick={() => navigate("/")}>
                         <XIcon className="w-4 h-4" />
                     </Button>
@@ -230,21 +384,45 @@ const Chat = () => {
                             <XIcon className="w-4 h-4" />
                         </Button>
                     </div>
+                    <div className="px-2 pt-2">
+                        <div className="relative">
+                            <SearchIcon className="w-3.5 h-3.5 absolute left-2.5 top-1/2 -translate-y-1/2 opacity-50" />
+                            <Input
+                                value={searchQuery}
+                                onChange={e => setSearchQuery(e.target.value)}
+                                placeholder="Search chats"
+                                className="h-8 pl-8 text-sm"
+                            />
+                        </div>
+                    </div>
                     <div className="flex-1 overflow-y-auto p-2 space-y-1">
-                        {sessions.map(s => (
+                        {searchQuery.trim() && searchResults.length === 0 && (
+                            <p className="p-2 text-xs text-muted-foreground">No matching chats.</p>
+                        )}
+                        {searchQuery.trim() && searchResults.map(result => (
+                            <div
+                                key={result.message || result.aiSession}
+                                onClick={() => { navigate(`/chat/${aiSessionUid(result.aiSession)}`); if (window.innerWidth < 768) setSidebarOpen(false); }}
+                                className="flex flex-col gap-0.5 p-2 rounded-lg cursor-pointer hover:bg-muted transition-colors"
+                            >
+                                <span className="truncate text-sm font-medium">{result.title || "New Chat"}</span>
+                                <span className="text-xs text-muted-foreground line-clamp-2">{highlightedSnippet(result)}</span>
+                            </div>
+                        ))}
+                        {!searchQuery.trim() && sessions.map(s => (
                             <div
-                                key={s.uid}
-                                onClick={() => { navigate(`/chat/${s.uid}`); if (window.innerWidth < 768) setSidebarOpen(false); }}
+                                key={s.name}
+                                onClick={() => { navigate(`/chat/${aiSessionUid(s.name)}`); if (window.innerWidth < 768) setSidebarOpen(false); }}
                                 className={cn(
                                     "group flex items-center justify-between p-2 rounded-lg cursor-pointer hover:bg-muted transition-colors",
-                                    uid === s.uid && "bg-muted font-medium"
+                                    sessionName === s.name && "bg-muted font-medium"
                                 )}
                             >
                                 <div className="flex items-center gap-2 truncate text-sm">
                                     <MessageSquareIcon className="w-4 h-4 shrink-0 opacity-70" />
                                     <span className="truncate">{s.title || "New Chat"}</span>
                                 </div>
-                                <button onClick={(e) => handleDeleteSession(e, s.uid)} className="opacity-0 group-hover:opacity-100 p-1 hover:text-red-500 rounded transition-opacity shrink-0">
+                                <button onClick={(e) => handleDeleteSession(e, s.name)} className="opacity-0 group-hover:opacity-100 p-1 hover:text-red-500 rounded transition-opacity shrink-0">
                                     <TrashIcon className="w-3.5 h-3.5" />
                                 </button>
                             </div>
@@ -265,12 +443,48 @@ const Chat = () => {
                             </div>
                         )}
 
-                        {messages.map((m) => (
-                            <div key={m.id} className={cn("flex w-full", m.role === "user" ? "justify-end" : "justify-start")}>
+                        {messages.map((m) => m.role === AIMessage_Role.TOOL ? (
+                            <details key={m.name} className="w-full max-w-[90%] sm:max-w-[85%] text-xs text-muted-foreground">
+                                <summary className="cursor-pointer w-fit flex items-center gap-2 bg-muted p-2 rounded">
+                                    <BrainCircuitIcon className="w-3 h-3" />
+                                    Result of {m.toolName || "tool"}
+                                </summary>
+                                <div className="whitespace-pre-wrap mt-1 p-2 border border-border rounded">{m.content}</div>
+                            </details>
+                        ) : m.role === AIMessage_Role.ASSISTANT && m.toolCalls.length > 0 && !m.content ? (
+                            <div key={m.name} className="flex flex-wrap gap-2">
+                                {m.toolCalls.map((tc) => (
+                                    <div key={tc.id} title={tc.arguments} className="text-xs flex items-center gap-2 text-muted-foreground bg-muted p-2 rounded w-fit">
+                                        <BrainCircuitIcon className="w-3 h-3" />
+                                        Used {tc.name}
+                                    </div>
+                                ))}
+                            </div>
+                        ) : editingId === m.name ? (
+                            <div key={m.name} className="flex w-full justify-end">
+                                <div className="w-full max-w-[90%] sm:max-w-[85%] flex flex-col gap-2">
+                                    <Textarea
+                                        value={editText}
+                                        onChange={(e) => setEditText(e.target.value)}
+                                        className="min-h-[60px] resize-none"
+                                        autoFocus
+                                    />
+                                    <div className="flex justify-end gap-2">
+                                        <Button variant="ghost" size="sm" onClick={() => setEditingId(null)}>Cancel</Button>
+                                        <Button size="sm" disabled={!editText.trim() || isGenerating} onClick={() => handleEdit(m)}>Send</Button>
+                                    </div>
+                                </div>
+                            </div>
+                        ) : (
+                            <div
+                                key={m.name}
+                                className={cn("group flex flex-col w-full gap-1", m.role === AIMessage_Role.USER ? "items-end" : "items-start", m.compacted && "opacity-60")}
+                                title={m.compacted ? "Summarized for the assistant" : undefined}
+                            >
                                 <div className={cn("max-w-[90%] sm:max-w-[85%] rounded-2xl p-3 sm:p-4 shadow-sm overflow-x-auto",
-                                    m.role === "user" ? "bg-primary text-primary-foreground" : "bg-card border border-border text-card-foreground"
+                                    m.role === AIMessage_Role.USER ? "bg-primary text-primary-foreground" : "bg-card border border-border text-card-foreground"
                                 )}>
-                                    {m.role === "assistant" ? (
+                                    {m.role === AIMessage_Role.ASSISTANT ? (
                                         <MemoViewContext.Provider value={mockMemoContextValue}>
                                             <MemoContent content={m.content} />
                                         </MemoViewContext.Provider>
@@ -278,6 +492,37 @@ const Chat = () => {
                                         <div className="whitespace-pre-wrap text-sm sm:text-base">{m.content}</div>
                                     )}
                                 </div>
+                                {!isGenerating && (
+                                    <div className="flex items-center gap-2">
+                                        {branchSwitcher(m)}
+                                        {m.role === AIMessage_Role.USER ? (
+                                            <button
+                                                className="opacity-0 group-hover:opacity-100 text-muted-foreground hover:text-foreground transition-opacity"
+                                                onClick={() => { setEditingId(m.name); setEditText(m.content); }}
+                                                aria-label="Edit message"
+                                            >
+                                                <PencilIcon className="w-3 h-3" />
+                                            </button>
+                                        ) : (
+                                            <>
+                                                <button
+                                                    className="opacity-0 group-hover:opacity-100 text-muted-foreground hover:text-foreground transition-opacity"
+                                                    onClick={() => handleRegenerate(m)}
+                                                    aria-label="Regenerate reply"
+                                                >
+                                                    <RefreshCwIcon className="w-3 h-3" />
+                                                </button>
+                                                <button
+                                                    className="opacity-0 group-hover:opacity-100 text-muted-foreground hover:text-foreground transition-opacity"
+                                                    onClick={() => handleSaveAsMemo(m)}
+                                                    aria-label="Save answer as memo"
+                                                >
+                                                    <BookmarkPlusIcon className="w-3 h-3" />
+                                                </button>
+                                            </>
+                                        )}
+                                    </div>
+                                )}
                             </div>
                         ))}
 
@@ -293,8 +538,9 @@ const Chat = () => {
                                     {sources.length > 0 && (
                                         <div className="flex flex-wrap gap-2 mb-2">
                                             {sources.map((s, i) => (
-                                                <a key={i} href={`/memos/${s.memo_uid}`} target="_blank" className="text-xs flex items-center gap-1 bg-muted text-foreground px-2 py-1 rounded-full hover:underline border border-border">
-                                                    <LinkIcon className="w-3 h-3" /> Memo {s.memo_uid}
+                                                <a key={i} href={`/${s.memo}`} target="_blank" title={s.snippet} className="text-xs flex items-center gap-1 bg-muted text-foreground px-2 py-1 rounded-full hover:underline border border-border">
+                                                    <LinkIcon className="w-3 h-3" /> Memo {s.memo.split("/").pop()}
+                                                    {s.filename && <span className="text-muted-foreground">· {s.filename}</span>}
                                                 </a>
                                             ))}
                                         </div>
@@ -306,6 +552,33 @@ const Chat = () => {
                             </div>
                         )}
 
+                        {!isGenerating && pendingActions.map((action) => (
+                            <div key={action.toolCallId} className="w-full max-w-[90%] sm:max-w-[85%] rounded-2xl p-3 sm:p-4 bg-card border border-border text-card-foreground flex flex-col gap-2">
+                                <div className="text-sm font-medium flex items-center gap-2">
+                                    <BrainCircuitIcon className="w-4 h-4" />
+                                    The assistant wants to run {action.toolName}
+                                    {action.memo && (
+                                        <a href={`/${action.memo}`} target="_blank" className="text-xs text-muted-foreground hover:underline">
+                                            Memo {action.memo.split("/").pop()}
+                                        </a>
+                                    )}
+                                </div>
+                                <pre className="text-xs whitespace-pre-wrap bg-muted p-2 rounded max-h-64 overflow-auto">
+                                    {action.diff
+                                        ? action.diff.split("\n").map((line, i) => (
+                                            <div key={i} className={cn(line.startsWith("+") && !line.startsWith("+++") && "text-green-600", line.startsWith("-") && !line.startsWith("---") && "text-red-600")}>
+                                                {line}
+                                            </div>
+                                        ))
+                                        : action.input}
+                                </pre>
+                                <div className="flex justify-end gap-2">
+                                    <Button variant="ghost" size="sm" onClick={() => handleConfirmAction(action, false)}>Reject</Button>
+                                    <Button size="sm" onClick={() => handleConfirmAction(action, true)}>Approve</Button>
+                                </div>
+                            </div>
+                        ))}
+
                         <div ref={messagesEndRef} />
                     </div>
 
@@ -337,15 +610,28 @@ const Chat = () => {
                                         disabled={isGenerating}
                                         rows={1}
                                     />
-                                    <Button
-                                        onClick={handleSend}
-                                        disabled={!input.trim() || isGenerating}
-                                        size="icon"
-                                        className="h-10 w-10 shrink-0"
-                                        aria-label="Send message"
-                                    >
-                                        <SendIcon className="w-4 h-4" />
-                                    </Button>
+                                    {isGenerating ? (
+                                        <Button
+                                            onClick={handleStop}
+                                            disabled={!activeTurn}
+                                            variant="outline"
+                                            size="icon"
+                                            className="h-10 w-10 shrink-0"
+                                            aria-label="Stop generating"
+                                        >
+                                            <SquareIcon className="w-4 h-4" />
+                                        </Button>
+                                    ) : (
+                                        <Button
+                                            onClick={handleSend}
+                                            disabled={!input.trim()}
+                                            size="icon"
+                                            className="h-10 w-10 shrink-0"
+                                            aria-label="Send message"
+                                        >
+                                            <SendIcon className="w-4 h-4" />
+                                        </Button>
+                                    )}
                                 </div>
                             </div>
                             <p className="text-center text-xs text-muted-foreground pb-1 max-w-4xl mx-auto">
@@ -0,0 +1,1596 @@
+// @generated by protoc-gen-es v2.11.0 with parameter "target=ts"
+// @generated from file api/v1/ai_service.proto (package memos.api.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { MemoSchema, Visibility } from "./memo_service_pb";
+import { file_api_v1_memo_service } from "./memo_service_pb";
+import { file_google_api_annotations } from "../../google/api/annotations_pb";
+import { file_google_api_client } from "../../google/api/client_pb";
+import { file_google_api_field_behavior } from "../../google/api/field_behavior_pb";
+import { file_google_api_resource } from "../../google/api/resource_pb";
+import type { EmptySchema, FieldMask, Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file api/v1/ai_service.proto.
+ */
+export const file_api_v1_ai_service: GenFile = /*@__PURE__*/
+  fileDesc("ChdhcGkvdjEvYWlfc2VydmljZS5wcm90bxIMbWVtb3MuYXBpLnYxItYCCglBSVNlc3Npb24SEQoEbmFtZRgBIAEoCUID4EEIEhIKBXRpdGxlGAIgASgJQgPgQQESNAoLY3JlYXRlX3RpbWUYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSNAoLdXBkYXRlX3RpbWUYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSOwoPcGVuZGluZ19hY3Rpb25zGAUgAygLMh0ubWVtb3MuYXBpLnYxLkFJUGVuZGluZ0FjdGlvbkID4EEDEhkKDHJ1bm5pbmdfdHVybhgGIAEoCUID4EEDOl7qQVsKFm1lbW9zLmFwaS52MS9BSVNlc3Npb24SJHVzZXJzL3t1c2VyfS9haVNlc3Npb25zL3thaV9zZXNzaW9ufRoEbmFtZSoKYWlTZXNzaW9uczIJYWlTZXNzaW9uInYKD0FJUGVuZGluZ0FjdGlvbhIUCgx0b29sX2NhbGxfaWQYASABKAkSEQoJdG9vbF9uYW1lGAIgASgJEg0KBWlucHV0GAMgASgJEg8KB21lc3NhZ2UYBCABKAkSDAoEbWVtbxgFIAEoCRIMCgRkaWZmGAYgASgJIsoECglBSU1lc3NhZ2USEQoEbmFtZRgBIAEoCUID4EEIEhsKDnBhcmVudF9tZXNzYWdlGAIgASgJQgPgQQMSHQoQc2libGluZ19tZXNzYWdlcxgDIAMoCUID4EEDEi8KBHJvbGUYBCABKA4yHC5tZW1vcy5hcGkudjEuQUlNZXNzYWdlLlJvbGVCA+BBAxIUCgdjb250ZW50GAUgASgJQgPgQQMSFgoJdG9vbF9uYW1lGAYgASgJQgPgQQMSGQoMdG9vbF9jYWxsX2lkGAcgASgJQgPgQQMSOQoKdG9vbF9jYWxscxgIIAMoCzIgLm1lbW9zLmFwaS52MS5BSU1lc3NhZ2UuVG9vbENhbGxCA+BBAxIWCgljb21wYWN0ZWQYCSABKAhCA+BBAxI0CgtjcmVhdGVfdGltZRgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxo3CghUb29sQ2FsbBIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhEKCWFyZ3VtZW50cxgDIAEoCSI/CgRSb2xlEhQKEFJPTEVfVU5TUEVDSUZJRUQQABIICgRVU0VSEAESDQoJQVNTSVNUQU5UEAISCAoEVE9PTBADOnHqQW4KFm1lbW9zLmFwaS52MS9BSU1lc3NhZ2USN3VzZXJzL3t1c2VyfS9haVNlc3Npb25zL3thaV9zZXNzaW9ufS9tZXNzYWdlcy97bWVzc2FnZX0aBG5hbWUqCmFpTWVzc2FnZXMyCWFpTWVzc2FnZSKfAQoIQUlCcmFuY2gSFAoMbGVhZl9tZXNzYWdlGAEgASgJEhQKDGZvcmtfbWVzc2FnZRgCIAEoCRIVCg1tZXNzYWdlX2NvdW50GAMgASgFEg8KB3ByZXZpZXcYBCABKAkSDgoGYWN0aXZlGAUgASgIEi8KC3VwZGF0ZV90aW1lGAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCLzAgoLQUlDaGF0RXZlbnQSDwoFdG9rZW4YASABKAlIABI1Cgl0b29sX2NhbGwYAiABKAsyIC5tZW1vcy5hcGkudjEuQUlNZXNzYWdlLlRvb2xDYWxsSAASMgoGc291cmNlGAMgASgLMiAubWVtb3MuYXBpLnYxLkFJQ2hhdEV2ZW50LlNvdXJjZUgAEj4KFWNvbmZpcm1hdGlvbl9yZXF1aXJlZBgEIAEoCzIdLm1lbW9zLmFwaS52MS5BSVBlbmRpbmdBY3Rpb25IABIPCgVlcnJvchgFIAEoCUgAEg4KBHR1cm4YBiABKAlIABITCgljYW5jZWxsZWQYByABKAhIABppCgZTb3VyY2USDAoEbWVtbxgBIAEoCRIPCgdzbmlwcGV0GAIgASgJEg0KBXN0YXJ0GAMgASgFEgsKA2VuZBgEIAEoBRISCgphdHRhY2htZW50GAUgASgJEhAKCGZpbGVuYW1lGAYgASgJQgcKBWV2ZW50IngKFUxpc3RBSVNlc3Npb25zUmVxdWVzdBIuCgZwYXJlbnQYASABKAlCHuBBAvpBGBIWbWVtb3MuYXBpLnYxL0FJU2Vzc2lvbhIWCglwYWdlX3NpemUYAiABKAVCA+BBARIXCgpwYWdlX3Rva2VuGAMgASgJQgPgQQEiXwoWTGlzdEFJU2Vzc2lvbnNSZXNwb25zZRIsCgthaV9zZXNzaW9ucxgBIAMoCzIXLm1lbW9zLmFwaS52MS5BSVNlc3Npb24SFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJIo4BChdTZWFyY2hBSVNlc3Npb25zUmVxdWVzdBIuCgZwYXJlbnQYASABKAlCHuBBAvpBGBIWbWVtb3MuYXBpLnYxL0FJU2Vzc2lvbhISCgVxdWVyeRgCIAEoCUID4EECEhYKCXBhZ2Vfc2l6ZRgDIAEoBUID4EEBEhcKCnBhZ2VfdG9rZW4YBCABKAlCA+BBASKPAwoYU2VhcmNoQUlTZXNzaW9uc1Jlc3BvbnNlEj4KB3Jlc3VsdHMYASADKAsyLS5tZW1vcy5hcGkudjEuU2VhcmNoQUlTZXNzaW9uc1Jlc3BvbnNlLlJlc3VsdBIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAka8AEKBlJlc3VsdBISCgphaV9zZXNzaW9uGAEgASgJEg0KBXRpdGxlGAIgASgJEg8KB21lc3NhZ2UYAyABKAkSKgoEcm9sZRgEIAEoDjIcLm1lbW9zLmFwaS52MS5BSU1lc3NhZ2UuUm9sZRIPCgdzbmlwcGV0GAUgASgJEkQKCmhpZ2hsaWdodHMYBiADKAsyMC5tZW1vcy5hcGkudjEuU2VhcmNoQUlTZXNzaW9uc1Jlc3BvbnNlLkhpZ2hsaWdodBIvCgtjcmVhdGVfdGltZRgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAaJwoJSGlnaGxpZ2h0Eg0KBXN0YXJ0GAEgASgFEgsKA2VuZBgCIAEoBSJDChNHZXRBSVNlc3Npb25SZXF1ZXN0EiwKBG5hbWUYASABKAlCHuBBAvpBGAoWbWVtb3MuYXBpLnYxL0FJU2Vzc2lvbiJ6ChZDcmVhdGVBSVNlc3Npb25SZXF1ZXN0Ei4KBnBhcmVudBgBIAEoCUIe4EEC+kEYEhZtZW1vcy5hcGkudjEvQUlTZXNzaW9uEjAKCmFpX3Nlc3Npb24YAiABKAsyFy5tZW1vcy5hcGkudjEuQUlTZXNzaW9uQgPgQQEigAEKFlVwZGF0ZUFJU2Vzc2lvblJlcXVlc3QSMAoKYWlfc2Vzc2lvbhgBIAEoCzIXLm1lbW9zLmFwaS52MS5BSVNlc3Npb25CA+BBAhI0Cgt1cGRhdGVfbWFzaxgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2tCA+BBAiJGChZEZWxldGVBSVNlc3Npb25SZXF1ZXN0EiwKBG5hbWUYASABKAlCHuBBAvpBGAoWbWVtb3MuYXBpLnYxL0FJU2Vzc2lvbiJ4ChVMaXN0QUlNZXNzYWdlc1JlcXVlc3QSLgoGcGFyZW50GAEgASgJQh7gQQL6QRgSFm1lbW9zLmFwaS52MS9BSU1lc3NhZ2USFgoJcGFnZV9zaXplGAIgASgFQgPgQQESFwoKcGFnZV90b2tlbhgDIAEoCUID4EEBIl8KFkxpc3RBSU1lc3NhZ2VzUmVzcG9uc2USLAoLYWlfbWVzc2FnZXMYASADKAsyFy5tZW1vcy5hcGkudjEuQUlNZXNzYWdlEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSJHChVMaXN0QUlCcmFuY2hlc1JlcXVlc3QSLgoGcGFyZW50GAEgASgJQh7gQQL6QRgKFm1lbW9zLmFwaS52MS9BSVNlc3Npb24iQgoWTGlzdEFJQnJhbmNoZXNSZXNwb25zZRIoCghicmFuY2hlcxgBIAMoCzIWLm1lbW9zLmFwaS52MS5BSUJyYW5jaCJ2ChVTd2l0Y2hBSUJyYW5jaFJlcXVlc3QSLAoEbmFtZRgBIAEoCUIe4EEC+kEYChZtZW1vcy5hcGkudjEvQUlTZXNzaW9uEi8KB21lc3NhZ2UYAiABKAlCHuBBAvpBGAoWbWVtb3MuYXBpLnYxL0FJTWVzc2FnZSKzAQoLQ2hhdFJlcXVlc3QSLAoEbmFtZRgBIAEoCUIe4EEC+kEYChZtZW1vcy5hcGkudjEvQUlTZXNzaW9uEhQKB2NvbnRlbnQYAiABKAlCA+BBAhIXCgp0YWdfZmlsdGVyGAMgASgJQgPgQQESIAoOcGFyZW50X21lc3NhZ2UYBCABKAlCA+BBAUgAiAEBEhIKBW1vZGVsGAUgASgJQgPgQQFCEQoPX3BhcmVudF9tZXNzYWdlIncKGlJlZ2VuZXJhdGVBSU1lc3NhZ2VSZXF1ZXN0EiwKBG5hbWUYASABKAlCHuBBAvpBGAoWbWVtb3MuYXBpLnYxL0FJTWVzc2FnZRIXCgp0YWdfZmlsdGVyGAIgASgJQgPgQQESEgoFbW9kZWwYAyABKAlCA+BBASJzChZDb25maXJtQUlBY3Rpb25SZXF1ZXN0EiwKBG5hbWUYASABKAlCHuBBAvpBGAoWbWVtb3MuYXBpLnYxL0FJU2Vzc2lvbhIZCgx0b29sX2NhbGxfaWQYAiABKAlCA+BBAhIQCghhcHByb3ZlZBgDIAEoCCIoChNDYW5jZWxBSVR1cm5SZXF1ZXN0EhEKBG5hbWUYASABKAlCA+BBAiI9ChNSZXN1bWVBSVR1cm5SZXF1ZXN0EhEKBG5hbWUYASABKAlCA+BBAhITCgZvZmZzZXQYAiABKAVCA+BBASKlAQoaU2F2ZUFJU2Vzc2lvbkFzTWVtb1JlcXVlc3QSLAoEbmFtZRgBIAEoCUIe4EEC+kEYChZtZW1vcy5hcGkudjEvQUlTZXNzaW9uEhQKB21lc3NhZ2UYAiABKAlCA+BBARIQCgN0YWcYAyABKAlCA+BBARIxCgp2aXNpYmlsaXR5GAQgASgOMhgubWVtb3MuYXBpLnYxLlZpc2liaWxpdHlCA+BBASL8AQoIQUlNZW1vcnkSEQoEbmFtZRgBIAEoCUID4EEIEhQKB2NvbnRlbnQYAiABKAlCA+BBAhI0CgtjcmVhdGVfdGltZRgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxI0Cgt1cGRhdGVfdGltZRgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAzpb6kFYChVtZW1vcy5hcGkudjEvQUlNZW1vcnkSI3VzZXJzL3t1c2VyfS9haU1lbW9yaWVzL3thaV9tZW1vcnl9GgRuYW1lKgphaU1lbW9yaWVzMghhaU1lbW9yeSJGChVMaXN0QUlNZW1vcmllc1JlcXVlc3QSLQoGcGFyZW50GAEgASgJQh3gQQL6QRcSFW1lbW9zLmFwaS52MS9BSU1lbW9yeSJFChZMaXN0QUlNZW1vcmllc1Jlc3BvbnNlEisKC2FpX21lbW9yaWVzGAEgAygLMhYubWVtb3MuYXBpLnYxLkFJTWVtb3J5In0KFVVwZGF0ZUFJTWVtb3J5UmVxdWVzdBIuCglhaV9tZW1vcnkYASABKAsyFi5tZW1vcy5hcGkudjEuQUlNZW1vcnlCA+BBAhI0Cgt1cGRhdGVfbWFzaxgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2tCA+BBAiJEChVEZWxldGVBSU1lbW9yeVJlcXVlc3QSKwoEbmFtZRgBIAEoCUId4EEC+kEXChVtZW1vcy5hcGkudjEvQUlNZW1vcnkiRQoZR2VuZXJhdGVDb21wbGV0aW9uUmVxdWVzdBITCgZwcm9tcHQYASABKAlCA+BBAhITCgZzeXN0ZW0YAiABKAlCA+BBASItChpHZW5lcmF0ZUNvbXBsZXRpb25SZXNwb25zZRIPCgdjb250ZW50GAEgASgJIicKEUdldEFJVXNhZ2VSZXF1ZXN0EhIKBW1vbnRoGAEgASgJQgPgQQEiqwIKB0FJVXNhZ2USLgoKc3RhcnRfdGltZRgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLAoIZW5kX3RpbWUYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KBXVzZXJzGAMgAygLMh8ubWVtb3MuYXBpLnYxLkFJVXNhZ2UuVXNlclVzYWdlGpEBCglVc2VyVXNhZ2USDAoEdXNlchgBIAEoCRIQCgh1c2VybmFtZRgCIAEoCRINCgVjYWxscxgDIAEoAxIVCg1wcm9tcHRfdG9rZW5zGAQgASgDEhkKEWNvbXBsZXRpb25fdG9rZW5zGAUgASgDEhQKDHRvdGFsX3Rva2VucxgGIAEoAxINCgVxdW90YRgHIAEoAzLQFwoJQUlTZXJ2aWNlEpEBCg5MaXN0QUlTZXNzaW9ucxIjLm1lbW9zLmFwaS52MS5MaXN0QUlTZXNzaW9uc1JlcXVlc3QaJC5tZW1vcy5hcGkudjEuTGlzdEFJU2Vzc2lvbnNSZXNwb25zZSI02kEGcGFyZW50gtPkkwIlEiMvYXBpL3YxL3twYXJlbnQ9dXNlcnMvKn0vYWlTZXNzaW9ucxKkAQoQU2VhcmNoQUlTZXNzaW9ucxIlLm1lbW9zLmFwaS52MS5TZWFyY2hBSVNlc3Npb25zUmVxdWVzdBomLm1lbW9zLmFwaS52MS5TZWFyY2hBSVNlc3Npb25zUmVzcG9uc2UiQdpBDHBhcmVudCxxdWVyeYLT5JMCLBIqL2FwaS92MS97cGFyZW50PXVzZXJzLyp9L2FpU2Vzc2lvbnM6c2VhcmNoEn4KDEdldEFJU2Vzc2lvbhIhLm1lbW9zLmFwaS52MS5HZXRBSVNlc3Npb25SZXF1ZXN0GhcubWVtb3MuYXBpLnYxLkFJU2Vzc2lvbiIy2kEEbmFtZYLT5JMCJRIjL2FwaS92MS97bmFtZT11c2Vycy8qL2FpU2Vzc2lvbnMvKn0SnQEKD0NyZWF0ZUFJU2Vzc2lvbhIkLm1lbW9zLmFwaS52MS5DcmVhdGVBSVNlc3Npb25SZXF1ZXN0GhcubWVtb3MuYXBpLnYxLkFJU2Vzc2lvbiJL2kERcGFyZW50LGFpX3Nlc3Npb26C0+STAjE6CmFpX3Nlc3Npb24iIy9hcGkvdjEve3BhcmVudD11c2Vycy8qfS9haVNlc3Npb25zEq0BCg9VcGRhdGVBSVNlc3Npb24SJC5tZW1vcy5hcGkudjEuVXBkYXRlQUlTZXNzaW9uUmVxdWVzdBoXLm1lbW9zLmFwaS52MS5BSVNlc3Npb24iW9pBFmFpX3Nlc3Npb24sdXBkYXRlX21hc2uC0+STAjw6CmFpX3Nlc3Npb24yLi9hcGkvdjEve2FpX3Nlc3Npb24ubmFtZT11c2Vycy8qL2FpU2Vzc2lvbnMvKn0SgwEKD0RlbGV0ZUFJU2Vzc2lvbhIkLm1lbW9zLmFwaS52MS5EZWxldGVBSVNlc3Npb25SZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IjLaQQRuYW1lgtPkkwIlKiMvYXBpL3YxL3tuYW1lPXVzZXJzLyovYWlTZXNzaW9ucy8qfRKcAQoOTGlzdEFJTWVzc2FnZXMSIy5tZW1vcy5hcGkudjEuTGlzdEFJTWVzc2FnZXNSZXF1ZXN0GiQubWVtb3MuYXBpLnYxLkxpc3RBSU1lc3NhZ2VzUmVzcG9uc2UiP9pBBnBhcmVudILT5JMCMBIuL2FwaS92MS97cGFyZW50PXVzZXJzLyovYWlTZXNzaW9ucy8qfS9tZXNzYWdlcxKcAQoOTGlzdEFJQnJhbmNoZXMSIy5tZW1vcy5hcGkudjEuTGlzdEFJQnJhbmNoZXNSZXF1ZXN0GiQubWVtb3MuYXBpLnYxLkxpc3RBSUJyYW5jaGVzUmVzcG9uc2UiP9pBBnBhcmVudILT5JMCMBIuL2FwaS92MS97cGFyZW50PXVzZXJzLyovYWlTZXNzaW9ucy8qfS9icmFuY2hlcxKaAQoOU3dpdGNoQUlCcmFuY2gSIy5tZW1vcy5hcGkudjEuU3dpdGNoQUlCcmFuY2hSZXF1ZXN0GhcubWVtb3MuYXBpLnYxLkFJU2Vzc2lvbiJK2kEMbmFtZSxtZXNzYWdlgtPkkwI1OgEqIjAvYXBpL3YxL3tuYW1lPXVzZXJzLyovYWlTZXNzaW9ucy8qfTpzd2l0Y2hCcmFuY2gSggEKBENoYXQSGS5tZW1vcy5hcGkudjEuQ2hhdFJlcXVlc3QaGS5tZW1vcy5hcGkudjEuQUlDaGF0RXZlbnQiQtpBDG5hbWUsY29udGVudILT5JMCLToBKiIoL2FwaS92MS97bmFtZT11c2Vycy8qL2FpU2Vzc2lvbnMvKn06Y2hhdDABEqkBChNSZWdlbmVyYXRlQUlNZXNzYWdlEigubWVtb3MuYXBpLnYxLlJlZ2VuZXJhdGVBSU1lc3NhZ2VSZXF1ZXN0GhkubWVtb3MuYXBpLnYxLkFJQ2hhdEV2ZW50IkvaQQRuYW1lgtPkkwI+OgEqIjkvYXBpL3YxL3tuYW1lPXVzZXJzLyovYWlTZXNzaW9ucy8qL21lc3NhZ2VzLyp9OnJlZ2VuZXJhdGUwARKvAQoPQ29uZmlybUFJQWN0aW9uEiQubWVtb3MuYXBpLnYxLkNvbmZpcm1BSUFjdGlvblJlcXVlc3QaGS5tZW1vcy5hcGkudjEuQUlDaGF0RXZlbnQiWdpBGm5hbWUsdG9vbF9jYWxsX2lkLGFwcHJvdmVkgtPkkwI2OgEqIjEvYXBpL3YxL3tuYW1lPXVzZXJzLyovYWlTZXNzaW9ucy8qfTpjb25maXJtQWN0aW9uMAESjAEKDENhbmNlbEFJVHVybhIhLm1lbW9zLmFwaS52MS5DYW5jZWxBSVR1cm5SZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IkHaQQRuYW1lgtPkkwI0IjIvYXBpL3YxL3tuYW1lPXVzZXJzLyovYWlTZXNzaW9ucy8qL3R1cm5zLyp9OmNhbmNlbBKUAQoMUmVzdW1lQUlUdXJuEiEubWVtb3MuYXBpLnYxLlJlc3VtZUFJVHVyblJlcXVlc3QaGS5tZW1vcy5hcGkudjEuQUlDaGF0RXZlbnQiRNpBBG5hbWWC0+STAjc6ASoiMi9hcGkvdjEve25hbWU9dXNlcnMvKi9haVNlc3Npb25zLyovdHVybnMvKn06cmVzdW1lMAESlQEKE1NhdmVBSVNlc3Npb25Bc01lbW8SKC5tZW1vcy5hcGkudjEuU2F2ZUFJU2Vzc2lvbkFzTWVtb1JlcXVlc3QaEi5tZW1vcy5hcGkudjEuTWVtbyJA2kEEbmFtZYLT5JMCMzoBKiIuL2FwaS92MS97bmFtZT11c2Vycy8qL2FpU2Vzc2lvbnMvKn06c2F2ZUFzTWVtbxKRAQoOTGlzdEFJTWVtb3JpZXMSIy5tZW1vcy5hcGkudjEuTGlzdEFJTWVtb3JpZXNSZXF1ZXN0GiQubWVtb3MuYXBpLnYxLkxpc3RBSU1lbW9yaWVzUmVzcG9uc2UiNNpBBnBhcmVudILT5JMCJRIjL2FwaS92MS97cGFyZW50PXVzZXJzLyp9L2FpTWVtb3JpZXMSpwEKDlVwZGF0ZUFJTWVtb3J5EiMubWVtb3MuYXBpLnYxLlVwZGF0ZUFJTWVtb3J5UmVxdWVzdBoWLm1lbW9zLmFwaS52MS5BSU1lbW9yeSJY2kEVYWlfbWVtb3J5LHVwZGF0ZV9tYXNrgtPkkwI6OglhaV9tZW1vcnkyLS9hcGkvdjEve2FpX21lbW9yeS5uYW1lPXVzZXJzLyovYWlNZW1vcmllcy8qfRKBAQoORGVsZXRlQUlNZW1vcnkSIy5tZW1vcy5hcGkudjEuRGVsZXRlQUlNZW1vcnlSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IjLaQQRuYW1lgtPkkwIlKiMvYXBpL3YxL3tuYW1lPXVzZXJzLyovYWlNZW1vcmllcy8qfRKTAQoSR2VuZXJhdGVDb21wbGV0aW9uEicubWVtb3MuYXBpLnYxLkdlbmVyYXRlQ29tcGxldGlvblJlcXVlc3QaKC5tZW1vcy5hcGkudjEuR2VuZXJhdGVDb21wbGV0aW9uUmVzcG9uc2UiKILT5JMCIjoBKiIdL2FwaS92MS9haTpnZW5lcmF0ZUNvbXBsZXRpb24wARJeCgpHZXRBSVVzYWdlEh8ubWVtb3MuYXBpLnYxLkdldEFJVXNhZ2VSZXF1ZXN0GhUubWVtb3MuYXBpLnYxLkFJVXNhZ2UiGILT5JMCEhIQL2FwaS92MS9haS91c2FnZUKmAQoQY29tLm1lbW9zLmFwaS52MUIOQWlTZXJ2aWNlUHJvdG9QAVowZ2l0aHViLmNvbS91c2VtZW1vcy9tZW1vcy9wcm90by9nZW4vYXBpL3YxO2FwaXYxogIDTUFYqgIMTWVtb3MuQXBpLlYxygIMTWVtb3NcQXBpXFYx4gIYTWVtb3NcQXBpXFYxXEdQQk1ldGFkYXRh6gIOTWVtb3M6OkFwaTo6VjFiBnByb3RvMw", [file_api_v1_memo_service, file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_timestamp]);
+
+/**
+ * @generated from message memos.api.v1.AISession
+ */
+export type AISession = Message<"memos.api.v1.AISession"> & {
+  /**
+   * The resource name of the session.
+   * Format: users/{user}/aiSessions/{ai_session}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The title of the session.
+   *
+   * @generated from field: string title = 2;
+   */
+  title: string;
+
+  /**
+   * The creation timestamp.
+   *
+   * @generated from field: google.protobuf.Timestamp create_time = 3;
+   */
+  createTime?: Timestamp;
+
+  /**
+   * The last update timestamp.
+   *
+   * @generated from field: google.protobuf.Timestamp update_time = 4;
+   */
+  updateTime?: Timestamp;
+
+  /**
+   * The tool calls awaiting the user's approval.
+   *
+   * @generated from field: repeated memos.api.v1.AIPendingAction pending_actions = 5;
+   */
+  pendingActions: AIPendingAction[];
+
+  /**
+   * The turn still running in the session, if any.
+   * Format: users/{user}/aiSessions/{ai_session}/turns/{turn}
+   *
+   * @generated from field: string running_turn = 6;
+   */
+  runningTurn: string;
+};
+
+/**
+ * Describes the message memos.api.v1.AISession.
+ * Use `create(AISessionSchema)` to create a new message.
+ */
+export const AISessionSchema: GenMessage<AISession> = /*@__PURE__*/
+  messageDesc(file_api_v1_ai_service, 0);
+
+/**
+ * AIPendingAction is a tool call awaiting the user's approval.
+ *
+ * @generated from message memos.api.v1.AIPendingAction
+ */
+export type AIPendingAction = Message<"memos.api.v1.AIPendingAction"> & {
+  /**
+   * The ID of the tool call.
+   *
+   * @generated from field: string tool_call_id = 1;
+   */
+  toolCallId: string;
+
+  /**
+   * The name of the tool.
+   *
+   * @generated from field: string tool_name = 2;
+   */
+  toolName: string;
+
+  /**
+   * The JSON arguments of the call.
+   *
+   * @generated from field: string input = 3;
+   */
+  input: string;
+
+  /**
+   * The assistant message that made the call.
+   * Format: users/{user}/aiSessions/{ai_session}/messages/{message}
+   *
+   * @generated from field: string message = 4;
+   */
+  message: string;
+
+  /**
+   * The memo the call would change, if any.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string memo = 5;
+   */
+  memo: string;
+
+  /**
+   * The change the call would make to the memo, as a unified diff.
+   *
+   * @generated from field: string diff = 6;
+   */
+  diff: string;
+};
+
+/**
+ * Describes the message memos.api.v1.AIPendingAction.
+ * Use `create(AIPendingActionSchema)` to create a new message.
+ */
+export const AIPendingActionSchema: GenMessage<AIPendingAction> = /*@__PURE__*/
+  messageDesc(file_api_v1_ai_service, 1);
+
+/**
+ * @generated from message memos.api.v1.AIMessage
+ */
+export type AIMessage = Message<"memos.api.v1.AIMessage"> & {
+  /**
+   * The resource name of the message.
+   * Format: users/{user}/aiSessions/{ai_session}/messages/{message}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The message this one follows on its branch; empty for the first message.
+   *
+   * @generated from field: string parent_message = 2;
+   */
+  parentMessage: string;
+
+  /**
+   * The messages sharing this message's parent, including itself, oldest first.
+   *
+   * @generated from field: repeated string sibling_messages = 3;
+   */
+  siblingMessages: string[];
+
+  /**
+   * @generated from field: memos.api.v1.AIMessage.Role role = 4;
+   */
+  role: AIMessage_Role;
+
+  /**
+   * @generated from field: string content = 5;
+   */
+  content: string;
+
+  /**
+   * The tool a tool message is the result of.
+   *
+   * @generated from field: string tool_name = 6;
+   */
+  toolName: string;
+
+  /**
+   * The tool call a tool message answers.
+   *
+   * @generated from field: string tool_call_id = 7;
+   */
+  toolCallId: string;
+
+  /**
+   * The tool calls made by an assistant message.
+   *
+   * @generated from field: repeated memos.api.v1.AIMessage.ToolCall tool_calls = 8;
+   */
+  toolCalls: AIMessage_ToolCall[];
+
+  /**
+   * Compacted messages are only sent to the model as part of the session summary.
+   *
+   * @generated from field: bool compacted = 9;
+   */
+  compacted: boolean;
+
+  /**
+   * The creation timestamp.
+   *
+   * @generated from field: google.protobuf.Timestamp create_time = 10;
+   */
+  createTime?: Timestamp;
+};
+
+/**
+ * Describes the message memos.api.v1.AIMessage.
+ * Use `create(AIMessageSchema)` to create a new message.
+ */
+export const AIMessageSchema: GenMessage<AIMessage> = /*@__PURE__*/
+  messageDesc(file_api_v1_ai_service, 2);
+
+/**
+ * @generated from message memos.api.v1.AIMessage.ToolCall
+ */
+export type AIMessage_ToolCall = Message<"memos.api.v1.AIMessage.ToolCall"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * The name of the tool.
+   *
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * The JSON arguments of the call.
+   *
+   * @generated from field: string arguments = 3;
+   */
+  arguments: string;
+};
+
+/**
+ * Describes the message memos.api.v1.AIMessage.ToolCall.
+ * Use `create(AIMessage_ToolCallSchema)` to create a new message.
+ */
+export const AIMessage_ToolCallSchema: GenMessage<AIMessage_ToolCall> = /*@__PURE__*/
+  messageDesc(file_api_v1_ai_service, 2, 0);
+
+/**
+ * @generated from enum memos.api.v1.AIMessage.Role
+ */
+export enum AIMessage_Role {
+  /**
+   * @generated from enum value: ROLE_UNSPECIFIED = 0;
+   */
+  ROLE_UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: USER = 1;
+   */
+  USER = 1,
+
+  /**
+   * @generated from enum value: ASSISTANT = 2;
+   */
+  ASSISTANT = 2,
+
+  /**
+   * @generated from enum value: TOOL = 3;
+   */
+  TOOL = 3,
+}
+
+/**
+ * Describes the enum memos.api.v1.AIMessage.Role.
+ */
+export const AIMessage_RoleSchema: GenEnum<AIMessage_Role> = /*@__PURE__*/
+  enumDesc(file_api_v1_ai_service, 2, 0);
+
+/**
+ * AIBranch is a path through the message tree of a session, from its first
+ * message to a message without replies.
+ *
+ * @generated from message memos.api.v1.AIBranch
+ */
+export type AIBranch = Message<"memos.api.v1.AIBranch"> & {
+  /**
+   * The last message of the branch.
+   *
+   * @generated from field: string leaf_message = 1;
+   */
+  leafMessage: string;
+
+  /**
+   * The message the branch diverges from its nearest sibling branch at; empty
+   * when it diverges at the first message.
+   *
+   * @generated from field: string fork_message = 2;
+   */
+  forkMessage: string;
+
+  /**
+   * @generated from field: int32 message_count = 3;
+   */
+  messageCount: number;
+
+  /**
+   * The last user message of the branch.
+   *
+   * @generated from field: string preview = 4;
+   */
+  preview: string;
+
+  /**
+   * Whether this is the branch being shown and continued.
+   *
+   * @generated from field: bool active = 5;
+   */
+  active: boolean;
+
+  /**
+   * The creation time of the last message.
+   *
+   * @generated from field: google.protobuf.Timestamp update_time = 6;
+   */
+  updateTime?: Timestamp;
+};
+
+/**
+ * Describes the message memos.api.v1.AIBranch.
+ * Use `create(AIBranchSchema)` to create a new message.
+ */
+export const AIBranchSchema: GenMessage<AIBranch> = /*@__PURE__*/
+  messageDesc(file_api_v1_ai_service, 3);
+
+/**
+ * AIChatEvent is an event of a streamed reply. The stream ends with the reply.
+ *
+ * @generated from message memos.api.v1.AIChatEvent
+ */
+export type AIChatEvent = Message<"memos.api.v1.AIChatEvent"> & {
+  /**
+   * @generated from oneof memos.api.v1.AIChatEvent.event
+   */
+  event: {
+    /**
+     * A piece of the answer.
+     *
+     * @generated from field: string token = 1;
+     */
+    value: string;
+    case: "token";
+  } | {
+    /**
+     * A tool the assistant is calling.
+     *
+     * @generated from field: memos.api.v1.AIMessage.ToolCall tool_call = 2;
+     */
+    value: AIMessage_ToolCall;
+    case: "toolCall";
+  } | {
+    /**
+     * A memo the answer draws on.
+     *
+     * @generated from field: memos.api.v1.AIChatEvent.Source source = 3;
+     */
+    value: AIChatEvent_Source;
+    case: "source";
+  } | {
+    /**
+     * A tool call that waits for the user's approval; the reply ends after
+     * the last one.
+     *
+     * @generated from field: memos.api.v1.AIPendingAction confirmation_required = 4;
+     */
+    value: AIPendingAction;
+    case: "confirmationRequired";
+  } | {
+    /**
+     * A failure that cut the reply short.
+     *
+     * @generated from field: string error = 5;
+     */
+    value: string;
+    case: "error";
+  } | {
+    /**
+     * The turn the reply belongs to, sent first; cancel or resume it by name.
+     * Format: users/{user}/aiSessions/{ai_session}/turns/{turn}
+     *
+     * @generated from field: string turn = 6;
+     */
+    value: string;
+    case: "turn";
+  } | {
+    /**
+     * The turn was cancelled; the reply ends here.
+     *
+     * @generated from field: bool cancelled = 7;
+     */
+    value: boolean;
+    case: "cancelled";
+  } | { case: undefined; value?: undefined };
+};
+
+/**
+ * Describes the message memos.api.v1.AIChatEvent.
+ * Use `create(AIChatEventSchema)` to create a new message.
+ */
+export const AIChatEventSchema: GenMessage<AIChatEvent> = /*@__PURE__*/
+  messageDesc(file_api_v1_ai_service, 4);
+
+/**
+ * @generated from message memos.api.v1.AIChatEvent.Source
+ */
+export type AIChatEvent_Source = Message<"memos.api.v1.AIChatEvent.Source"> & {
+  /**
+   * Format: memos/{memo}
+   *
+   * @generated from field: string memo = 1;
+   */
+  memo: string;
+
+  /**
+   * The passage of the memo that matched.
+   *
+   * @generated from field: string snippet = 2;
+   */
+  snippet: string;
+
+  /**
+   * The offsets of the passage in the memo content, or in the text of the
+   * attachment if set.
+   *
+   * @generated from field: int32 start = 3;
+   */
+  start: number;
+
+  /**
+   * @generated from field: int32 end = 4;
+   */
+  end: number;
+
+  /**
+   * The attachment of the memo the passage comes from, if any.
+   * Format: attachments/{attachment}
+   *
+   * @generated from field: string attachment = 5;
+   */
+  attachment: string;
+
+  /**
+   * The filename of the attachment.
+   *
+   * @generated from field: string filename = 6;
+   */
+  filename: string;
+};
+
+/**
+ * Describes the message memos.api.v1.AIChatEvent.Source.
+ * Use `create(AIChatEvent_SourceSchema)` to create a new message.
+ */
+export const AIChatEvent_SourceSchema: GenMessage<AIChatEvent_Source> = /*@__PURE__*/
+  messageDesc(file_api_v1_ai_service, 4, 0);
+
+/**
+ * @generated from message memos.api.v1.ListAISessionsRequest
+ */
+export type ListAISessionsRequest = Message<"memos.api.v1.ListAISessionsRequest"> & {
+  /**
+   * Required. The parent user.
+   * Format: users/{user}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+
+  /**
+   * Optional. The maximum number of sessions to return.
+   *
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize: number;
+
+  /**
+   * Optional. A page token, received from a previous `ListAISessions` call.
+   *
+   * @generated from field: string page_token = 3;
+   */
+  pageToken: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListAISessionsRequest.
+ * Use `create(ListAISessionsRequestSchema)` to create a new message.
+ */
+export const ListAISessionsRequestSchema: GenMessage<ListAISessionsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_ai_service, 5);
+
+/**
+ * @generated from message memos.api.v1.ListAISessionsResponse
+ */
+export type ListAISessionsResponse = Message<"memos.api.v1.ListAISessionsResponse"> & {
+  /**
+   * @generated from field: repeated memos.api.v1.AISession ai_sessions = 1;
+   */
+  aiSessions: AISession[];
+
+  /**
+   * A token that can be sent as `page_token` to retrieve the next page.
+   * If this field is omitted, there are no subsequent pages.
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListAISessionsResponse.
+ * Use `create(ListAISessionsResponseSchema)` to create a new message.
+ */
+export const ListAISessionsResponseSchema: GenMessage<ListAISessionsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_ai_service, 6);
+
+/**
+ * @generated from message memos.api.v1.SearchAISessionsRequest
+ */
+export type SearchAISessionsRequest = Message<"memos.api.v1.SearchAISessionsRequest"> & {
+  /**
+   * Required. The parent user.
+   * Format: users/{user}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+
+  /**
+   * Required. The search query. Sessions and messages match when they contain
+   * any of its words, ignoring case.
+   *
+   * @generated from field: string query = 2;
+   */
+  query: string;
+
+  /**
+   * Optional. The maximum number of results to return.
+   *
+   * @generated from field: int32 page_size = 3;
+   */
+  pageSize: number;
+
+  /**
+   * Optional. A page token, received from a previous `SearchAISessions` call.
+   *
+   * @generated from field: string page_token = 4;
+   */
+  pageToken: string;
+};
+
+/**
+ * Describes the message memos.api.v1.SearchAISessionsRequest.
+ * Use `create(SearchAISessionsRequestSchema)` to create a new message.
+ */
+export const SearchAISessionsRequestSchema: GenMessage<SearchAISessionsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_ai_service, 7);
+
+/**
+ * @generated from message memos.api.v1.SearchAISessionsResponse
+ */
+export type SearchAISessionsResponse = Message<"memos.api.v1.SearchAISessionsResponse"> & {
+  /**
+   * The results, newest first.
+   *
+   * @generated from field: repeated memos.api.v1.SearchAISessionsResponse.Result results = 1;
+   */
+  results: SearchAISessionsResponse_Result[];
+
+  /**
+   * A token that can be sent as `page_token` to retrieve the next page.
+   * If this field is omitted, there are no subsequent pages.
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message memos.api.v1.SearchAISessionsResponse.
+ * Use `create(SearchAISessionsResponseSchema)` to create a new message.
+ */
+export const SearchAISessionsResponseSchema: GenMessage<SearchAISessionsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_ai_service, 8);
+
+/**
+ * @generated from message memos.api.v1.SearchAISessionsResponse.Result
+ */
+export type SearchAISessionsResponse_Result = Message<"memos.api.v1.SearchAISessionsResponse.Result"> & {
+  /**
+   * The session that matched, or that holds the matching message.
+   * Format: users/{user}/aiSessions/{ai_session}
+   *
+   * @generated from field: string ai_session = 1;
+   */
+  aiSession: string;
+
+  /**
+   * The title of the session.
+   *
+   * @generated from field: string title = 2;
+   */
+  title: string;
+
+  /**
+   * The matching message; empty when the session title or summary matched.
+   * Format: users/{user}/aiSessions/{ai_session}/messages/{message}
+   *
+   * @generated from field: string message = 3;
+   */
+  message: string;
+
+  /**
+   * The role of the matching message.
+   *
+   * @generated from field: memos.api.v1.AIMessage.Role role = 4;
+   */
+  role: AIMessage_Role;
+
+  /**
+   * The passage that matched.
+   *
+   * @generated from field: string snippet = 5;
+   */
+  snippet: string;
+
+  /**
+   * The query words found in the snippet.
+   *
+   * @generated from field: repeated memos.api.v1.SearchAISessionsResponse.Highlight highlights = 6;
+   */
+  highlights: SearchAISessionsResponse_Highlight[];
+
+  /**
+   * When the message was sent, or when the session was last updated.
+   *
+   * @generated from field: google.protobuf.Timestamp create_time = 7;
+   */
+  createTime?: Timestamp;
+};
+
+/**
+ * Describes the message memos.api.v1.SearchAISessionsResponse.Result.
+ * Use `create(SearchAISessionsResponse_ResultSchema)` to create a new message.
+ */
+export const SearchAISessionsResponse_ResultSchema: GenMessage<SearchAISessionsResponse_Result> = /*@__PURE__*/
+  messageDesc(file_api_v1_ai_service, 8, 0);
+
+/**
+ * Highlight is a range of the snippet, in Unicode code points.
+ *
+ * @generated from message memos.api.v1.SearchAISessionsResponse.Highlight
+ */
+export type SearchAISessionsResponse_Highlight = Message<"memos.api.v1.SearchAISessionsResponse.Highlight"> & {
+  /**
+   * @generated from field: int32 start = 1;
+   */
+  start: number;
+
+  /**
+   * @generated from field: int32 end = 2;
+   */
+  end: number;
+};
+
+/**
+ * Describes the message memos.api.v1.SearchAISessionsResponse.Highlight.
+ * Use `create(SearchAISessionsResponse_HighlightSchema)` to create a new message.
+ */
+export const SearchAISessionsResponse_HighlightSchema: GenMessage<SearchAISessionsResponse_Highlight> = /*@__PURE__*/
+  messageDesc(file_api_v1_ai_service, 8, 1);
+
+/**
+ * @generated from message memos.api.v1.GetAISessionRequest
+ */
+export type GetAISessionRequest = Message<"memos.api.v1.GetAISessionRequest"> & {
+  /**
+   * Required. The resource name of the session.
+   * Format: users/{user}/aiSessions/{ai_session}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.GetAISessionRequest.
+ * Use `create(GetAISessionRequestSchema)` to create a new message.
+ */
+export const GetAISessionRequestSchema: GenMessage<GetAISessionRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_ai_service, 9);
+
+/**
+ * @generated from message memos.api.v1.CreateAISessionRequest
+ */
+export type CreateAISessionRequest = Message<"memos.api.v1.CreateAISessionRequest"> & {
+  /**
+   * Required. The user creating the session.
+   * Format: users/{user}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+
+  /**
+   * The session to create; it is titled "New Chat" without a title.
+   *
+   * @generated from field: memos.api.v1.AISession ai_session = 2;
+   */
+  aiSession?: AISession;
+};
+
+/**
+ * Describes the message memos.api.v1.CreateAISessionRequest.
+ * Use `create(CreateAISessionRequestSchema)` to create a new message.
+ */
+export const CreateAISessionRequestSchema: GenMessage<CreateAISessionRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_ai_service, 10);
+
+/**
+ * @generated from message memos.api.v1.UpdateAISessionRequest
+ */
+export type UpdateAISessionRequest = Message<"memos.api.v1.UpdateAISessionRequest"> & {
+  /**
+   * Required. The session to update.
+   *
+   * @generated from field: memos.api.v1.AISession ai_session = 1;
+   */
+  aiSession?: AISession;
+
+  /**
+   * Required. The fields to update; only "title" can be updated.
+   *
+   * @generated from field: google.protobuf.FieldMask update_mask = 2;
+   */
+  updateMask?: FieldMask;
+};
+
+/**
+ * Describes the message memos.api.v1.UpdateAISessionRequest.
+ * Use `create(UpdateAISessionRequestSchema)` to create a new message.
+ */
+export const UpdateAISessionRequestSchema: GenMessage<UpdateAISessionRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_ai_service, 11);
+
+/**
+ * @generated from message memos.api.v1.DeleteAISessionRequest
+ */
+export type DeleteAISessionRequest = Message<"memos.api.v1.DeleteAISessionRequest"> & {
+  /**
+   * Required. The resource name of the session.
+   * Format: users/{user}/aiSessions/{ai_session}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.DeleteAISessionRequest.
+ * Use `create(DeleteAISessionRequestSchema)` to create a new message.
+ */
+export const DeleteAISessionRequestSchema: GenMessage<DeleteAISessionRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_ai_service, 12);
+
+/**
+ * @generated from message memos.api.v1.ListAIMessagesRequest
+ */
+export type ListAIMessagesRequest = Message<"memos.api.v1.ListAIMessagesRequest"> & {
+  /**
+   * Required. The session.
+   * Format: users/{user}/aiSessions/{ai_session}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+
+  /**
+   * Optional. The maximum number of messages to return.
+   *
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize: number;
+
+  /**
+   * Optional. A page token, received from a previous `ListAIMessages` call.
+   *
+   * @generated from field: string page_token = 3;
+   */
+  pageToken: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListAIMessagesRequest.
+ * Use `create(ListAIMessagesRequestSchema)` to create a new message.
+ */
+export const ListAIMessagesRequestSchema: GenMessage<ListAIMessagesRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_ai_service, 13);
+
+/**
+ * @generated from message memos.api.v1.ListAIMessagesResponse
+ */
+export type ListAIMessagesResponse = Message<"memos.api.v1.ListAIMessagesResponse"> & {
+  /**
+   * @generated from field: repeated memos.api.v1.AIMessage ai_messages = 1;
+   */
+  aiMessages: AIMessage[];
+
+  /**
+   * A token that can be sent as `page_token` to retrieve the next page.
+   * If this field is omitted, there are no subsequent pages.
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListAIMessagesResponse.
+ * Use `create(ListAIMessagesResponseSchema)` to create a new message.
+ */
+export const ListAIMessagesResponseSchema: GenMessage<ListAIMessagesResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_ai_service, 14);
+
+/**
+ * @generated from message memos.api.v1.ListAIBranchesRequest
+ */
+export type ListAIBranchesRequest = Message<"memos.api.v1.ListAIBranchesRequest"> & {
+  /**
+   * Required. The session.
+   * Format: users/{user}/aiSessions/{ai_session}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListAIBranchesRequest.
+ * Use `create(ListAIBranchesRequestSchema)` to create a new message.
+ */
+export const ListAIBranchesRequestSchema: GenMessage<ListAIBranchesRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_ai_service, 15);
+
+/**
+ * @generated from message memos.api.v1.ListAIBranchesResponse
+ */
+export type ListAIBranchesResponse = Message<"memos.api.v1.ListAIBranchesResponse"> & {
+  /**
+   * @generated from field: repeated memos.api.v1.AIBranch branches = 1;
+   */
+  branches: AIBranch[];
+};
+
+/**
+ * Describes the message memos.api.v1.ListAIBranchesResponse.
+ * Use `create(ListAIBranchesResponseSchema)` to create a new message.
+ */
+export const ListAIBranchesResponseSchema: GenMessage<ListAIBranchesResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_ai_service, 16);
+
+/**
+ * @generated from message memos.api.v1.SwitchAIBranchRequest
+ */
+export type SwitchAIBranchRequest = Message<"memos.api.v1.SwitchAIBranchRequest"> & {
+  /**
+   * Required. The session.
+   * Format: users/{user}/aiSessions/{ai_session}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Required. A message of the branch; the branch continues to the message's
+   * most recent descendant.
+   * Format: users/{user}/aiSessions/{ai_session}/messages/{message}
+   *
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message memos.api.v1.SwitchAIBranchRequest.
+ * Use `create(SwitchAIBranchRequestSchema)` to create a new message.
+ */
+export const SwitchAIBranchRequestSchema: GenMessage<SwitchAIBranchRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_ai_service, 17);
+
+/**
+ * @generated from message memos.api.v1.ChatRequest
+ */
+export type ChatRequest = Message<"memos.api.v1.ChatRequest"> & {
+  /**
+   * Required. The session.
+   * Format: users/{user}/aiSessions/{ai_session}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Required. The user message.
+   *
+   * @generated from field: string content = 2;
+   */
+  content: string;
+
+  /**
+   * Optional. Tags like "#work #ideas" that memo searches are limited to.
+   *
+   * @generated from field: string tag_filter = 3;
+   */
+  tagFilter: string;
+
+  /**
+   * Optional. The message to reply after, which edits the turn after it; empty
+   * to start the session over. Defaults to the end of the active branch.
+   * Format: users/{user}/aiSessions/{ai_session}/messages/{message}
+   *
+   * @generated from field: optional string parent_message = 4;
+   */
+  parentMessage?: string;
+
+  /**
+   * Optional. The chat model to answer with, one of the instance's allowed
+   * models. Defaults to the server's model.
+   *
+   * @generated from field: string model = 5;
+   */
+  model: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ChatRequest.
+ * Use `create(ChatRequestSchema)` to create a new message.
+ */
+export const ChatRequestSchema: GenMessage<ChatRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_ai_service, 18);
+
+/**
+ * @generated from message memos.api.v1.RegenerateAIMessageRequest
+ */
+export type RegenerateAIMessageRequest = Message<"memos.api.v1.RegenerateAIMessageRequest"> & {
+  /**
+   * Required. The message to answer again, or the reply to regenerate.
+   * Format: users/{user}/aiSessions/{ai_session}/messages/{message}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Optional. Tags like "#work #ideas" that memo searches are limited to.
+   *
+   * @generated from field: string tag_filter = 2;
+   */
+  tagFilter: string;
+
+  /**
+   * Optional. The chat model to answer with, one of the instance's allowed
+   * models. Defaults to the server's model.
+   *
+   * @generated from field: string model = 3;
+   */
+  model: string;
+};
+
+/**
+ * Describes the message memos.api.v1.RegenerateAIMessageRequest.
+ * Use `create(RegenerateAIMessageRequestSchema)` to create a new message.
+ */
+export const RegenerateAIMessageRequestSchema: GenMessage<RegenerateAIMessageRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_ai_service, 19);
+
+/**
+ * @generated from message memos.api.v1.ConfirmAIActionRequest
+ */
+export type ConfirmAIActionRequest = Message<"memos.api.v1.ConfirmAIActionRequest"> & {
+  /**
+   * Required. The session.
+   * Format: users/{user}/aiSessions/{ai_session}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Required. The tool call of the pending action.
+   *
+   * @generated from field: string tool_call_id = 2;
+   */
+  toolCallId: string;
+
+  /**
+   * Whether to run the tool call or reject it.
+   *
+   * @generated from field: bool approved = 3;
+   */
+  approved: boolean;
+};
+
+/**
+ * Describes the message memos.api.v1.ConfirmAIActionRequest.
+ * Use `create(ConfirmAIActionRequestSchema)` to create a new message.
+ */
+export const ConfirmAIActionRequestSchema: GenMessage<ConfirmAIActionRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_ai_service, 20);
+
+/**
+ * @generated from message memos.api.v1.CancelAITurnRequest
+ */
+export type CancelAITurnRequest = Message<"memos.api.v1.CancelAITurnRequest"> & {
+  /**
+   * Required. The turn to cancel.
+   * Format: users/{user}/aiSessions/{ai_session}/turns/{turn}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.CancelAITurnRequest.
+ * Use `create(CancelAITurnRequestSchema)` to create a new message.
+ */
+export const CancelAITurnRequestSchema: GenMessage<CancelAITurnRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_ai_service, 21);
+
+/**
+ * @generated from message memos.api.v1.ResumeAITurnRequest
+ */
+export type ResumeAITurnRequest = Message<"memos.api.v1.ResumeAITurnRequest"> & {
+  /**
+   * Required. The turn to resume.
+   * Format: users/{user}/aiSessions/{ai_session}/turns/{turn}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The number of events already received, which are not sent again.
+   *
+   * @generated from field: int32 offset = 2;
+   */
+  offset: number;
+};
+
+/**
+ * Describes the message memos.api.v1.ResumeAITurnRequest.
+ * Use `create(ResumeAITurnRequestSchema)` to create a new message.
+ */
+export const ResumeAITurnRequestSchema: GenMessage<ResumeAITurnRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_ai_service, 22);
+
+/**
+ * @generated from message memos.api.v1.SaveAISessionAsMemoRequest
+ */
+export type SaveAISessionAsMemoRequest = Message<"memos.api.v1.SaveAISessionAsMemoRequest"> & {
+  /**
+   * Required. The session.
+   * Format: users/{user}/aiSessions/{ai_session}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Optional. The assistant message to save. The whole session is saved when
+   * unset.
+   * Format: users/{user}/aiSessions/{ai_session}/messages/{message}
+   *
+   * @generated from field: string message = 2;
+   */
+  message: string;
+
+  /**
+   * Optional. A tag to add to the memo, such as "ai".
+   *
+   * @generated from field: string tag = 3;
+   */
+  tag: string;
+
+  /**
+   * Optional. The visibility of the memo. Defaults to private.
+   *
+   * @generated from field: memos.api.v1.Visibility visibility = 4;
+   */
+  visibility: Visibility;
+};
+
+/**
+ * Describes the message memos.api.v1.SaveAISessionAsMemoRequest.
+ * Use `create(SaveAISessionAsMemoRequestSchema)` to create a new message.
+ */
+export const SaveAISessionAsMemoRequestSchema: GenMessage<SaveAISessionAsMemoRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_ai_service, 23);
+
+/**
+ * AIMemory is a durable fact or preference the assistant keeps about a user
+ * across sessions. The assistant saves and forgets memories with its
+ * remember and forget tools.
+ *
+ * @generated from message memos.api.v1.AIMemory
+ */
+export type AIMemory = Message<"memos.api.v1.AIMemory"> & {
+  /**
+   * The resource name of the memory.
+   * Format: users/{user}/aiMemories/{ai_memory}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The remembered fact, e.g. "Prefers metric units."
+   *
+   * @generated from field: string content = 2;
+   */
+  content: string;
+
+  /**
+   * The creation timestamp.
+   *
+   * @generated from field: google.protobuf.Timestamp create_time = 3;
+   */
+  createTime?: Timestamp;
+
+  /**
+   * The last update timestamp.
+   *
+   * @generated from field: google.protobuf.Timestamp update_time = 4;
+   */
+  updateTime?: Timestamp;
+};
+
+/**
+ * Describes the message memos.api.v1.AIMemory.
+ * Use `create(AIMemorySchema)` to create a new message.
+ */
+export const AIMemorySchema: GenMessage<AIMemory> = /*@__PURE__*/
+  messageDesc(file_api_v1_ai_service, 24);
+
+/**
+ * @generated from message memos.api.v1.ListAIMemoriesRequest
+ */
+export type ListAIMemoriesRequest = Message<"memos.api.v1.ListAIMemoriesRequest"> & {
+  /**
+   * Required. The parent user.
+   * Format: users/{user}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListAIMemoriesRequest.
+ * Use `create(ListAIMemoriesRequestSchema)` to create a new message.
+ */
+export const ListAIMemoriesRequestSchema: GenMessage<ListAIMemoriesRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_ai_service, 25);
+
+/**
+ * @generated from message memos.api.v1.ListAIMemoriesResponse
+ */
+export type ListAIMemoriesResponse = Message<"memos.api.v1.ListAIMemoriesResponse"> & {
+  /**
+   * @generated from field: repeated memos.api.v1.AIMemory ai_memories = 1;
+   */
+  aiMemories: AIMemory[];
+};
+
+/**
+ * Describes the message memos.api.v1.ListAIMemoriesResponse.
+ * Use `create(ListAIMemoriesResponseSchema)` to create a new message.
+ */
+export const ListAIMemoriesResponseSchema: GenMessage<ListAIMemoriesResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_ai_service, 26);
+
+/**
+ * @generated from message memos.api.v1.UpdateAIMemoryRequest
+ */
+export type UpdateAIMemoryRequest = Message<"memos.api.v1.UpdateAIMemoryRequest"> & {
+  /**
+   * Required. The memory to update.
+   *
+   * @generated from field: memos.api.v1.AIMemory ai_memory = 1;
+   */
+  aiMemory?: AIMemory;
+
+  /**
+   * Required. The fields to update; only "content" can be updated.
+   *
+   * @generated from field: google.protobuf.FieldMask update_mask = 2;
+   */
+  updateMask?: FieldMask;
+};
+
+/**
+ * Describes the message memos.api.v1.UpdateAIMemoryRequest.
+ * Use `create(UpdateAIMemoryRequestSchema)` to create a new message.
+ */
+export const UpdateAIMemoryRequestSchema: GenMessage<UpdateAIMemoryRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_ai_service, 27);
+
+/**
+ * @generated from message memos.api.v1.DeleteAIMemoryRequest
+ */
+export type DeleteAIMemoryRequest = Message<"memos.api.v1.DeleteAIMemoryRequest"> & {
+  /**
+   * Required. The resource name of the memory.
+   * Format: users/{user}/aiMemories/{ai_memory}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.DeleteAIMemoryRequest.
+ * Use `create(DeleteAIMemoryRequestSchema)` to create a new message.
+ */
+export const DeleteAIMemoryRequestSchema: GenMessage<DeleteAIMemoryRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_ai_service, 28);
+
+/**
+ * @generated from message memos.api.v1.GenerateCompletionRequest
+ */
+export type GenerateCompletionRequest = Message<"memos.api.v1.GenerateCompletionRequest"> & {
+  /**
+   * Required. The prompt to complete.
+   *
+   * @generated from field: string prompt = 1;
+   */
+  prompt: string;
+
+  /**
+   * Optional. The system prompt.
+   *
+   * @generated from field: string system = 2;
+   */
+  system: string;
+};
+
+/**
+ * Describes the message memos.api.v1.GenerateCompletionRequest.
+ * Use `create(GenerateCompletionRequestSchema)` to create a new message.
+ */
+export const GenerateCompletionRequestSchema: GenMessage<GenerateCompletionRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_ai_service, 29);
+
+/**
+ * @generated from message memos.api.v1.GenerateCompletionResponse
+ */
+export type GenerateCompletionResponse = Message<"memos.api.v1.GenerateCompletionResponse"> & {
+  /**
+   * The next piece of the completion.
+   *
+   * @generated from field: string content = 1;
+   */
+  content: string;
+};
+
+/**
+ * Describes the message memos.api.v1.GenerateCompletionResponse.
+ * Use `create(GenerateCompletionResponseSchema)` to create a new message.
+ */
+export const GenerateCompletionResponseSchema: GenMessage<GenerateCompletionResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_ai_service, 30);
+
+/**
+ * @generated from message memos.api.v1.GetAIUsageRequest
+ */
+export type GetAIUsageRequest = Message<"memos.api.v1.GetAIUsageRequest"> & {
+  /**
+   * Optional. The month, formatted as YYYY-MM. Defaults to the current month.
+   *
+   * @generated from field: string month = 1;
+   */
+  month: string;
+};
+
+/**
+ * Describes the message memos.api.v1.GetAIUsageRequest.
+ * Use `create(GetAIUsageRequestSchema)` to create a new message.
+ */
+export const GetAIUsageRequestSchema: GenMessage<GetAIUsageRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_ai_service, 31);
+
+/**
+ * AIUsage is the token usage of every user in a calendar month (UTC).
+ *
+ * @generated from message memos.api.v1.AIUsage
+ */
+export type AIUsage = Message<"memos.api.v1.AIUsage"> & {
+  /**
+   * @generated from field: google.protobuf.Timestamp start_time = 1;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp end_time = 2;
+   */
+  endTime?: Timestamp;
+
+  /**
+   * @generated from field: repeated memos.api.v1.AIUsage.UserUsage users = 3;
+   */
+  users: AIUsage_UserUsage[];
+};
+
+/**
+ * Describes the message memos.api.v1.AIUsage.
+ * Use `create(AIUsageSchema)` to create a new message.
+ */
+export const AIUsageSchema: GenMessage<AIUsage> = /*@__PURE__*/
+  messageDesc(file_api_v1_ai_service, 32);
+
+/**
+ * @generated from message memos.api.v1.AIUsage.UserUsage
+ */
+export type AIUsage_UserUsage = Message<"memos.api.v1.AIUsage.UserUsage"> & {
+  /**
+   * Format: users/{user}
+   *
+   * @generated from field: string user = 1;
+   */
+  user: string;
+
+  /**
+   * Empty for deleted users.
+   *
+   * @generated from field: string username = 2;
+   */
+  username: string;
+
+  /**
+   * @generated from field: int64 calls = 3;
+   */
+  calls: bigint;
+
+  /**
+   * @generated from field: int64 prompt_tokens = 4;
+   */
+  promptTokens: bigint;
+
+  /**
+   * @generated from field: int64 completion_tokens = 5;
+   */
+  completionTokens: bigint;
+
+  /**
+   * @generated from field: int64 total_tokens = 6;
+   */
+  totalTokens: bigint;
+
+  /**
+   * The user's monthly token quota; 0 means unlimited.
+   *
+   * @generated from field: int64 quota = 7;
+   */
+  quota: bigint;
+};
+
+/**
+ * Describes the message memos.api.v1.AIUsage.UserUsage.
+ * Use `create(AIUsage_UserUsageSchema)` to create a new message.
+ */
+export const AIUsage_UserUsageSchema: GenMessage<AIUsage_UserUsage> = /*@__PURE__*/
+  messageDesc(file_api_v1_ai_service, 32, 0);
+
+/**
+ * @generated from service memos.api.v1.AIService
+ */
+export const AIService: GenService<{
+  /**
+   * ListAISessions lists the AI chat sessions of a user, most recently updated first.
+   *
+   * @generated from rpc memos.api.v1.AIService.ListAISessions
+   */
+  listAISessions: {
+    methodKind: "unary";
+    input: typeof ListAISessionsRequestSchema;
+    output: typeof ListAISessionsResponseSchema;
+  },
+  /**
+   * SearchAISessions finds the sessions and messages of a user that contain
+   * the query, newest first.
+   *
+   * @generated from rpc memos.api.v1.AIService.SearchAISessions
+   */
+  searchAISessions: {
+    methodKind: "unary";
+    input: typeof SearchAISessionsRequestSchema;
+    output: typeof SearchAISessionsResponseSchema;
+  },
+  /**
+   * GetAISession gets an AI chat session by name.
+   *
+   * @generated from rpc memos.api.v1.AIService.GetAISession
+   */
+  getAISession: {
+    methodKind: "unary";
+    input: typeof GetAISessionRequestSchema;
+    output: typeof AISessionSchema;
+  },
+  /**
+   * CreateAISession creates an AI chat session.
+   *
+   * @generated from rpc memos.api.v1.AIService.CreateAISession
+   */
+  createAISession: {
+    methodKind: "unary";
+    input: typeof CreateAISessionRequestSchema;
+    output: typeof AISessionSchema;
+  },
+  /**
+   * UpdateAISession updates an AI chat session.
+   *
+   * @generated from rpc memos.api.v1.AIService.UpdateAISession
+   */
+  updateAISession: {
+    methodKind: "unary";
+    input: typeof UpdateAISessionRequestSchema;
+    output: typeof AISessionSchema;
+  },
+  /**
+   * DeleteAISession deletes an AI chat session and its messages.
+   *
+   * @generated from rpc memos.api.v1.AIService.DeleteAISession
+   */
+  deleteAISession: {
+    methodKind: "unary";
+    input: typeof DeleteAISessionRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * ListAIMessages lists the messages on the active branch of a session, oldest first.
+   *
+   * @generated from rpc memos.api.v1.AIService.ListAIMessages
+   */
+  listAIMessages: {
+    methodKind: "unary";
+    input: typeof ListAIMessagesRequestSchema;
+    output: typeof ListAIMessagesResponseSchema;
+  },
+  /**
+   * ListAIBranches lists the branches of a session.
+   *
+   * @generated from rpc memos.api.v1.AIService.ListAIBranches
+   */
+  listAIBranches: {
+    methodKind: "unary";
+    input: typeof ListAIBranchesRequestSchema;
+    output: typeof ListAIBranchesResponseSchema;
+  },
+  /**
+   * SwitchAIBranch makes the branch through a message the active branch of its session.
+   *
+   * @generated from rpc memos.api.v1.AIService.SwitchAIBranch
+   */
+  switchAIBranch: {
+    methodKind: "unary";
+    input: typeof SwitchAIBranchRequestSchema;
+    output: typeof AISessionSchema;
+  },
+  /**
+   * Chat sends a message to the assistant and streams its reply.
+   *
+   * @generated from rpc memos.api.v1.AIService.Chat
+   */
+  chat: {
+    methodKind: "server_streaming";
+    input: typeof ChatRequestSchema;
+    output: typeof AIChatEventSchema;
+  },
+  /**
+   * RegenerateAIMessage answers a user message again and streams the new reply.
+   * Given a reply, it regenerates the reply to the user message before it.
+   *
+   * @generated from rpc memos.api.v1.AIService.RegenerateAIMessage
+   */
+  regenerateAIMessage: {
+    methodKind: "server_streaming";
+    input: typeof RegenerateAIMessageRequestSchema;
+    output: typeof AIChatEventSchema;
+  },
+  /**
+   * ConfirmAIAction runs or rejects a tool call awaiting the user's approval and
+   * streams the rest of the reply once no actions are left.
+   *
+   * @generated from rpc memos.api.v1.AIService.ConfirmAIAction
+   */
+  confirmAIAction: {
+    methodKind: "server_streaming";
+    input: typeof ConfirmAIActionRequestSchema;
+    output: typeof AIChatEventSchema;
+  },
+  /**
+   * CancelAITurn stops a running turn before its next model or tool call. The
+   * reply ends with a cancelled event and keeps what was streamed so far.
+   *
+   * @generated from rpc memos.api.v1.AIService.CancelAITurn
+   */
+  cancelAITurn: {
+    methodKind: "unary";
+    input: typeof CancelAITurnRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * ResumeAITurn streams the events of a turn again, from the given offset,
+   * followed by the rest of the reply while the turn runs.
+   *
+   * @generated from rpc memos.api.v1.AIService.ResumeAITurn
+   */
+  resumeAITurn: {
+    methodKind: "server_streaming";
+    input: typeof ResumeAITurnRequestSchema;
+    output: typeof AIChatEventSchema;
+  },
+  /**
+   * SaveAISessionAsMemo creates a memo from an assistant answer, or from the
+   * whole active branch of a session rendered as markdown. Memos the chat's
+   * tools cited become references of the new memo.
+   *
+   * @generated from rpc memos.api.v1.AIService.SaveAISessionAsMemo
+   */
+  saveAISessionAsMemo: {
+    methodKind: "unary";
+    input: typeof SaveAISessionAsMemoRequestSchema;
+    output: typeof MemoSchema;
+  },
+  /**
+   * ListAIMemories lists what the assistant remembers about a user across
+   * sessions, most recently updated first.
+   *
+   * @generated from rpc memos.api.v1.AIService.ListAIMemories
+   */
+  listAIMemories: {
+    methodKind: "unary";
+    input: typeof ListAIMemoriesRequestSchema;
+    output: typeof ListAIMemoriesResponseSchema;
+  },
+  /**
+   * UpdateAIMemory edits a memory of the assistant.
+   *
+   * @generated from rpc memos.api.v1.AIService.UpdateAIMemory
+   */
+  updateAIMemory: {
+    methodKind: "unary";
+    input: typeof UpdateAIMemoryRequestSchema;
+    output: typeof AIMemorySchema;
+  },
+  /**
+   * DeleteAIMemory makes the assistant forget a memory.
+   *
+   * @generated from rpc memos.api.v1.AIService.DeleteAIMemory
+   */
+  deleteAIMemory: {
+    methodKind: "unary";
+    input: typeof DeleteAIMemoryRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * GenerateCompletion streams a one-off completion of a prompt, outside any session.
+   *
+   * @generated from rpc memos.api.v1.AIService.GenerateCompletion
+   */
+  generateCompletion: {
+    methodKind: "server_streaming";
+    input: typeof GenerateCompletionRequestSchema;
+    output: typeof GenerateCompletionResponseSchema;
+  },
+  /**
+   * GetAIUsage reports every user's token usage for a calendar month. Admins only.
+   *
+   * @generated from rpc memos.api.v1.AIService.GetAIUsage
+   */
+  getAIUsage: {
+    methodKind: "unary";
+    input: typeof GetAIUsageRequestSchema;
+    output: typeof AIUsageSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_api_v1_ai_service, 0);
+
@@ -4,21 +4,21 @@
 
 import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
 import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
-import type { User } from "./user_service_pb";
+import type { MCPServer, User } from "./user_service_pb";
 import { file_api_v1_user_service } from "./user_service_pb";
 import { file_google_api_annotations } from "../../google/api/annotations_pb";
 import { file_google_api_client } from "../../google/api/client_pb";
 import { file_google_api_field_behavior } from "../../google/api/field_behavior_pb";
 import { file_google_api_resource } from "../../google/api/resource_pb";
-import type { FieldMask } from "@bufbuild/protobuf/wkt";
-import { file_google_protobuf_field_mask } from "@bufbuild/protobuf/wkt";
+import type { FieldMask, Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_field_mask, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file api/v1/instance_service.proto.
  */
 export const file_api_v1_instance_service: GenFile = /*@__PURE__*/
-  fileDesc("Ch1hcGkvdjEvaW5zdGFuY2Vfc2VydmljZS5wcm90bxIMbWVtb3MuYXBpLnYxImkKD0luc3RhbmNlUHJvZmlsZRIPCgd2ZXJzaW9uGAIgASgJEgwKBGRlbW8YAyABKAgSFAoMaW5zdGFuY2VfdXJsGAYgASgJEiEKBWFkbWluGAcgASgLMhIubWVtb3MuYXBpLnYxLlVzZXIiGwoZR2V0SW5zdGFuY2VQcm9maWxlUmVxdWVzdCLUCwoPSW5zdGFuY2VTZXR0aW5nEhEKBG5hbWUYASABKAlCA+BBCBJHCg9nZW5lcmFsX3NldHRpbmcYAiABKAsyLC5tZW1vcy5hcGkudjEuSW5zdGFuY2VTZXR0aW5nLkdlbmVyYWxTZXR0aW5nSAASRwoPc3RvcmFnZV9zZXR0aW5nGAMgASgLMiwubWVtb3MuYXBpLnYxLkluc3RhbmNlU2V0dGluZy5TdG9yYWdlU2V0dGluZ0gAElAKFG1lbW9fcmVsYXRlZF9zZXR0aW5nGAQgASgLMjAubWVtb3MuYXBpLnYxLkluc3RhbmNlU2V0dGluZy5NZW1vUmVsYXRlZFNldHRpbmdIABqHAwoOR2VuZXJhbFNldHRpbmcSIgoaZGlzYWxsb3dfdXNlcl9yZWdpc3RyYXRpb24YAiABKAgSHgoWZGlzYWxsb3dfcGFzc3dvcmRfYXV0aBgDIAEoCBIZChFhZGRpdGlvbmFsX3NjcmlwdBgEIAEoCRIYChBhZGRpdGlvbmFsX3N0eWxlGAUgASgJElIKDmN1c3RvbV9wcm9maWxlGAYgASgLMjoubWVtb3MuYXBpLnYxLkluc3RhbmNlU2V0dGluZy5HZW5lcmFsU2V0dGluZy5DdXN0b21Qcm9maWxlEh0KFXdlZWtfc3RhcnRfZGF5X29mZnNldBgHIAEoBRIgChhkaXNhbGxvd19jaGFuZ2VfdXNlcm5hbWUYCCABKAgSIAoYZGlzYWxsb3dfY2hhbmdlX25pY2tuYW1lGAkgASgIGkUKDUN1c3RvbVByb2ZpbGUSDQoFdGl0bGUYASABKAkSEwoLZGVzY3JpcHRpb24YAiABKAkSEAoIbG9nb191cmwYAyABKAkaugMKDlN0b3JhZ2VTZXR0aW5nEk4KDHN0b3JhZ2VfdHlwZRgBIAEoDjI4Lm1lbW9zLmFwaS52MS5JbnN0YW5jZVNldHRpbmcuU3RvcmFnZVNldHRpbmcuU3RvcmFnZVR5cGUSGQoRZmlsZXBhdGhfdGVtcGxhdGUYAiABKAkSHAoUdXBsb2FkX3NpemVfbGltaXRfbWIYAyABKAMSSAoJczNfY29uZmlnGAQgASgLMjUubWVtb3MuYXBpLnYxLkluc3RhbmNlU2V0dGluZy5TdG9yYWdlU2V0dGluZy5TM0NvbmZpZxqGAQoIUzNDb25maWcSFQoNYWNjZXNzX2tleV9pZBgBIAEoCRIZChFhY2Nlc3Nfa2V5X3NlY3JldBgCIAEoCRIQCghlbmRwb2ludBgDIAEoCRIOCgZyZWdpb24YBCABKAkSDgoGYnVja2V0GAUgASgJEhYKDnVzZV9wYXRoX3N0eWxlGAYgASgIIkwKC1N0b3JhZ2VUeXBlEhwKGFNUT1JBR0VfVFlQRV9VTlNQRUNJRklFRBAAEgwKCERBVEFCQVNFEAESCQoFTE9DQUwQAhIGCgJTMxADGs4BChJNZW1vUmVsYXRlZFNldHRpbmcSIgoaZGlzYWxsb3dfcHVibGljX3Zpc2liaWxpdHkYASABKAgSIAoYZGlzcGxheV93aXRoX3VwZGF0ZV90aW1lGAIgASgIEhwKFGNvbnRlbnRfbGVuZ3RoX2xpbWl0GAMgASgFEiAKGGVuYWJsZV9kb3VibGVfY2xpY2tfZWRpdBgEIAEoCBIfChdlbmFibGVfY3VzdG9tX21lbW9fZGF0ZRgIIAEoCBIRCglyZWFjdGlvbnMYByADKAkiRgoDS2V5EhMKD0tFWV9VTlNQRUNJRklFRBAAEgsKB0dFTkVSQUwQARILCgdTVE9SQUdFEAISEAoMTUVNT19SRUxBVEVEEAM6YepBXgocbWVtb3MuYXBpLnYxL0luc3RhbmNlU2V0dGluZxIbaW5zdGFuY2Uvc2V0dGluZ3Mve3NldHRpbmd9KhBpbnN0YW5jZVNldHRpbmdzMg9pbnN0YW5jZVNldHRpbmdCBwoFdmFsdWUiTwoZR2V0SW5zdGFuY2VTZXR0aW5nUmVxdWVzdBIyCgRuYW1lGAEgASgJQiTgQQL6QR4KHG1lbW9zLmFwaS52MS9JbnN0YW5jZVNldHRpbmciiQEKHFVwZGF0ZUluc3RhbmNlU2V0dGluZ1JlcXVlc3QSMwoHc2V0dGluZxgBIAEoCzIdLm1lbW9zLmFwaS52MS5JbnN0YW5jZVNldHRpbmdCA+BBAhI0Cgt1cGRhdGVfbWFzaxgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2tCA+BBATLbAwoPSW5zdGFuY2VTZXJ2aWNlEn4KEkdldEluc3RhbmNlUHJvZmlsZRInLm1lbW9zLmFwaS52MS5HZXRJbnN0YW5jZVByb2ZpbGVSZXF1ZXN0Gh0ubWVtb3MuYXBpLnYxLkluc3RhbmNlUHJvZmlsZSIggtPkkwIaEhgvYXBpL3YxL2luc3RhbmNlL3Byb2ZpbGUSjwEKEkdldEluc3RhbmNlU2V0dGluZxInLm1lbW9zLmFwaS52MS5HZXRJbnN0YW5jZVNldHRpbmdSZXF1ZXN0Gh0ubWVtb3MuYXBpLnYxLkluc3RhbmNlU2V0dGluZyIx2kEEbmFtZYLT5JMCJBIiL2FwaS92MS97bmFtZT1pbnN0YW5jZS9zZXR0aW5ncy8qfRK1AQoVVXBkYXRlSW5zdGFuY2VTZXR0aW5nEioubWVtb3MuYXBpLnYxLlVwZGF0ZUluc3RhbmNlU2V0dGluZ1JlcXVlc3QaHS5tZW1vcy5hcGkudjEuSW5zdGFuY2VTZXR0aW5nIlHaQRNzZXR0aW5nLHVwZGF0ZV9tYXNrgtPkkwI1OgdzZXR0aW5nMiovYXBpL3YxL3tzZXR0aW5nLm5hbWU9aW5zdGFuY2Uvc2V0dGluZ3MvKn1CrAEKEGNvbS5tZW1vcy5hcGkudjFCFEluc3RhbmNlU2VydmljZVByb3RvUAFaMGdpdGh1Yi5jb20vdXNlbWVtb3MvbWVtb3MvcHJvdG8vZ2VuL2FwaS92MTthcGl2MaICA01BWKoCDE1lbW9zLkFwaS5WMcoCDE1lbW9zXEFwaVxWMeICGE1lbW9zXEFwaVxWMVxHUEJNZXRhZGF0YeoCDk1lbW9zOjpBcGk6OlYxYgZwcm90bzM", [file_api_v1_user_service, file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_field_mask]);
+  fileDesc("Ch1hcGkvdjEvaW5zdGFuY2Vfc2VydmljZS5wcm90bxIMbWVtb3MuYXBpLnYxImkKD0luc3RhbmNlUHJvZmlsZRIPCgd2ZXJzaW9uGAIgASgJEgwKBGRlbW8YAyABKAgSFAoMaW5zdGFuY2VfdXJsGAYgASgJEiEKBWFkbWluGAcgASgLMhIubWVtb3MuYXBpLnYxLlVzZXIiGwoZR2V0SW5zdGFuY2VQcm9maWxlUmVxdWVzdCLVEQoPSW5zdGFuY2VTZXR0aW5nEhEKBG5hbWUYASABKAlCA+BBCBJHCg9nZW5lcmFsX3NldHRpbmcYAiABKAsyLC5tZW1vcy5hcGkudjEuSW5zdGFuY2VTZXR0aW5nLkdlbmVyYWxTZXR0aW5nSAASRwoPc3RvcmFnZV9zZXR0aW5nGAMgASgLMiwubWVtb3MuYXBpLnYxLkluc3RhbmNlU2V0dGluZy5TdG9yYWdlU2V0dGluZ0gAElAKFG1lbW9fcmVsYXRlZF9zZXR0aW5nGAQgASgLMjAubWVtb3MuYXBpLnYxLkluc3RhbmNlU2V0dGluZy5NZW1vUmVsYXRlZFNldHRpbmdIABI9CgphaV9zZXR0aW5nGAUgASgLMicubWVtb3MuYXBpLnYxLkluc3RhbmNlU2V0dGluZy5BSVNldHRpbmdIABqHAwoOR2VuZXJhbFNldHRpbmcSIgoaZGlzYWxsb3dfdXNlcl9yZWdpc3RyYXRpb24YAiABKAgSHgoWZGlzYWxsb3dfcGFzc3dvcmRfYXV0aBgDIAEoCBIZChFhZGRpdGlvbmFsX3NjcmlwdBgEIAEoCRIYChBhZGRpdGlvbmFsX3N0eWxlGAUgASgJElIKDmN1c3RvbV9wcm9maWxlGAYgASgLMjoubWVtb3MuYXBpLnYxLkluc3RhbmNlU2V0dGluZy5HZW5lcmFsU2V0dGluZy5DdXN0b21Qcm9maWxlEh0KFXdlZWtfc3RhcnRfZGF5X29mZnNldBgHIAEoBRIgChhkaXNhbGxvd19jaGFuZ2VfdXNlcm5hbWUYCCABKAgSIAoYZGlzYWxsb3dfY2hhbmdlX25pY2tuYW1lGAkgASgIGkUKDUN1c3RvbVByb2ZpbGUSDQoFdGl0bGUYASABKAkSEwoLZGVzY3JpcHRpb24YAiABKAkSEAoIbG9nb191cmwYAyABKAkaugMKDlN0b3JhZ2VTZXR0aW5nEk4KDHN0b3JhZ2VfdHlwZRgBIAEoDjI4Lm1lbW9zLmFwaS52MS5JbnN0YW5jZVNldHRpbmcuU3RvcmFnZVNldHRpbmcuU3RvcmFnZVR5cGUSGQoRZmlsZXBhdGhfdGVtcGxhdGUYAiABKAkSHAoUdXBsb2FkX3NpemVfbGltaXRfbWIYAyABKAMSSAoJczNfY29uZmlnGAQgASgLMjUubWVtb3MuYXBpLnYxLkluc3RhbmNlU2V0dGluZy5TdG9yYWdlU2V0dGluZy5TM0NvbmZpZxqGAQoIUzNDb25maWcSFQoNYWNjZXNzX2tleV9pZBgBIAEoCRIZChFhY2Nlc3Nfa2V5X3NlY3JldBgCIAEoCRIQCghlbmRwb2ludBgDIAEoCRIOCgZyZWdpb24YBCABKAkSDgoGYnVja2V0GAUgASgJEhYKDnVzZV9wYXRoX3N0eWxlGAYgASgIIkwKC1N0b3JhZ2VUeXBlEhwKGFNUT1JBR0VfVFlQRV9VTlNQRUNJRklFRBAAEgwKCERBVEFCQVNFEAESCQoFTE9DQUwQAhIGCgJTMxADGs4BChJNZW1vUmVsYXRlZFNldHRpbmcSIgoaZGlzYWxsb3dfcHVibGljX3Zpc2liaWxpdHkYASABKAgSIAoYZGlzcGxheV93aXRoX3VwZGF0ZV90aW1lGAIgASgIEhwKFGNvbnRlbnRfbGVuZ3RoX2xpbWl0GAMgASgFEiAKGGVuYWJsZV9kb3VibGVfY2xpY2tfZWRpdBgEIAEoCBIfChdlbmFibGVfY3VzdG9tX21lbW9fZGF0ZRgIIAEoCBIRCglyZWFjdGlvbnMYByADKAkatwUKCUFJU2V0dGluZxIbChNtb250aGx5X3Rva2VuX3F1b3RhGAEgASgDEmYKGXJvbGVfbW9udGhseV90b2tlbl9xdW90YXMYAiADKAsyQy5tZW1vcy5hcGkudjEuSW5zdGFuY2VTZXR0aW5nLkFJU2V0dGluZy5Sb2xlTW9udGhseVRva2VuUXVvdGFzRW50cnkSZgoZdXNlcl9tb250aGx5X3Rva2VuX3F1b3RhcxgDIAMoCzJDLm1lbW9zLmFwaS52MS5JbnN0YW5jZVNldHRpbmcuQUlTZXR0aW5nLlVzZXJNb250aGx5VG9rZW5RdW90YXNFbnRyeRIsCgttY3Bfc2VydmVycxgEIAMoCzIXLm1lbW9zLmFwaS52MS5NQ1BTZXJ2ZXISRQoKd2ViX2FjY2VzcxgFIAEoDjIxLm1lbW9zLmFwaS52MS5JbnN0YW5jZVNldHRpbmcuQUlTZXR0aW5nLldlYkFjY2VzcxISCgpyZWFkZXJfdXJsGAYgASgJEhYKDmRpc2FibGVkX3Rvb2xzGAcgAygJEhYKDmFsbG93ZWRfbW9kZWxzGAggAygJEh4KFnN5c3RlbV9wcm9tcHRfcHJlYW1ibGUYCSABKAkSGAoQbWF4X2FnZW50X3JvdW5kcxgKIAEoBRo9ChtSb2xlTW9udGhseVRva2VuUXVvdGFzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgDOgI4ARo9ChtVc2VyTW9udGhseVRva2VuUXVvdGFzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgDOgI4ASJMCglXZWJBY2Nlc3MSGgoWV0VCX0FDQ0VTU19VTlNQRUNJRklFRBAAEgkKBUxPQ0FMEAESCgoGUkVBREVSEAISDAoIRElTQUJMRUQQAyJOCgNLZXkSEwoPS0VZX1VOU1BFQ0lGSUVEEAASCwoHR0VORVJBTBABEgsKB1NUT1JBR0UQAhIQCgxNRU1PX1JFTEFURUQQAxIGCgJBSRAEOmHqQV4KHG1lbW9zLmFwaS52MS9JbnN0YW5jZVNldHRpbmcSG2luc3RhbmNlL3NldHRpbmdzL3tzZXR0aW5nfSoQaW5zdGFuY2VTZXR0aW5nczIPaW5zdGFuY2VTZXR0aW5nQgcKBXZhbHVlIk8KGUdldEluc3RhbmNlU2V0dGluZ1JlcXVlc3QSMgoEbmFtZRgBIAEoCUIk4EEC+kEeChxtZW1vcy5hcGkudjEvSW5zdGFuY2VTZXR0aW5nIokBChxVcGRhdGVJbnN0YW5jZVNldHRpbmdSZXF1ZXN0EjMKB3NldHRpbmcYASABKAsyHS5tZW1vcy5hcGkudjEuSW5zdGFuY2VTZXR0aW5nQgPgQQISNAoLdXBkYXRlX21hc2sYAiABKAsyGi5nb29nbGUucHJvdG9idWYuRmllbGRNYXNrQgPgQQEiGgoYR2V0RW1iZWRkaW5nSW5kZXhSZXF1ZXN0IokCCg5FbWJlZGRpbmdJbmRleBINCgVtb2RlbBgBIAEoCRIRCglkaW1lbnNpb24YAiABKAUSNQoHcmVpbmRleBgDIAEoCzIkLm1lbW9zLmFwaS52MS5FbWJlZGRpbmdJbmRleC5SZWluZGV4Gp0BCgdSZWluZGV4Eg0KBW1vZGVsGAEgASgJEhEKCWRpbWVuc2lvbhgCIAEoBRIuCgpzdGFydF90aW1lGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIVCg1pbmRleGVkX21lbW9zGAQgASgFEhQKDGZhaWxlZF9tZW1vcxgFIAEoBRITCgt0b3RhbF9tZW1vcxgGIAEoBTLgBAoPSW5zdGFuY2VTZXJ2aWNlEn4KEkdldEluc3RhbmNlUHJvZmlsZRInLm1lbW9zLmFwaS52MS5HZXRJbnN0YW5jZVByb2ZpbGVSZXF1ZXN0Gh0ubWVtb3MuYXBpLnYxLkluc3RhbmNlUHJvZmlsZSIggtPkkwIaEhgvYXBpL3YxL2luc3RhbmNlL3Byb2ZpbGUSjwEKEkdldEluc3RhbmNlU2V0dGluZxInLm1lbW9zLmFwaS52MS5HZXRJbnN0YW5jZVNldHRpbmdSZXF1ZXN0Gh0ubWVtb3MuYXBpLnYxLkluc3RhbmNlU2V0dGluZyIx2kEEbmFtZYLT5JMCJBIiL2FwaS92MS97bmFtZT1pbnN0YW5jZS9zZXR0aW5ncy8qfRK1AQoVVXBkYXRlSW5zdGFuY2VTZXR0aW5nEioubWVtb3MuYXBpLnYxLlVwZGF0ZUluc3RhbmNlU2V0dGluZ1JlcXVlc3QaHS5tZW1vcy5hcGkudjEuSW5zdGFuY2VTZXR0aW5nIlHaQRNzZXR0aW5nLHVwZGF0ZV9tYXNrgtPkkwI1OgdzZXR0aW5nMiovYXBpL3YxL3tzZXR0aW5nLm5hbWU9aW5zdGFuY2Uvc2V0dGluZ3MvKn0SggEKEUdldEVtYmVkZGluZ0luZGV4EiYubWVtb3MuYXBpLnYxLkdldEVtYmVkZGluZ0luZGV4UmVxdWVzdBocLm1lbW9zLmFwaS52MS5FbWJlZGRpbmdJbmRleCIngtPkkwIhEh8vYXBpL3YxL2luc3RhbmNlL2VtYmVkZGluZ0luZGV4QqwBChBjb20ubWVtb3MuYXBpLnYxQhRJbnN0YW5jZVNlcnZpY2VQcm90b1ABWjBnaXRodWIuY29tL3VzZW1lbW9zL21lbW9zL3Byb3RvL2dlbi9hcGkvdjE7YXBpdjGiAgNNQViqAgxNZW1vcy5BcGkuVjHKAgxNZW1vc1xBcGlcVjHiAhhNZW1vc1xBcGlcVjFcR1BCTWV0YWRhdGHqAg5NZW1vczo6QXBpOjpWMWIGcHJvdG8z", [file_api_v1_user_service, file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_field_mask, file_google_protobuf_timestamp]);
 
 /**
  * Instance profile message containing basic instance information.
@@ -113,6 +113,12 @@ export type InstanceSetting = Message<"memos.api.v1.InstanceSetting"> & {
      */
     value: InstanceSetting_MemoRelatedSetting;
     case: "memoRelatedSetting";
+  } | {
+    /**
+     * @generated from field: memos.api.v1.InstanceSetting.AISetting ai_setting = 5;
+     */
+    value: InstanceSetting_AISetting;
+    case: "aiSetting";
   } | { case: undefined; value?: undefined };
 };
 
@@ -407,6 +413,140 @@ export type InstanceSetting_MemoRelatedSetting = Message<"memos.api.v1.InstanceS
 export const InstanceSetting_MemoRelatedSettingSchema: GenMessage<InstanceSetting_MemoRelatedSetting> = /*@__PURE__*/
   messageDesc(file_api_v1_instance_service, 2, 2);
 
+/**
+ * AI instance settings, including usage quotas.
+ *
+ * @generated from message memos.api.v1.InstanceSetting.AISetting
+ */
+export type InstanceSetting_AISetting = Message<"memos.api.v1.InstanceSetting.AISetting"> & {
+  /**
+   * monthly_token_quota is the number of tokens each user may spend on AI
+   * features per calendar month (UTC). 0 means unlimited.
+   *
+   * @generated from field: int64 monthly_token_quota = 1;
+   */
+  monthlyTokenQuota: bigint;
+
+  /**
+   * role_monthly_token_quotas overrides monthly_token_quota for the users of
+   * a role, keyed by role, e.g. "USER".
+   *
+   * @generated from field: map<string, int64> role_monthly_token_quotas = 2;
+   */
+  roleMonthlyTokenQuotas: { [key: string]: bigint };
+
+  /**
+   * user_monthly_token_quotas overrides the other quotas for single users,
+   * keyed by user name. Format: users/{user}
+   *
+   * @generated from field: map<string, int64> user_monthly_token_quotas = 3;
+   */
+  userMonthlyTokenQuotas: { [key: string]: bigint };
+
+  /**
+   * mcp_servers are external MCP servers whose tools the assistant of every
+   * user can use.
+   *
+   * @generated from field: repeated memos.api.v1.MCPServer mcp_servers = 4;
+   */
+  mcpServers: MCPServer[];
+
+  /**
+   * web_access is how the assistant reads web pages.
+   *
+   * @generated from field: memos.api.v1.InstanceSetting.AISetting.WebAccess web_access = 5;
+   */
+  webAccess: InstanceSetting_AISetting_WebAccess;
+
+  /**
+   * reader_url is the endpoint of the reader service used when web_access
+   * is READER. The page URL is appended to it, e.g. https://r.jina.ai/
+   *
+   * @generated from field: string reader_url = 6;
+   */
+  readerUrl: string;
+
+  /**
+   * disabled_tools are built-in assistant tools, e.g. "delete_memo", that
+   * no user's assistant may use.
+   *
+   * @generated from field: repeated string disabled_tools = 7;
+   */
+  disabledTools: string[];
+
+  /**
+   * allowed_models are the chat models users may pick. When empty, only the
+   * server's default model is used.
+   *
+   * @generated from field: repeated string allowed_models = 8;
+   */
+  allowedModels: string[];
+
+  /**
+   * system_prompt_preamble replaces the opening of the assistant's system
+   * prompt, which says who the assistant is.
+   *
+   * @generated from field: string system_prompt_preamble = 9;
+   */
+  systemPromptPreamble: string;
+
+  /**
+   * max_agent_rounds caps the tool-use rounds of a chat turn, up to 50. 0
+   * means the default of 12.
+   *
+   * @generated from field: int32 max_agent_rounds = 10;
+   */
+  maxAgentRounds: number;
+};
+
+/**
+ * Describes the message memos.api.v1.InstanceSetting.AISetting.
+ * Use `create(InstanceSetting_AISettingSchema)` to create a new message.
+ */
+export const InstanceSetting_AISettingSchema: GenMessage<InstanceSetting_AISetting> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 2, 3);
+
+/**
+ * WebAccess is how the assistant reads web pages.
+ *
+ * @generated from enum memos.api.v1.InstanceSetting.AISetting.WebAccess
+ */
+export enum InstanceSetting_AISetting_WebAccess {
+  /**
+   * WEB_ACCESS_UNSPECIFIED behaves like LOCAL.
+   *
+   * @generated from enum value: WEB_ACCESS_UNSPECIFIED = 0;
+   */
+  WEB_ACCESS_UNSPECIFIED = 0,
+
+  /**
+   * LOCAL fetches pages from the server and extracts their content there.
+   *
+   * @generated from enum value: LOCAL = 1;
+   */
+  LOCAL = 1,
+
+  /**
+   * READER reads pages through the reader service at reader_url.
+   *
+   * @generated from enum value: READER = 2;
+   */
+  READER = 2,
+
+  /**
+   * DISABLED keeps the assistant off the web, including web search.
+   *
+   * @generated from enum value: DISABLED = 3;
+   */
+  DISABLED = 3,
+}
+
+/**
+ * Describes the enum memos.api.v1.InstanceSetting.AISetting.WebAccess.
+ */
+export const InstanceSetting_AISetting_WebAccessSchema: GenEnum<InstanceSetting_AISetting_WebAccess> = /*@__PURE__*/
+  enumDesc(file_api_v1_instance_service, 2, 3, 0);
+
 /**
  * Enumeration of instance setting keys.
  *
@@ -438,6 +578,13 @@ export enum InstanceSetting_Key {
    * @generated from enum value: MEMO_RELATED = 3;
    */
   MEMO_RELATED = 3,
+
+  /**
+   * AI is the key for AI settings.
+   *
+   * @generated from enum value: AI = 4;
+   */
+  AI = 4,
 }
 
 /**
@@ -496,6 +643,115 @@ export type UpdateInstanceSettingRequest = Message<"memos.api.v1.UpdateInstanceS
 export const UpdateInstanceSettingRequestSchema: GenMessage<UpdateInstanceSettingRequest> = /*@__PURE__*/
   messageDesc(file_api_v1_instance_service, 4);
 
+/**
+ * Request message for GetEmbeddingIndex method.
+ *
+ * @generated from message memos.api.v1.GetEmbeddingIndexRequest
+ */
+export type GetEmbeddingIndexRequest = Message<"memos.api.v1.GetEmbeddingIndexRequest"> & {
+};
+
+/**
+ * Describes the message memos.api.v1.GetEmbeddingIndexRequest.
+ * Use `create(GetEmbeddingIndexRequestSchema)` to create a new message.
+ */
+export const GetEmbeddingIndexRequestSchema: GenMessage<GetEmbeddingIndexRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 5);
+
+/**
+ * The embedding index used for semantic search.
+ *
+ * @generated from message memos.api.v1.EmbeddingIndex
+ */
+export type EmbeddingIndex = Message<"memos.api.v1.EmbeddingIndex"> & {
+  /**
+   * The embedding model of the index that searches use.
+   *
+   * @generated from field: string model = 1;
+   */
+  model: string;
+
+  /**
+   * The dimension of the vectors of the index, or 0 while it is empty.
+   *
+   * @generated from field: int32 dimension = 2;
+   */
+  dimension: number;
+
+  /**
+   * Set while memos are re-indexed.
+   *
+   * @generated from field: memos.api.v1.EmbeddingIndex.Reindex reindex = 3;
+   */
+  reindex?: EmbeddingIndex_Reindex;
+};
+
+/**
+ * Describes the message memos.api.v1.EmbeddingIndex.
+ * Use `create(EmbeddingIndexSchema)` to create a new message.
+ */
+export const EmbeddingIndexSchema: GenMessage<EmbeddingIndex> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 6);
+
+/**
+ * Progress of re-indexing memos for a new embedding model. Searches keep
+ * using the current index until every memo is in the new one.
+ *
+ * @generated from message memos.api.v1.EmbeddingIndex.Reindex
+ */
+export type EmbeddingIndex_Reindex = Message<"memos.api.v1.EmbeddingIndex.Reindex"> & {
+  /**
+   * The new embedding model.
+   *
+   * @generated from field: string model = 1;
+   */
+  model: string;
+
+  /**
+   * The dimension of the vectors of the new model, or 0 while none are
+   * stored.
+   *
+   * @generated from field: int32 dimension = 2;
+   */
+  dimension: number;
+
+  /**
+   * When re-indexing started.
+   *
+   * @generated from field: google.protobuf.Timestamp start_time = 3;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * The number of memos in the new index so far.
+   *
+   * @generated from field: int32 indexed_memos = 4;
+   */
+  indexedMemos: number;
+
+  /**
+   * The number of memos that could not be embedded in this run. They are
+   * retried when the server restarts.
+   *
+   * @generated from field: int32 failed_memos = 5;
+   */
+  failedMemos: number;
+
+  /**
+   * The number of memos to index, or 0 until the run has started.
+   *
+   * @generated from field: int32 total_memos = 6;
+   */
+  totalMemos: number;
+};
+
+/**
+ * Describes the message memos.api.v1.EmbeddingIndex.Reindex.
+ * Use `create(EmbeddingIndex_ReindexSchema)` to create a new message.
+ */
+export const EmbeddingIndex_ReindexSchema: GenMessage<EmbeddingIndex_Reindex> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 6, 0);
+
 /**
  * @generated from service memos.api.v1.InstanceService
  */
@@ -530,6 +786,17 @@ export const InstanceService: GenService<{
     input: typeof UpdateInstanceSettingRequestSchema;
     output: typeof InstanceSettingSchema;
   },
+  /**
+   * Gets the state of the embedding index used for semantic search, including
+   * the progress of re-indexing memos after the embedding model changed.
+   *
+   * @generated from rpc memos.api.v1.InstanceService.GetEmbeddingIndex
+   */
+  getEmbeddingIndex: {
+    methodKind: "unary";
+    input: typeof GetEmbeddingIndexRequestSchema;
+    output: typeof EmbeddingIndexSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_api_v1_instance_service, 0);
 
@@ -1,165 +1,156 @@
-export interface AIChatSession {
-    uid: string;
-    title: string;
-    createdTs: number;
-    updatedTs: number;
-}
-
-export interface AIChatMessage {
-    id: number;
-    role: "user" | "assistant" | "tool";
-    content: string;
-    toolName?: string;
-    createdTs: number;
-}
-
-export interface AIChatEvent {
-    type: "token" | "tool_call" | "source" | "done" | "error";
-    content?: string;
-    payload?: any;
-}
+import { create } from "@bufbuild/protobuf";
+import { FieldMaskSchema } from "@bufbuild/protobuf/wkt";
+import { aiServiceClient } from "@/connect";
+import type { Memo } from "@/types/proto/api/v1/memo_service_pb";
+import {
+    AIMemorySchema,
+    AISessionSchema,
+    type AIBranch,
+    type AIChatEvent,
+    type AIMemory,
+    type AIMessage,
+    type AISession,
+    type AIUsage,
+    type SearchAISessionsResponse,
+} from "@/types/proto/api/v1/ai_service_pb";
+
+export type {
+    AIBranch,
+    AIChatEvent,
+    AIMemory,
+    AIMessage,
+    AIPendingAction,
+    AISession,
+    AIUsage,
+    SearchAISessionsResponse_Result as AISearchResult,
+} from "@/types/proto/api/v1/ai_service_pb";
+
+// aiSessionName returns the resource name of a session of the user.
+export const aiSessionName = (user: string, uid: string) => `${user}/aiSessions/${uid}`;
+
+// aiSessionUid returns the last segment of a session name, used in chat URLs.
+export const aiSessionUid = (name: string) => name.split("/").pop() ?? "";
 
 export const aiService = {
-    async listSessions(): Promise<AIChatSession[]> {
-        const res = await fetch("/api/v1/ai/sessions", {
-            headers: { "Content-Type": "application/json" },
-        });
-        if (!res.ok) throw new Error("Failed to list sessions");
-        return res.json();
+    async listSessions(user: string): Promise<AISession[]> {
+        const sessions: AISession[] = [];
+        let pageToken = "";
+        do {
+            const response = await aiServiceClient.listAISessions({ parent: user, pageToken });
+            sessions.push(...response.aiSessions);
+            pageToken = response.nextPageToken;
+        } while (pageToken);
+        return sessions;
     },
 
-    async createSession(title: string = "New Chat"): Promise<AIChatSession> {
-        const res = await fetch("/api/v1/ai/sessions", {
-            method: "POST",
-            headers: { "Content-Type": "application/json" },
-            body: JSON.stringify({ title }),
-        });
-        if (!res.ok) throw new Error("Failed to create session");
-        return res.json();
+    // searchSessions finds the user's sessions and messages containing any
+    // word of query, newest first.
+    async searchSessions(user: string, query: string, pageToken = ""): Promise<SearchAISessionsResponse> {
+        return aiServiceClient.searchAISessions({ parent: user, query, pageToken });
     },
 
-    async renameSession(uid: string, title: string): Promise<AIChatSession> {
-        const res = await fetch(`/api/v1/ai/sessions/${uid}`, {
-            method: "PATCH",
-            headers: { "Content-Type": "application/json" },
-            body: JSON.stringify({ title }),
-        });
-        if (!res.ok) throw new Error("Failed to rename session");
-        return res.json();
+    async getSession(name: string): Promise<AISession> {
+        return aiServiceClient.getAISession({ name });
+    },
+
+    async createSession(user: string, title = ""): Promise<AISession> {
+        return aiServiceClient.createAISession({ parent: user, aiSession: create(AISessionSchema, { title }) });
     },
 
-    async deleteSession(uid: string): Promise<void> {
-        const res = await fetch(`/api/v1/ai/sessions/${uid}`, {
-            method: "DELETE",
+    async renameSession(name: string, title: string): Promise<AISession> {
+        return aiServiceClient.updateAISession({
+            aiSession: create(AISessionSchema, { name, title }),
+            updateMask: create(FieldMaskSchema, { paths: ["title"] }),
         });
-        if (!res.ok) throw new Error("Failed to delete session");
     },
 
-    async loadMessages(uid: string): Promise<AIChatMessage[]> {
-        const res = await fetch(`/api/v1/ai/sessions/${uid}/messages`);
-        if (!res.ok) throw new Error("Failed to load messages");
-        return res.json();
+    async deleteSession(name: string): Promise<void> {
+        await aiServiceClient.deleteAISession({ name });
     },
 
-    async *chat(uid: string, content: string, tagFilter: string = ""): AsyncGenerator<AIChatEvent, void, unknown> {
-        const res = await fetch(`/api/v1/ai/sessions/${uid}/chat`, {
-            method: "POST",
-            headers: { "Content-Type": "application/json" },
-            body: JSON.stringify({ content, tagFilter }),
-        });
+    // loadMessages returns the messages of the session's active branch.
+    async loadMessages(name: string): Promise<AIMessage[]> {
+        const messages: AIMessage[] = [];
+        let pageToken = "";
+        do {
+            const response = await aiServiceClient.listAIMessages({ parent: name, pageToken });
+            messages.push(...response.aiMessages);
+            pageToken = response.nextPageToken;
+        } while (pageToken);
+        return messages;
+    },
 
-        if (!res.ok) {
-            throw new Error("Failed to send chat message");
-        }
+    async listBranches(name: string): Promise<AIBranch[]> {
+        const response = await aiServiceClient.listAIBranches({ parent: name });
+        return response.branches;
+    },
 
-        if (!res.body) {
-            throw new Error("No response body");
-        }
+    // switchBranch activates the branch through message.
+    async switchBranch(name: string, message: string): Promise<AISession> {
+        return aiServiceClient.switchAIBranch({ name, message });
+    },
 
-        const reader = res.body.getReader();
-        const decoder = new TextDecoder("utf-8");
-        let buffer = "";
-
-        try {
-            while (true) {
-                const { value, done } = await reader.read();
-                if (done) break;
-
-                buffer += decoder.decode(value, { stream: true });
-
-                while (buffer.includes("\n\n")) {
-                    const eventEndIndex = buffer.indexOf("\n\n");
-                    const eventLine = buffer.slice(0, eventEndIndex);
-                    buffer = buffer.slice(eventEndIndex + 2);
-
-                    if (eventLine.startsWith("data: ")) {
-                        const dataStr = eventLine.slice(6);
-                        if (dataStr === "[DONE]") {
-                            return;
-                        }
-                        try {
-                            const event: AIChatEvent = JSON.parse(dataStr);
-                            yield event;
-                        } catch (e) {
-                            console.warn("Failed to parse SSE event", dataStr, e);
-                        }
-                    }
-                }
-            }
-        } finally {
-            reader.releaseLock();
-        }
+    // chat sends a message after parentMessage, or after the active branch when omitted.
+    chat(name: string, content: string, tagFilter = "", parentMessage?: string): AsyncIterable<AIChatEvent> {
+        return aiServiceClient.chat({ name, content, tagFilter, parentMessage });
+    },
+
+    regenerate(message: string, tagFilter = ""): AsyncIterable<AIChatEvent> {
+        return aiServiceClient.regenerateAIMessage({ name: message, tagFilter });
     },
 
-    async *streamCompletion(prompt: string, system: string = ""): AsyncGenerator<string, void, unknown> {
-        const res = await fetch(`/api/v1/ai/completions/stream`, {
-            method: "POST",
-            headers: { "Content-Type": "application/json" },
-            body: JSON.stringify({ prompt, system }),
+    // confirmAction runs or rejects a pending action; the agent carries on once
+    // no actions are left.
+    confirmAction(name: string, toolCallId: string, approved: boolean): AsyncIterable<AIChatEvent> {
+        return aiServiceClient.confirmAIAction({ name, toolCallId, approved });
+    },
+
+    // cancelTurn stops a running turn before its next model or tool call.
+    async cancelTurn(turn: string): Promise<void> {
+        await aiServiceClient.cancelAITurn({ name: turn });
+    },
+
+    // resumeTurn replays a turn's events after the first offset ones, then
+    // streams the rest of its reply.
+    resumeTurn(turn: string, offset = 0): AsyncIterable<AIChatEvent> {
+        return aiServiceClient.resumeAITurn({ name: turn, offset });
+    },
+
+    // saveAsMemo creates a memo from an assistant message, or from the whole
+    // session when message is empty, referencing the memos the chat cited.
+    async saveAsMemo(name: string, message = "", tag = "ai"): Promise<Memo> {
+        return aiServiceClient.saveAISessionAsMemo({ name, message, tag });
+    },
+
+    // listMemories returns what the assistant remembers about the user, most
+    // recently updated first.
+    async listMemories(user: string): Promise<AIMemory[]> {
+        const response = await aiServiceClient.listAIMemories({ parent: user });
+        return response.aiMemories;
+    },
+
+    async updateMemory(name: string, content: string): Promise<AIMemory> {
+        return aiServiceClient.updateAIMemory({
+            aiMemory: create(AIMemorySchema, { name, content }),
+            updateMask: create(FieldMaskSchema, { paths: ["content"] }),
         });
+    },
 
-        if (!res.ok) {
-            throw new Error("Failed to start AI completion");
-        }
+    async deleteMemory(name: string): Promise<void> {
+        await aiServiceClient.deleteAIMemory({ name });
+    },
 
-        if (!res.body) {
-            throw new Error("No response body");
-        }
+    // getUsage reports every user's token usage for a month (YYYY-MM), the
+    // current one by default. Admins only.
+    async getUsage(month = ""): Promise<AIUsage> {
+        return aiServiceClient.getAIUsage({ month });
+    },
 
-        const reader = res.body.getReader();
-        const decoder = new TextDecoder("utf-8");
-        let buffer = "";
-
-        try {
-            while (true) {
-                const { value, done } = await reader.read();
-                if (done) break;
-
-                buffer += decoder.decode(value, { stream: true });
-
-                while (buffer.includes("\n\n")) {
-                    const eventEndIndex = buffer.indexOf("\n\n");
-                    const eventLine = buffer.slice(0, eventEndIndex);
-                    buffer = buffer.slice(eventEndIndex + 2);
-
-                    if (eventLine.startsWith("data: ")) {
-                        const dataStr = eventLine.slice(6);
-                        if (dataStr === "[DONE]") {
-                            return;
-                        }
-                        try {
-                            const event: { type: string; content?: string } = JSON.parse(dataStr);
-                            if (event.type === "token" && event.content) {
-                                yield event.content;
-                            }
-                        } catch (e) {
-                            console.warn("Failed to parse SSE event", dataStr, e);
-                        }
-                    }
-                }
+    async *streamCompletion(prompt: string, system = ""): AsyncGenerator<string, void, unknown> {
+        for await (const response of aiServiceClient.generateCompletion({ prompt, system })) {
+            if (response.content) {
+                yield response.content;
             }
-        } finally {
-            reader.releaseLock();
         }
-    }
+    },
 };